@@ -270,15 +270,17 @@ type ServiceAccount struct {
 }
 
 type GatewayValidation struct {
-	Enabled                          *bool    `json:"enabled,omitempty" desc:"enable Gloo Edge API Gateway validation hook (default true)"`
-	AlwaysAcceptResources            *bool    `json:"alwaysAcceptResources,omitempty" desc:"unless this is set this to false in order to ensure validation webhook rejects invalid resources. by default, validation webhook will only log and report metrics for invalid resource admission without rejecting them outright."`
-	AllowWarnings                    *bool    `json:"allowWarnings,omitempty" desc:"set this to false in order to ensure validation webhook rejects resources that would have warning status or rejected status, rather than just rejected."`
-	DisableTransformationValidation  *bool    `json:"disableTransformationValidation,omitempty" desc:"set this to true to disable transformation validation. This may bring signifigant performance benefits if using many transformations, at the cost of possibly incorrect transformations being sent to envoy. When using this value make sure to pre-validate transformations."`
-	WarnRouteShortCircuiting         *bool    `json:"warnRouteShortCircuiting,omitempty" desc:"Write a warning to route resources if validation produced a route ordering warning (defaults to false). By setting to true, this means that Gloo Edge will start assigning warnings to resources that would result in route short-circuiting within a virtual host."`
-	SecretName                       *string  `json:"secretName,omitempty" desc:"Name of the Kubernetes Secret containing TLS certificates used by the validation webhook server. This secret will be created by the certGen Job if the certGen Job is enabled."`
-	FailurePolicy                    *string  `json:"failurePolicy,omitempty" desc:"failurePolicy defines how unrecognized errors from the Gateway validation endpoint are handled - allowed values are 'Ignore' or 'Fail'. Defaults to Ignore "`
-	Webhook                          *Webhook `json:"webhook,omitempty" desc:"webhook specific configuration"`
-	ValidationServerGrpcMaxSizeBytes *int     `json:"validationServerGrpcMaxSizeBytes,omitempty" desc:"gRPC max message size in bytes for the gloo validation server"`
+	Enabled                          *bool            `json:"enabled,omitempty" desc:"enable Gloo Edge API Gateway validation hook (default true)"`
+	AlwaysAcceptResources            *bool            `json:"alwaysAcceptResources,omitempty" desc:"unless this is set this to false in order to ensure validation webhook rejects invalid resources. by default, validation webhook will only log and report metrics for invalid resource admission without rejecting them outright."`
+	AllowWarnings                    *bool            `json:"allowWarnings,omitempty" desc:"set this to false in order to ensure validation webhook rejects resources that would have warning status or rejected status, rather than just rejected."`
+	DisableTransformationValidation  *bool            `json:"disableTransformationValidation,omitempty" desc:"set this to true to disable transformation validation. This may bring signifigant performance benefits if using many transformations, at the cost of possibly incorrect transformations being sent to envoy. When using this value make sure to pre-validate transformations."`
+	FullEnvoyValidation              *bool            `json:"fullEnvoyValidation,omitempty" desc:"set this to true to validate the full configuration translated for each proxy with a single call to envoy, rather than validating each transformation with its own call to envoy. This may bring significant performance benefits if using many transformations."`
+	WarnRouteShortCircuiting         *bool            `json:"warnRouteShortCircuiting,omitempty" desc:"Write a warning to route resources if validation produced a route ordering warning (defaults to false). By setting to true, this means that Gloo Edge will start assigning warnings to resources that would result in route short-circuiting within a virtual host."`
+	SecretName                       *string          `json:"secretName,omitempty" desc:"Name of the Kubernetes Secret containing TLS certificates used by the validation webhook server. This secret will be created by the certGen Job if the certGen Job is enabled."`
+	FailurePolicy                    *string          `json:"failurePolicy,omitempty" desc:"failurePolicy defines how unrecognized errors from the Gateway validation endpoint are handled - allowed values are 'Ignore' or 'Fail'. Defaults to Ignore "`
+	Webhook                          *Webhook         `json:"webhook,omitempty" desc:"webhook specific configuration"`
+	MutatingWebhook                  *MutatingWebhook `json:"mutatingWebhook,omitempty" desc:"mutating webhook specific configuration"`
+	ValidationServerGrpcMaxSizeBytes *int             `json:"validationServerGrpcMaxSizeBytes,omitempty" desc:"gRPC max message size in bytes for the gloo validation server"`
 }
 
 type Webhook struct {
@@ -288,6 +290,12 @@ type Webhook struct {
 	*KubeResourceOverride
 }
 
+type MutatingWebhook struct {
+	Enabled          *bool             `json:"enabled,omitempty" desc:"enable the mutating webhook, which applies the defaults of the settings to Virtual Services and normalizes Virtual Services, Route Tables and Upstreams before they are validated. Routes are sorted when the resource has the gateway.solo.io/sort_routes=true annotation (default false)"`
+	ExtraAnnotations map[string]string `json:"extraAnnotations,omitempty" desc:"extra annotations to add to the webhook"`
+	*KubeResourceOverride
+}
+
 type GatewayDeployment struct {
 	Image              *Image            `json:"image,omitempty,omitempty"`
 	Stats              *Stats            `json:"stats,omitempty,omitempty" desc:"overrides for prometheus stats published by the gateway pod"`
@@ -350,6 +358,8 @@ type GatewayProxy struct {
 	LogLevel                       *string                      `json:"logLevel,omitempty" desc:"Level at which the pod should log. Options include \"info\", \"debug\", \"warn\", \"error\", \"panic\" and \"fatal\". Default level is info"`
 	XdsServiceAddress              *string                      `json:"xdsServiceAddress,omitempty" desc:"The k8s service name for the xds server. Defaults to gloo."`
 	XdsServicePort                 *uint32                      `json:"xdsServicePort,omitempty" desc:"The k8s service port for the xds server. Defaults to the value from .Values.gloo.deployment.xdsPort, but can be overridden to use, for example, xds-relay."`
+	DeltaXds                       *bool                        `json:"deltaXds,omitempty" desc:"Use the incremental (delta) variant of the xDS protocol, with which only the resources that changed are sent to envoy. Defaults to false, using the State of the World variant"`
+	ZoneAwareRouting               ZoneAwareRouting             `json:"zoneAwareRouting,omitempty" desc:"Set the locality of the proxies and their local cluster in the envoy bootstrap, which the upstreams with a zoneAwareLbConfig require"`
 	*KubeResourceOverride
 }
 
@@ -450,6 +460,13 @@ type Tracing struct {
 	Cluster  *string `json:"cluster,omitempty"`
 }
 
+type ZoneAwareRouting struct {
+	Enabled *bool   `json:"enabled,omitempty" desc:"set the locality of the proxies, and the endpoints of their own service as the local cluster of envoy, so that the upstreams with a zoneAwareLbConfig prefer the endpoints in the zone of the proxies (default false)"`
+	Region  *string `json:"region,omitempty" desc:"region of the proxies. Defaults to the topology.kubernetes.io/region label of the pod, read with the downward API"`
+	Zone    *string `json:"zone,omitempty" desc:"zone of the proxies. Defaults to the topology.kubernetes.io/zone label of the pod, read with the downward API"`
+	SubZone *string `json:"subZone,omitempty" desc:"sub-zone of the proxies"`
+}
+
 type Failover struct {
 	Enabled    *bool   `json:"enabled,omitempty" desc:"(Enterprise Only): Configure this proxy for failover"`
 	Port       *uint   `json:"port,omitempty" desc:"(Enterprise Only): Port to use for failover Gateway Bind port, and service. Default is 15443"`
@@ -126,6 +126,7 @@ var _ = Describe("Helm Test", func() {
 					"ClusterRole",
 					"ClusterRoleBinding",
 					"ValidatingWebhookConfiguration",
+					"MutatingWebhookConfiguration",
 				)
 
 				// all namespaced resources should have a namespace set on them
@@ -534,6 +535,58 @@ var _ = Describe("Helm Test", func() {
 						}
 					})
 				})
+
+				It("should set the locality and the local cluster of the gateway-proxy-envoy-config for zone aware routing", func() {
+					prepareMakefile(namespace, helmValues{
+						valuesArgs: []string{
+							"gatewayProxies.gatewayProxy.zoneAwareRouting.enabled=true",
+							"gatewayProxies.gatewayProxy.zoneAwareRouting.zone=us-east-1a"},
+					})
+
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "ConfigMap"
+					}).ExpectAll(func(configMap *unstructured.Unstructured) {
+						configMapObject, err := kuberesource.ConvertUnstructured(configMap)
+						Expect(err).NotTo(HaveOccurred(), fmt.Sprintf("ConfigMap %+v should be able to convert from unstructured", configMap))
+						structuredConfigMap, ok := configMapObject.(*v1.ConfigMap)
+						Expect(ok).To(BeTrue(), fmt.Sprintf("ConfigMap %+v should be able to cast to a structured config map", configMap))
+
+						if structuredConfigMap.GetName() == "gateway-proxy-envoy-config" {
+							envoyYaml := structuredConfigMap.Data["envoy.yaml"]
+							Expect(envoyYaml).To(ContainSubstring(`region: '{{ index .PodLabels "topology.kubernetes.io/region" }}'`))
+							Expect(envoyYaml).To(ContainSubstring("zone: 'us-east-1a'"))
+							Expect(envoyYaml).To(ContainSubstring("service_name: " + namespace + "-gateway-proxy-80_" + namespace))
+							Expect(envoyYaml).To(ContainSubstring("local_cluster_name: gateway-proxy_local_cluster"))
+						}
+					})
+
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "Deployment"
+					}).ExpectAll(func(deployment *unstructured.Unstructured) {
+						deploymentObject, err := kuberesource.ConvertUnstructured(deployment)
+						Expect(err).NotTo(HaveOccurred(), fmt.Sprintf("Deployment %+v should be able to convert from unstructured", deployment))
+						structuredDeployment, ok := deploymentObject.(*appsv1.Deployment)
+						Expect(ok).To(BeTrue(), fmt.Sprintf("Deployment %+v should be able to cast to a structured deployment", deployment))
+
+						if structuredDeployment.GetName() == "gateway-proxy" {
+							Expect(structuredDeployment.Spec.Template.Spec.Volumes).To(ContainElement(v1.Volume{
+								Name: "podinfo",
+								VolumeSource: v1.VolumeSource{
+									DownwardAPI: &v1.DownwardAPIVolumeSource{
+										Items: []v1.DownwardAPIVolumeFile{{
+											Path:     "labels",
+											FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.labels"},
+										}},
+									},
+								},
+							}))
+							Expect(structuredDeployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(v1.VolumeMount{
+								Name:      "podinfo",
+								MountPath: "/etc/podinfo",
+							}))
+						}
+					})
+				})
 			})
 
 			Context("gloo with istio sds settings", func() {
@@ -2977,7 +3030,7 @@ webhooks:
      - operations: [ "CREATE", "UPDATE", "DELETE" ]
        apiGroups: ["gloo.solo.io"]
        apiVersions: ["v1"]
-       resources: ["upstreams"]
+       resources: ["upstreams", "upstreamgroups"]
      - operations: [ "DELETE" ]
        apiGroups: ["gloo.solo.io"]
        apiVersions: ["v1"]
@@ -2993,6 +3046,58 @@ webhooks:
 						testManifest.ExpectUnstructured(vwc.GetKind(), vwc.GetNamespace(), vwc.GetName()).To(BeEquivalentTo(vwc))
 					})
 
+					It("creates the mutating webhook configuration when enabled", func() {
+						mwc := makeUnstructured(`
+
+apiVersion: admissionregistration.k8s.io/v1
+kind: MutatingWebhookConfiguration
+metadata:
+  name: gloo-gateway-mutation-webhook-` + namespace + `
+  labels:
+    app: gloo
+    gloo: gateway
+  annotations:
+    "helm.sh/hook": pre-install
+    "helm.sh/hook-weight": "5" # should come before cert-gen job
+webhooks:
+ - name: gateway-mutation.` + namespace + `.svc  # must be a domain with at least three segments separated by dots
+   clientConfig:
+     service:
+       name: gateway
+       namespace: ` + namespace + `
+       path: "/mutation"
+     caBundle: "" # update manually or use certgen job
+   rules:
+     - operations: [ "CREATE", "UPDATE" ]
+       apiGroups: ["gateway.solo.io"]
+       apiVersions: ["v1"]
+       resources: ["virtualservices", "routetables"]
+     - operations: [ "CREATE", "UPDATE" ]
+       apiGroups: ["gloo.solo.io"]
+       apiVersions: ["v1"]
+       resources: ["upstreams"]
+   sideEffects: None
+   matchPolicy: Exact
+   reinvocationPolicy: IfNeeded
+   admissionReviewVersions:
+     - v1beta1
+   failurePolicy: Ignore
+
+`)
+						prepareMakefile(namespace, helmValues{
+							valuesArgs: []string{"gateway.validation.mutatingWebhook.enabled=true"},
+						})
+						testManifest.ExpectUnstructured(mwc.GetKind(), mwc.GetNamespace(), mwc.GetName()).To(BeEquivalentTo(mwc))
+					})
+
+					It("does not create the mutating webhook configuration by default", func() {
+						prepareMakefile(namespace, helmValues{})
+						mwcs := testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+							return resource.GetKind() == "MutatingWebhookConfiguration"
+						})
+						Expect(mwcs.NumResources()).To(Equal(0))
+					})
+
 					It("adds the validation port and mounts the certgen secret to the gateway deployment", func() {
 
 						gwDeployment := makeUnstructured(`
@@ -4529,6 +4634,7 @@ metadata:
 					Entry("5-gateway-service", "gateway.service.kubeResourceOverride"),
 					Entry("5-gateway-service-account", "gateway.serviceAccount.kubeResourceOverride"),
 					Entry("5-gateway-validation-webhook-configuration", "gateway.validation.webhook.kubeResourceOverride"),
+					Entry("5-gateway-mutation-webhook-configuration", "gateway.validation.mutatingWebhook.kubeResourceOverride", "gateway.validation.mutatingWebhook.enabled=true"),
 					Entry("6-access-logger-deployment", "accessLogger.deployment.kubeResourceOverride", "accessLogger.enabled=true"),
 					Entry("6-access-logger-service", "accessLogger.service.kubeResourceOverride", "accessLogger.enabled=true"),
 					Entry("6.5-gateway-certgen-job", "gateway.certGenJob.kubeResourceOverride"),
@@ -82,7 +82,12 @@ var _ = Describe("RBAC Test", func() {
 						Rules: []rbacv1.PolicyRule{
 							{
 								APIGroups: []string{""},
-								Resources: []string{"pods", "services", "secrets", "endpoints", "configmaps", "namespaces"},
+								Resources: []string{"pods", "services", "secrets", "endpoints", "configmaps", "namespaces", "nodes"},
+								Verbs:     []string{"get", "list", "watch"},
+							},
+							{
+								APIGroups: []string{"discovery.k8s.io"},
+								Resources: []string{"endpointslices"},
 								Verbs:     []string{"get", "list", "watch"},
 							},
 						},
@@ -55,6 +55,8 @@ func cmd(ctx context.Context) *cobra.Command {
 		"name of the server cert authority as it will be stored in the secret data")
 	pFlags.StringVar(&opts.ValidatingWebhookConfigurationName, "validating-webhook-configuration-name", "",
 		"name of the ValidatingWebhookConfiguration to patch with the generated CA bundle. leave empty to skip this step.")
+	pFlags.StringVar(&opts.MutatingWebhookConfigurationName, "mutating-webhook-configuration-name", "",
+		"name of the MutatingWebhookConfiguration to patch with the generated CA bundle. leave empty to skip this step.")
 
 	return cmd
 }
@@ -0,0 +1,48 @@
+package kube
+
+import (
+	"context"
+
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+func UpdateMutatingWebhookConfigurationCaBundle(ctx context.Context, kube kubernetes.Interface, mwcName string, cfg WebhookTlsConfig) error {
+	contextutils.LoggerFrom(ctx).Infow("attempting to patch caBundle for MutatingWebhookConfiguration", zap.String("svc", cfg.ServiceName), zap.String("mwc", mwcName))
+
+	mwc, err := kube.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(ctx, mwcName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to retrieve mwc")
+	}
+
+	setMutatingCaBundle(ctx, mwc, cfg)
+
+	if _, err := kube.AdmissionregistrationV1().MutatingWebhookConfigurations().Update(ctx, mwc, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to update mwc")
+	}
+
+	return nil
+}
+
+func setMutatingCaBundle(ctx context.Context, mwc *v1.MutatingWebhookConfiguration, cfg WebhookTlsConfig) {
+	for i, wh := range mwc.Webhooks {
+		if wh.ClientConfig.Service == nil {
+			continue
+		}
+
+		svcName, svcNamespace := wh.ClientConfig.Service.Name, wh.ClientConfig.Service.Namespace
+
+		// if we find a webhook cfg that targets our service, update it
+		if svcName == cfg.ServiceName && svcNamespace == cfg.ServiceNamespace {
+			wh.ClientConfig.CABundle = cfg.CaBundle
+
+			mwc.Webhooks[i] = wh
+
+			contextutils.LoggerFrom(ctx).Infow("set CA bundle on MutatingWebhookConfiguration", zap.String("svc", svcName), zap.String("mwc", mwc.Name), zap.Int("webhook", i))
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package kube_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/solo-io/gloo/jobs/pkg/kube"
+)
+
+var _ = Describe("MutatingWebhookConfiguration", func() {
+	It("updates a mwc with the provided cacert", func() {
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer func() { cancel() }()
+
+		kube := fake.NewSimpleClientset()
+		mwcCfg := WebhookTlsConfig{
+			ServiceName:      "mysvc",
+			ServiceNamespace: "mynamespace",
+			CaBundle:         []byte{1, 2, 3},
+		}
+
+		mwcName := "mymwc"
+
+		expectedMwc, err := kube.AdmissionregistrationV1().MutatingWebhookConfigurations().Create(ctx, &v1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: mwcName},
+			Webhooks: []v1.MutatingWebhook{
+				{Name: "ignored"},
+				{
+					Name: "foo",
+					ClientConfig: v1.WebhookClientConfig{
+						Service: &v1.ServiceReference{
+							Name:      mwcCfg.ServiceName,
+							Namespace: mwcCfg.ServiceNamespace,
+						},
+					},
+				},
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedMwc.Webhooks[1].ClientConfig.CABundle = mwcCfg.CaBundle
+
+		err = UpdateMutatingWebhookConfigurationCaBundle(context.TODO(), kube, mwcName, mwcCfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		patchedMwc, err := kube.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(ctx, mwcName, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(patchedMwc).To(Equal(expectedMwc))
+	})
+})
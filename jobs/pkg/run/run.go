@@ -24,6 +24,7 @@ type Options struct {
 	ServerKeySecretFileName     string
 
 	ValidatingWebhookConfigurationName string
+	MutatingWebhookConfigurationName   string
 }
 
 func Run(ctx context.Context, opts Options) error {
@@ -82,22 +83,26 @@ func Run(ctx context.Context, opts Options) error {
 		return eris.Wrapf(err, "failed creating secret")
 	}
 
-	vwcName := opts.ValidatingWebhookConfigurationName
-	if vwcName == "" {
-		contextutils.LoggerFrom(ctx).Infof("no ValidatingWebhookConfiguration provided. finished successfully.")
-		return nil
-	}
-
-	vwcConfig := kube.WebhookTlsConfig{
+	webhookConfig := kube.WebhookTlsConfig{
 		ServiceName:      opts.SvcName,
 		ServiceNamespace: opts.SvcNamespace,
 		CaBundle:         certs.CaCertificate,
 	}
 
-	if err := kube.UpdateValidatingWebhookConfigurationCaBundle(ctx, kubeClient, vwcName, vwcConfig); err != nil {
+	vwcName := opts.ValidatingWebhookConfigurationName
+	if vwcName == "" {
+		contextutils.LoggerFrom(ctx).Infof("no ValidatingWebhookConfiguration provided.")
+	} else if err := kube.UpdateValidatingWebhookConfigurationCaBundle(ctx, kubeClient, vwcName, webhookConfig); err != nil {
 		return eris.Wrapf(err, "failed patching validating webhook config")
 	}
 
+	mwcName := opts.MutatingWebhookConfigurationName
+	if mwcName == "" {
+		contextutils.LoggerFrom(ctx).Infof("no MutatingWebhookConfiguration provided.")
+	} else if err := kube.UpdateMutatingWebhookConfigurationCaBundle(ctx, kubeClient, mwcName, webhookConfig); err != nil {
+		return eris.Wrapf(err, "failed patching mutating webhook config")
+	}
+
 	contextutils.LoggerFrom(ctx).Infof("finished successfully.")
 
 	return nil
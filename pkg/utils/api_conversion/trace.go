@@ -1,10 +1,16 @@
 package api_conversion
 
 import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoytrace "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
 	envoytrace_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/trace/v3"
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
+const OpenTelemetryConfigTypeUrl = "type.googleapis.com/envoy.config.trace.v3.OpenTelemetryConfig"
+
 // Converts between Envoy and Gloo/solokit versions of envoy protos
 // This is required because go-control-plane dropped gogoproto in favor of goproto
 // in v0.9.0, but solokit depends on gogoproto (and the generated deep equals it creates).
@@ -40,3 +46,30 @@ func ToEnvoyZipkinCollectorEndpointVersion(version envoytrace_gloo.ZipkinConfig_
 	}
 	return envoytrace.ZipkinConfig_HTTP_JSON
 }
+
+// The version of go-control-plane we use does not include the config of envoy's OpenTelemetry tracer,
+// so it is marshalled by hand from its grpc_service (field 1) and service_name (field 2).
+func ToEnvoyOpenTelemetryConfiguration(glooOpenTelemetryConfig *envoytrace_gloo.OpenTelemetryConfig, clusterName string) (*any.Any, error) {
+	grpcService, err := proto.Marshal(&envoy_config_core_v3.GrpcService{
+		TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+			EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+				ClusterName: clusterName,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var value []byte
+	value = protowire.AppendTag(value, 1, protowire.BytesType)
+	value = protowire.AppendBytes(value, grpcService)
+	if serviceName := glooOpenTelemetryConfig.GetServiceName(); serviceName != "" {
+		value = protowire.AppendTag(value, 2, protowire.BytesType)
+		value = protowire.AppendString(value, serviceName)
+	}
+	return &any.Any{
+		TypeUrl: OpenTelemetryConfigTypeUrl,
+		Value:   value,
+	}, nil
+}
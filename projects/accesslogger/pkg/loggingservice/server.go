@@ -2,6 +2,7 @@ package loggingservice
 
 import (
 	"context"
+	"io"
 
 	envoyals "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
 	"github.com/solo-io/go-utils/contextutils"
@@ -21,20 +22,49 @@ type Server struct {
 var _ envoyals.AccessLogServiceServer = new(Server)
 
 func (s *Server) StreamAccessLogs(srv envoyals.AccessLogService_StreamAccessLogsServer) error {
-	msg, err := srv.Recv()
-	if err != nil {
-		return err
-	}
+	// the stream context is canceled once envoy closes the stream, which unblocks the callbacks waiting on it
+	streamCtx := contextutils.WithExistingLogger(srv.Context(), contextutils.LoggerFrom(s.opts.Ctx))
 
-	ctx := contextutils.WithLoggerValues(
-		s.opts.Ctx,
-		zap.String("logger_name", msg.GetIdentifier().GetLogName()),
-		zap.String("node_id", msg.GetIdentifier().GetNode().GetId()),
-		zap.String("node_cluster", msg.GetIdentifier().GetNode().GetCluster()),
-		zap.Any("node_locality", msg.GetIdentifier().GetNode().GetLocality()),
-		zap.Any("node_metadata", msg.GetIdentifier().GetNode().GetMetadata()),
+	var (
+		ctx        context.Context
+		identifier *envoyals.StreamAccessLogsMessage_Identifier
 	)
-	contextutils.LoggerFrom(ctx).Info("received access log message")
+	for {
+		msg, err := srv.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// only the first message of a stream identifies the envoy node and log it comes from
+		if msg.GetIdentifier() != nil {
+			identifier = msg.GetIdentifier()
+			ctx = contextutils.WithLoggerValues(
+				streamCtx,
+				zap.String("logger_name", identifier.GetLogName()),
+				zap.String("node_id", identifier.GetNode().GetId()),
+				zap.String("node_cluster", identifier.GetNode().GetCluster()),
+				zap.Any("node_locality", identifier.GetNode().GetLocality()),
+				zap.Any("node_metadata", identifier.GetNode().GetMetadata()),
+			)
+			contextutils.LoggerFrom(ctx).Info("received access log stream")
+		} else {
+			msg.Identifier = identifier
+		}
+		if ctx == nil {
+			ctx = streamCtx
+		}
+
+		if err := s.handleMessage(ctx, msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handleMessage(ctx context.Context, msg *envoyals.StreamAccessLogsMessage) error {
+	contextutils.LoggerFrom(ctx).Debug("received access log message")
 
 	if s.opts.Ordered {
 		for _, cb := range s.opts.Callbacks {
@@ -42,19 +72,16 @@ func (s *Server) StreamAccessLogs(srv envoyals.AccessLogService_StreamAccessLogs
 				return err
 			}
 		}
-	} else {
-		eg := errgroup.Group{}
-		for _, cb := range s.opts.Callbacks {
-			cb := cb
-			eg.Go(func() error {
-				return cb(ctx, msg)
-			})
-		}
-		if err := eg.Wait(); err != nil {
-			return err
-		}
+		return nil
+	}
+	eg := errgroup.Group{}
+	for _, cb := range s.opts.Callbacks {
+		cb := cb
+		eg.Go(func() error {
+			return cb(ctx, msg)
+		})
 	}
-	return nil
+	return eg.Wait()
 }
 
 type Options struct {
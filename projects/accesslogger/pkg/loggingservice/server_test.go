@@ -0,0 +1,90 @@
+package loggingservice_test
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyals "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/loggingservice"
+	"google.golang.org/grpc"
+)
+
+// a stream returning the messages given, then io.EOF
+type fakeStream struct {
+	grpc.ServerStream
+	messages []*envoyals.StreamAccessLogsMessage
+}
+
+func (s *fakeStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeStream) Recv() (*envoyals.StreamAccessLogsMessage, error) {
+	if len(s.messages) == 0 {
+		return nil, io.EOF
+	}
+	msg := s.messages[0]
+	s.messages = s.messages[1:]
+	return msg, nil
+}
+
+func (s *fakeStream) SendAndClose(*envoyals.StreamAccessLogsResponse) error {
+	return nil
+}
+
+var _ = Describe("Server", func() {
+
+	identifier := &envoyals.StreamAccessLogsMessage_Identifier{
+		Node:    &envoy_config_core_v3.Node{Id: "gateway-proxy"},
+		LogName: "als",
+	}
+
+	It("processes every message of a stream, with the identifier of the first one", func() {
+		var (
+			lock     sync.Mutex
+			received []*envoyals.StreamAccessLogsMessage
+		)
+		server := loggingservice.NewServer(loggingservice.Options{
+			Callbacks: loggingservice.AlsCallbackList{
+				func(ctx context.Context, message *envoyals.StreamAccessLogsMessage) error {
+					lock.Lock()
+					defer lock.Unlock()
+					received = append(received, message)
+					return nil
+				},
+			},
+		})
+
+		err := server.StreamAccessLogs(&fakeStream{messages: []*envoyals.StreamAccessLogsMessage{
+			{Identifier: identifier},
+			{},
+			{},
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(received).To(HaveLen(3))
+		for _, message := range received {
+			Expect(message.GetIdentifier()).To(Equal(identifier))
+		}
+	})
+
+	It("stops at the first error of a callback", func() {
+		calls := 0
+		server := loggingservice.NewServer(loggingservice.Options{
+			Ordered: true,
+			Callbacks: loggingservice.AlsCallbackList{
+				func(ctx context.Context, message *envoyals.StreamAccessLogsMessage) error {
+					calls++
+					return io.ErrUnexpectedEOF
+				},
+			},
+		})
+
+		err := server.StreamAccessLogs(&fakeStream{messages: []*envoyals.StreamAccessLogsMessage{{Identifier: identifier}, {}}})
+		Expect(err).To(MatchError(io.ErrUnexpectedEOF))
+		Expect(calls).To(Equal(1))
+	})
+})
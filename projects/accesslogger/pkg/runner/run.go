@@ -5,12 +5,10 @@ import (
 	"fmt"
 	"net"
 
-	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
 	pb "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
-	_struct "github.com/golang/protobuf/ptypes/struct"
 	"github.com/solo-io/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/accesslogger/pkg/loggingservice"
-	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/healthchecker"
 	"github.com/solo-io/go-utils/stats"
@@ -97,13 +95,13 @@ func Run() {
 						//
 						// follow the guide here to create requests with the proper transformation to populate 'pod_name' in the access logs:
 						// https://docs.solo.io/gloo-edge/latest/guides/traffic_management/request_processing/transformations/enrich_access_logs/#update-virtual-service
-						podName := getTransformationValueFromDynamicMetadata("pod_name", meta)
+						podName := sinks.TransformationValue("pod_name", meta)
 
 						// we could change the claim to any other jwt claim, such as client_id
 						//
 						// follow the guide here to create requests with a jwt that has the 'iss' claim, to populate issuer in the access logs:
 						// https://docs.solo.io/gloo-edge/latest/guides/security/auth/jwt/access_control/#appendix---use-a-remote-json-web-key-set-jwks-server
-						issuer := getClaimFromJwtInDynamicMetadata("iss", meta)
+						issuer := sinks.JwtClaim("iss", meta)
 
 						utils.MeasureOne(
 							ctx,
@@ -113,13 +111,12 @@ func Run() {
 							tag.Insert(requestMethodKey, v.GetRequest().GetRequestMethod().String()))
 
 						// this includes the time filters take during the processing of the request and response.
-						downstreamRespTime := v.GetCommonProperties().GetTimeToLastDownstreamTxByte()
-						downstreamRespTimeNs := int64(downstreamRespTime.GetNanos()) + (downstreamRespTime.GetSeconds()*1 ^ 9)
+						downstreamRespTimeNs := sinks.DownstreamRespTimeNs(v)
 
 						// if envoy is buffering the request before sending upstream, you want the following
-						upstreamRespTimeNs := lastToFirstNs(v)
+						upstreamRespTimeNs := sinks.LastToFirstNs(v)
 						// otherwise, you want this
-						// upstreamRespTimeNs := firstToFirstNs(v)
+						// upstreamRespTimeNs := sinks.FirstToFirstNs(v)
 
 						utils.Measure(
 							ctx,
@@ -169,9 +166,22 @@ func Run() {
 		},
 		Ctx: ctx,
 	}
+
+	sinksConfig, err := clientSettings.SinksConfig()
+	if err != nil {
+		panic(err)
+	}
+	if len(sinksConfig.Sinks) > 0 {
+		pipeline, err := sinks.NewPipeline(ctx, sinksConfig)
+		if err != nil {
+			panic(err)
+		}
+		defer pipeline.Close()
+		opts.Callbacks = append(opts.Callbacks, pipeline.Callback)
+	}
 	service := loggingservice.NewServer(opts)
 
-	err := RunWithSettings(ctx, service, clientSettings)
+	err = RunWithSettings(ctx, service, clientSettings)
 
 	if err != nil {
 		if ctx.Err() == nil {
@@ -218,52 +228,3 @@ func StartAccessLog(ctx context.Context, clientSettings Settings, service *loggi
 
 	return srv.Serve(lis)
 }
-
-func getTransformationValueFromDynamicMetadata(key string, filterMetadata map[string]*_struct.Struct) string {
-	transformationMeta := filterMetadata[transformation.FilterName]
-	for tKey, tVal := range transformationMeta.GetFields() {
-		if tKey == key {
-			return tVal.GetStringValue()
-		}
-	}
-	return ""
-}
-
-func getClaimFromJwtInDynamicMetadata(claim string, filterMetadata map[string]*_struct.Struct) string {
-	providerByJwt := filterMetadata["envoy.filters.http.jwt_authn"]
-	jwts := providerByJwt.GetFields()
-	for _, jwt := range jwts {
-		claims := jwt.GetStructValue()
-		if claims != nil {
-			claimsMap := claims.GetFields()
-			if val, ok := claimsMap[claim]; ok {
-				return val.GetStringValue()
-			}
-		}
-	}
-	return ""
-}
-
-func firstToFirstNs(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) int64 {
-	timeToFirstUpstreamRxByte := entry.GetCommonProperties().GetTimeToFirstUpstreamRxByte()
-	timeToFirstUpstreamRxByteNs := int64(timeToFirstUpstreamRxByte.GetNanos()) + (timeToFirstUpstreamRxByte.GetSeconds()*1 ^ 9)
-	timeToFirstUpstreamTxByte := entry.GetCommonProperties().GetTimeToFirstUpstreamTxByte()
-	timeToFirstUpstreamTxByteNs := int64(timeToFirstUpstreamTxByte.GetNanos()) + (timeToFirstUpstreamTxByte.GetSeconds()*1 ^ 9)
-
-	// this excludes the time filters take during the processing of the request and response.
-	upstreamRespTimeNs := timeToFirstUpstreamRxByteNs - timeToFirstUpstreamTxByteNs
-	return upstreamRespTimeNs
-}
-
-func lastToFirstNs(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) int64 {
-	timeToFirstUpstreamRxByte := entry.GetCommonProperties().GetTimeToFirstUpstreamRxByte()
-	timeToFirstUpstreamRxByteNs := int64(timeToFirstUpstreamRxByte.GetNanos()) + (timeToFirstUpstreamRxByte.GetSeconds()*1 ^ 9)
-	timeToLastUpstreamTxByte := entry.GetCommonProperties().GetTimeToLastUpstreamTxByte()
-	timeToLastUpstreamTxByteNs := int64(timeToLastUpstreamTxByte.GetNanos()) + (timeToLastUpstreamTxByte.GetSeconds()*1 ^ 9)
-
-	// this excludes the time filters take during the processing of the request and response.
-	// this could, in theory, be negative. for example, the upstream could reject based on the
-	// request headers and respond before the request body had finished transmitting upstream.
-	upstreamRespTimeNs := timeToFirstUpstreamRxByteNs - timeToLastUpstreamTxByteNs
-	return upstreamRespTimeNs
-}
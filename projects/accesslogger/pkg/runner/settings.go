@@ -2,12 +2,26 @@ package runner
 
 import (
 	"github.com/kelseyhightower/envconfig"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
 )
 
 type Settings struct {
 	DebugPort   int    `envconfig:"DEBUG_PORT" default:"9091"`
 	ServerPort  int    `envconfig:"SERVER_PORT" default:"8083"`
 	ServiceName string `envconfig:"SERVICE_NAME" default:"AccessLog"`
+
+	// The YAML or JSON file configuring the sinks the access logs are sent to, and their filtering.
+	// The settings below are ignored if it is set.
+	SinksConfigFile string `envconfig:"SINKS_CONFIG_FILE"`
+	// A comma separated list of sinks, each either `stdout`, `file:<path>`, `http:<url>` or `otlp:<url>`
+	Sinks string `envconfig:"SINKS"`
+	// The fields of the records sent to the sinks, all fields if empty
+	Fields []string `envconfig:"FIELDS"`
+	// Only the entries matching these are sent to the sinks
+	FilterKinds           []string `envconfig:"FILTER_KINDS"`
+	FilterMinResponseCode uint32   `envconfig:"FILTER_MIN_RESPONSE_CODE"`
+	FilterClusters        []string `envconfig:"FILTER_CLUSTERS"`
+	FilterMethods         []string `envconfig:"FILTER_METHODS"`
 }
 
 func NewSettings() Settings {
@@ -20,3 +34,24 @@ func NewSettings() Settings {
 
 	return s
 }
+
+// SinksConfig returns the config of the sinks, read from the sinks config file if set.
+func (s Settings) SinksConfig() (*sinks.Config, error) {
+	if s.SinksConfigFile != "" {
+		return sinks.LoadConfig(s.SinksConfigFile)
+	}
+	sinkConfigs, err := sinks.ParseSinks(s.Sinks)
+	if err != nil {
+		return nil, err
+	}
+	return &sinks.Config{
+		Fields: s.Fields,
+		Filter: sinks.Filter{
+			Kinds:           s.FilterKinds,
+			MinResponseCode: s.FilterMinResponseCode,
+			Clusters:        s.FilterClusters,
+			Methods:         s.FilterMethods,
+		},
+		Sinks: sinkConfigs,
+	}, nil
+}
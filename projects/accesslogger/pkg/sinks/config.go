@@ -0,0 +1,199 @@
+package sinks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/rotisserie/eris"
+)
+
+const (
+	StdoutSink = "stdout"
+	FileSink   = "file"
+	HttpSink   = "http"
+	OtlpSink   = "otlp"
+)
+
+const (
+	DefaultBatchSize     = 100
+	DefaultFlushInterval = time.Second
+	DefaultQueueSize     = 1000
+	DefaultTimeout       = 5 * time.Second
+	// The size after which the files are rotated, and the number of rotated files kept, if not specified
+	DefaultMaxSizeBytes = 100 * 1024 * 1024
+	DefaultMaxBackups   = 3
+)
+
+var (
+	UnknownSinkTypeError = func(sinkType string) error {
+		return eris.Errorf("unknown access log sink type %v, expected one of %v, %v, %v or %v", sinkType, StdoutSink, FileSink, HttpSink, OtlpSink)
+	}
+	MissingSinkFieldError = func(sinkType, field string) error {
+		return eris.Errorf("%v access log sink requires a %v", sinkType, field)
+	}
+)
+
+// Config configures which access log entries are sent to which sinks.
+type Config struct {
+	// The fields of the records sent to the sinks, all fields if empty
+	Fields []string `json:"fields,omitempty"`
+	// The entries not matching the filter are not sent to the sinks
+	Filter Filter        `json:"filter,omitempty"`
+	Sinks  []*SinkConfig `json:"sinks,omitempty"`
+}
+
+// Filter selects the access log entries sent to the sinks. Unset criteria match every entry.
+type Filter struct {
+	// The kinds of entries sent, either http or tcp
+	Kinds []string `json:"kinds,omitempty"`
+	// Only the http entries whose response code is at least this one are sent
+	MinResponseCode uint32 `json:"minResponseCode,omitempty"`
+	// The upstream clusters of the entries sent
+	Clusters []string `json:"clusters,omitempty"`
+	// The request methods of the http entries sent
+	Methods []string `json:"methods,omitempty"`
+}
+
+// SinkConfig configures a sink the access log records are sent to, in batches.
+type SinkConfig struct {
+	// One of stdout, file, http or otlp
+	Type string `json:"type"`
+
+	// The file the records are written to, as JSON lines, by file sinks
+	Path string `json:"path,omitempty"`
+	// The size after which the file is rotated, and the number of rotated files kept
+	MaxSizeBytes int64 `json:"maxSizeBytes,omitempty"`
+	MaxBackups   int   `json:"maxBackups,omitempty"`
+
+	// The URL the records are POSTed to by http and otlp sinks. http sinks POST them as a JSON array,
+	// otlp sinks as an OTLP/HTTP logs export request.
+	Url     string            `json:"url,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Timeout Duration          `json:"timeout,omitempty"`
+
+	// The maximum number of records per batch, and how long a batch is held before it is sent if not full
+	BatchSize     int      `json:"batchSize,omitempty"`
+	FlushInterval Duration `json:"flushInterval,omitempty"`
+	// The number of records queued for the sink. Once full, the access log streams are not read until it frees,
+	// pushing back on Envoy.
+	QueueSize int `json:"queueSize,omitempty"`
+}
+
+// Duration is a time.Duration read from a string such as `10s`.
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// LoadConfig reads the config from a YAML or JSON file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, eris.Wrapf(err, "reading access log config %v", path)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, eris.Wrapf(err, "parsing access log config %v", path)
+	}
+	return &cfg, nil
+}
+
+// ParseSinks parses a comma separated list of sinks, each either `stdout`, `file:<path>`, `http:<url>` or `otlp:<url>`.
+func ParseSinks(sinks string) ([]*SinkConfig, error) {
+	var out []*SinkConfig
+	for _, sink := range strings.Split(sinks, ",") {
+		sink = strings.TrimSpace(sink)
+		if sink == "" {
+			continue
+		}
+		sinkType, target := sink, ""
+		if i := strings.Index(sink, ":"); i >= 0 {
+			sinkType, target = sink[:i], sink[i+1:]
+		}
+		cfg := &SinkConfig{Type: sinkType}
+		switch sinkType {
+		case StdoutSink:
+		case FileSink:
+			cfg.Path = target
+		case HttpSink, OtlpSink:
+			cfg.Url = target
+		default:
+			return nil, UnknownSinkTypeError(sinkType)
+		}
+		out = append(out, cfg)
+	}
+	return out, nil
+}
+
+func (s *SinkConfig) validate() error {
+	switch s.Type {
+	case StdoutSink:
+	case FileSink:
+		if s.Path == "" {
+			return MissingSinkFieldError(s.Type, "path")
+		}
+	case HttpSink, OtlpSink:
+		if s.Url == "" {
+			return MissingSinkFieldError(s.Type, "url")
+		}
+	default:
+		return UnknownSinkTypeError(s.Type)
+	}
+	return nil
+}
+
+func (s *SinkConfig) name() string {
+	switch s.Type {
+	case FileSink:
+		return s.Type + ":" + s.Path
+	case HttpSink, OtlpSink:
+		return s.Type + ":" + s.Url
+	default:
+		return s.Type
+	}
+}
+
+func (s *SinkConfig) batchSize() int {
+	if s.BatchSize > 0 {
+		return s.BatchSize
+	}
+	return DefaultBatchSize
+}
+
+func (s *SinkConfig) flushInterval() time.Duration {
+	if s.FlushInterval > 0 {
+		return time.Duration(s.FlushInterval)
+	}
+	return DefaultFlushInterval
+}
+
+func (s *SinkConfig) queueSize() int {
+	if s.QueueSize > 0 {
+		return s.QueueSize
+	}
+	return DefaultQueueSize
+}
+
+func (s *SinkConfig) timeout() time.Duration {
+	if s.Timeout > 0 {
+		return time.Duration(s.Timeout)
+	}
+	return DefaultTimeout
+}
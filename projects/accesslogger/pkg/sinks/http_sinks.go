@@ -0,0 +1,158 @@
+package sinks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/rotisserie/eris"
+	collogs "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	logsv1 "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcev1 "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// the name of the service the OTLP log records are attributed to
+const otlpServiceName = "gloo-access-logger"
+
+var UnexpectedResponseError = func(url string, code int, body []byte) error {
+	return eris.Errorf("POST %v returned %v: %s", url, code, body)
+}
+
+// httpSink POSTs the batches of records to a URL, encoded by its `encode` function.
+type httpSink struct {
+	client      *http.Client
+	url         string
+	headers     map[string]string
+	contentType string
+	encode      func(records []Record) ([]byte, error)
+}
+
+// NewHttpSink returns a sink POSTing the records to `url` as a JSON array.
+func NewHttpSink(url string, headers map[string]string, timeout time.Duration) Sink {
+	return &httpSink{
+		client:      &http.Client{Timeout: timeout},
+		url:         url,
+		headers:     headers,
+		contentType: "application/json",
+		encode: func(records []Record) ([]byte, error) {
+			return json.Marshal(records)
+		},
+	}
+}
+
+// NewOtlpSink returns a sink exporting the records to an OTLP/HTTP logs endpoint, such as `http://collector:4318/v1/logs`.
+func NewOtlpSink(url string, headers map[string]string, timeout time.Duration) Sink {
+	return &httpSink{
+		client:      &http.Client{Timeout: timeout},
+		url:         url,
+		headers:     headers,
+		contentType: "application/x-protobuf",
+		encode: func(records []Record) ([]byte, error) {
+			return proto.Marshal(OtlpExportRequest(records))
+		},
+	}
+}
+
+func (s *httpSink) Write(records []Record) error {
+	body, err := s.encode(records)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", s.contentType)
+	for name, value := range s.headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return UnexpectedResponseError(s.url, resp.StatusCode, respBody)
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
+
+func (s *httpSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// OtlpExportRequest converts records to an OTLP logs export request. The fields of the records are the attributes
+// of the log records, which are timestamped with the start time of the requests.
+func OtlpExportRequest(records []Record) *collogs.ExportLogsServiceRequest {
+	logs := make([]*logsv1.LogRecord, 0, len(records))
+	for _, record := range records {
+		logRecord := &logsv1.LogRecord{
+			SeverityNumber: logsv1.SeverityNumber_SEVERITY_NUMBER_INFO,
+			SeverityText:   "INFO",
+			Name:           fmt.Sprintf("%v access log", record[KindField]),
+		}
+		if startTime, ok := record[StartTimeField].(time.Time); ok {
+			logRecord.TimeUnixNano = uint64(startTime.UnixNano())
+		}
+		keys := make([]string, 0, len(record))
+		for key := range record {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			logRecord.Attributes = append(logRecord.Attributes, &commonv1.KeyValue{Key: key, Value: otlpValue(record[key])})
+		}
+		logs = append(logs, logRecord)
+	}
+	return &collogs.ExportLogsServiceRequest{
+		ResourceLogs: []*logsv1.ResourceLogs{{
+			Resource: &resourcev1.Resource{
+				Attributes: []*commonv1.KeyValue{{
+					Key:   "service.name",
+					Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: otlpServiceName}},
+				}},
+			},
+			InstrumentationLibraryLogs: []*logsv1.InstrumentationLibraryLogs{{Logs: logs}},
+		}},
+	}
+}
+
+func otlpValue(value interface{}) *commonv1.AnyValue {
+	switch v := value.(type) {
+	case string:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: v}}
+	case bool:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_BoolValue{BoolValue: v}}
+	case int64:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_IntValue{IntValue: v}}
+	case uint32:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_IntValue{IntValue: int64(v)}}
+	case float64:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_DoubleValue{DoubleValue: v}}
+	case time.Time:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: v.Format(time.RFC3339Nano)}}
+	case map[string]string:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		list := &commonv1.KeyValueList{}
+		for _, key := range keys {
+			list.Values = append(list.Values, &commonv1.KeyValue{Key: key, Value: otlpValue(v[key])})
+		}
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_KvlistValue{KvlistValue: list}}
+	default:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: fmt.Sprint(v)}}
+	}
+}
@@ -0,0 +1,237 @@
+package sinks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils"
+	"github.com/solo-io/go-utils/contextutils"
+	ocstats "go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+)
+
+func init() {
+	view.Register(sinkRecordsView, sinkWriteTimeView, sinkBackpressureView)
+}
+
+const (
+	writtenResult = "written"
+	failedResult  = "failed"
+)
+
+var (
+	sinkKey, _   = tag.NewKey("sink")
+	resultKey, _ = tag.NewKey("result")
+
+	mSinkRecords    = ocstats.Int64("gloo.solo.io/accesslogging/sink_records", "The number of records written to or failed to be written to an access log sink.", ocstats.UnitDimensionless)
+	sinkRecordsView = &view.View{
+		Name:        "gloo.solo.io/accesslogging/sink_records",
+		Measure:     mSinkRecords,
+		Description: "The number of records written to or failed to be written to an access log sink.",
+		Aggregation: view.Sum(),
+		TagKeys:     []tag.Key{sinkKey, resultKey},
+	}
+
+	mSinkWriteTime    = ocstats.Int64("gloo.solo.io/accesslogging/sink_write_time", "The time to write a batch of records to an access log sink (ms).", ocstats.UnitMilliseconds)
+	sinkWriteTimeView = &view.View{
+		Name:        "gloo.solo.io/accesslogging/sink_write_time",
+		Measure:     mSinkWriteTime,
+		Description: "The time to write a batch of records to an access log sink (ms).",
+		Aggregation: view.Distribution(1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000),
+		TagKeys:     []tag.Key{sinkKey},
+	}
+
+	mSinkBackpressure    = ocstats.Int64("gloo.solo.io/accesslogging/sink_backpressure", "The number of records that waited for the queue of an access log sink to free.", ocstats.UnitDimensionless)
+	sinkBackpressureView = &view.View{
+		Name:        "gloo.solo.io/accesslogging/sink_backpressure",
+		Measure:     mSinkBackpressure,
+		Description: "The number of records that waited for the queue of an access log sink to free.",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{sinkKey},
+	}
+)
+
+var PipelineClosedError = eris.New("the access log pipeline is closed")
+
+// Pipeline filters the entries of the access log messages, and sends the fields selected to its sinks.
+// Each sink has its own queue, and writes the records in batches.
+type Pipeline struct {
+	fields []string
+	filter Filter
+	sinks  []*batchingSink
+}
+
+// NewPipeline starts the sinks configured, which run until the pipeline is closed.
+func NewPipeline(ctx context.Context, cfg *Config) (*Pipeline, error) {
+	var sinks []Sink
+	for _, sinkCfg := range cfg.Sinks {
+		sink, err := NewSink(sinkCfg)
+		if err != nil {
+			for _, sink := range sinks {
+				_ = sink.Close()
+			}
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return NewPipelineWithSinks(ctx, cfg, sinks), nil
+}
+
+// NewPipelineWithSinks starts a pipeline writing to the given sinks, which are batched as configured by the
+// sink configs of the same index.
+func NewPipelineWithSinks(ctx context.Context, cfg *Config, sinks []Sink) *Pipeline {
+	p := &Pipeline{
+		fields: cfg.Fields,
+		filter: cfg.Filter,
+	}
+	for i, sink := range sinks {
+		p.sinks = append(p.sinks, newBatchingSink(ctx, sink, cfg.Sinks[i]))
+	}
+	return p
+}
+
+// Callback is the access log service callback sending the records of the messages to the sinks.
+// It blocks while the queue of a sink is full.
+func (p *Pipeline) Callback(ctx context.Context, message *pb.StreamAccessLogsMessage) error {
+	for _, record := range RecordsFromMessage(message) {
+		if !p.filter.Matches(record) {
+			continue
+		}
+		record = record.Select(p.fields)
+		for _, sink := range p.sinks {
+			if err := sink.enqueue(ctx, record); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Close writes the records queued and closes the sinks.
+func (p *Pipeline) Close() error {
+	var errs *multierror.Error
+	for _, sink := range p.sinks {
+		if err := sink.close(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+type batchingSink struct {
+	ctx           context.Context
+	name          string
+	sink          Sink
+	batchSize     int
+	flushInterval time.Duration
+
+	queue    chan Record
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+	closeErr error
+	tagSink  tag.Mutator
+}
+
+func newBatchingSink(ctx context.Context, sink Sink, cfg *SinkConfig) *batchingSink {
+	name := cfg.name()
+	b := &batchingSink{
+		ctx:           contextutils.WithLoggerValues(ctx, zap.String("sink", name)),
+		name:          name,
+		sink:          sink,
+		batchSize:     cfg.batchSize(),
+		flushInterval: cfg.flushInterval(),
+		queue:         make(chan Record, cfg.queueSize()),
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+		tagSink:       tag.Insert(sinkKey, name),
+	}
+	go b.run()
+	return b
+}
+
+func (b *batchingSink) enqueue(ctx context.Context, record Record) error {
+	select {
+	case b.queue <- record:
+		return nil
+	default:
+	}
+
+	// the queue is full, which holds the stream until the sink catches up
+	utils.MeasureOne(b.ctx, mSinkBackpressure, b.tagSink)
+	select {
+	case b.queue <- record:
+		return nil
+	case <-b.stop:
+		return PipelineClosedError
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *batchingSink) run() {
+	defer close(b.done)
+	ticker := time.NewTicker(b.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Record, 0, b.batchSize)
+	flush := func() {
+		if len(batch) > 0 {
+			b.write(batch)
+			batch = make([]Record, 0, b.batchSize)
+		}
+	}
+	for {
+		select {
+		case record := <-b.queue:
+			batch = append(batch, record)
+			if len(batch) >= b.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-b.stop:
+			// write what was queued before the pipeline was closed
+			for {
+				select {
+				case record := <-b.queue:
+					batch = append(batch, record)
+					if len(batch) >= b.batchSize {
+						flush()
+					}
+				default:
+					flush()
+					b.closeErr = b.sink.Close()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (b *batchingSink) write(batch []Record) {
+	start := time.Now()
+	err := b.sink.Write(batch)
+	utils.Measure(b.ctx, mSinkWriteTime, time.Since(start).Milliseconds(), b.tagSink)
+	if err != nil {
+		contextutils.LoggerFrom(b.ctx).Warnw("failed to write access log records", zap.Int("records", len(batch)), zap.Error(err))
+		utils.Measure(b.ctx, mSinkRecords, int64(len(batch)), b.tagSink, tag.Insert(resultKey, failedResult))
+		return
+	}
+	utils.Measure(b.ctx, mSinkRecords, int64(len(batch)), b.tagSink, tag.Insert(resultKey, writtenResult))
+}
+
+func (b *batchingSink) close() error {
+	b.stopOnce.Do(func() { close(b.stop) })
+	<-b.done
+	if b.closeErr != nil {
+		return eris.Wrapf(b.closeErr, "closing access log sink %v", b.name)
+	}
+	return nil
+}
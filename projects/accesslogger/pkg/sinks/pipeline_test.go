@@ -0,0 +1,170 @@
+package sinks_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	pb "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
+)
+
+func httpLogs(entries ...*envoy_data_accesslog_v3.HTTPAccessLogEntry) *pb.StreamAccessLogsMessage {
+	return &pb.StreamAccessLogsMessage{
+		Identifier: &pb.StreamAccessLogsMessage_Identifier{
+			Node:    &envoy_config_core_v3.Node{Id: "gateway-proxy"},
+			LogName: "als",
+		},
+		LogEntries: &pb.StreamAccessLogsMessage_HttpLogs{
+			HttpLogs: &pb.StreamAccessLogsMessage_HTTPAccessLogEntries{LogEntry: entries},
+		},
+	}
+}
+
+func httpEntry(method envoy_config_core_v3.RequestMethod, path string, code uint32) *envoy_data_accesslog_v3.HTTPAccessLogEntry {
+	return &envoy_data_accesslog_v3.HTTPAccessLogEntry{
+		CommonProperties: &envoy_data_accesslog_v3.AccessLogCommon{UpstreamCluster: "default-petstore-8080_gloo-system"},
+		Request:          &envoy_data_accesslog_v3.HTTPRequestProperties{RequestMethod: method, Path: path},
+		Response:         &envoy_data_accesslog_v3.HTTPResponseProperties{ResponseCode: &wrappers.UInt32Value{Value: code}},
+	}
+}
+
+// records the batches written, blocking the writes until unblocked if `block` is set
+type fakeSink struct {
+	lock    sync.Mutex
+	batches [][]sinks.Record
+	block   chan struct{}
+	closed  bool
+}
+
+func (s *fakeSink) Write(records []sinks.Record) error {
+	if s.block != nil {
+		<-s.block
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.batches = append(s.batches, records)
+	return nil
+}
+
+func (s *fakeSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.closed = true
+	return nil
+}
+
+func (s *fakeSink) Batches() [][]sinks.Record {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.batches
+}
+
+var _ = Describe("Pipeline", func() {
+
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("flattens the entries of the messages to records", func() {
+		records := sinks.RecordsFromMessage(httpLogs(httpEntry(envoy_config_core_v3.RequestMethod_GET, "/pets", 200)))
+		Expect(records).To(HaveLen(1))
+		Expect(records[0]).To(HaveKeyWithValue(sinks.KindField, sinks.HttpKind))
+		Expect(records[0]).To(HaveKeyWithValue(sinks.NodeIdField, "gateway-proxy"))
+		Expect(records[0]).To(HaveKeyWithValue(sinks.RequestMethodField, "GET"))
+		Expect(records[0]).To(HaveKeyWithValue(sinks.RequestPathField, "/pets"))
+		Expect(records[0]).To(HaveKeyWithValue(sinks.ResponseCodeField, uint32(200)))
+		Expect(records[0]).To(HaveKeyWithValue(sinks.ClusterField, "default-petstore-8080_gloo-system"))
+	})
+
+	It("records the response times in nanoseconds", func() {
+		entry := httpEntry(envoy_config_core_v3.RequestMethod_GET, "/pets", 200)
+		entry.CommonProperties.TimeToLastDownstreamTxByte = ptypes.DurationProto(2500 * time.Millisecond)
+		entry.CommonProperties.TimeToFirstUpstreamTxByte = ptypes.DurationProto(100 * time.Millisecond)
+		entry.CommonProperties.TimeToLastUpstreamTxByte = ptypes.DurationProto(300 * time.Millisecond)
+		entry.CommonProperties.TimeToFirstUpstreamRxByte = ptypes.DurationProto(2100 * time.Millisecond)
+
+		records := sinks.RecordsFromMessage(httpLogs(entry))
+		Expect(records[0]).To(HaveKeyWithValue(sinks.DownstreamRespTimeField, (2500 * time.Millisecond).Nanoseconds()))
+		Expect(sinks.FirstToFirstNs(entry)).To(Equal((2 * time.Second).Nanoseconds()))
+		Expect(sinks.LastToFirstNs(entry)).To(Equal((1800 * time.Millisecond).Nanoseconds()))
+	})
+
+	It("filters the records and selects their fields", func() {
+		filter := sinks.Filter{MinResponseCode: 500, Methods: []string{"POST"}}
+		records := sinks.RecordsFromMessage(httpLogs(
+			httpEntry(envoy_config_core_v3.RequestMethod_POST, "/pets", 503),
+			httpEntry(envoy_config_core_v3.RequestMethod_POST, "/pets", 200),
+			httpEntry(envoy_config_core_v3.RequestMethod_GET, "/pets", 503),
+		))
+		Expect(filter.Matches(records[0])).To(BeTrue())
+		Expect(filter.Matches(records[1])).To(BeFalse())
+		Expect(filter.Matches(records[2])).To(BeFalse())
+
+		Expect(records[0].Select([]string{sinks.RequestPathField, sinks.ResponseCodeField})).To(Equal(sinks.Record{
+			sinks.RequestPathField:  "/pets",
+			sinks.ResponseCodeField: uint32(503),
+		}))
+	})
+
+	It("writes the records in batches, and the remaining ones when closed", func() {
+		sink := &fakeSink{}
+		pipeline := sinks.NewPipelineWithSinks(ctx, &sinks.Config{
+			Fields: []string{sinks.RequestPathField},
+			Filter: sinks.Filter{Kinds: []string{sinks.HttpKind}},
+			Sinks:  []*sinks.SinkConfig{{Type: "fake", BatchSize: 2, FlushInterval: sinks.Duration(time.Hour)}},
+		}, []sinks.Sink{sink})
+
+		for _, path := range []string{"/a", "/b", "/c"} {
+			Expect(pipeline.Callback(ctx, httpLogs(httpEntry(envoy_config_core_v3.RequestMethod_GET, path, 200)))).NotTo(HaveOccurred())
+		}
+		Eventually(sink.Batches).Should(HaveLen(1))
+		Expect(sink.Batches()[0]).To(Equal([]sinks.Record{{sinks.RequestPathField: "/a"}, {sinks.RequestPathField: "/b"}}))
+
+		Expect(pipeline.Close()).NotTo(HaveOccurred())
+		Expect(sink.Batches()).To(HaveLen(2))
+		Expect(sink.Batches()[1]).To(Equal([]sinks.Record{{sinks.RequestPathField: "/c"}}))
+		Expect(sink.closed).To(BeTrue())
+	})
+
+	It("flushes the batches that are not full", func() {
+		sink := &fakeSink{}
+		pipeline := sinks.NewPipelineWithSinks(ctx, &sinks.Config{
+			Sinks: []*sinks.SinkConfig{{Type: "fake", BatchSize: 10, FlushInterval: sinks.Duration(10 * time.Millisecond)}},
+		}, []sinks.Sink{sink})
+		defer pipeline.Close()
+
+		Expect(pipeline.Callback(ctx, httpLogs(httpEntry(envoy_config_core_v3.RequestMethod_GET, "/a", 200)))).NotTo(HaveOccurred())
+		Eventually(sink.Batches).Should(HaveLen(1))
+	})
+
+	It("blocks while the queue of a sink is full", func() {
+		sink := &fakeSink{block: make(chan struct{})}
+		pipeline := sinks.NewPipelineWithSinks(ctx, &sinks.Config{
+			Sinks: []*sinks.SinkConfig{{Type: "fake", BatchSize: 1, QueueSize: 1}},
+		}, []sinks.Sink{sink})
+
+		// the first record is being written, the second one is queued
+		message := httpLogs(httpEntry(envoy_config_core_v3.RequestMethod_GET, "/a", 200), httpEntry(envoy_config_core_v3.RequestMethod_GET, "/b", 200))
+		Expect(pipeline.Callback(ctx, message)).NotTo(HaveOccurred())
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		err := pipeline.Callback(timeoutCtx, httpLogs(httpEntry(envoy_config_core_v3.RequestMethod_GET, "/c", 200)))
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+
+		close(sink.block)
+		Expect(pipeline.Close()).NotTo(HaveOccurred())
+		Expect(sink.Batches()).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,211 @@
+package sinks
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	pb "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
+	"github.com/golang/protobuf/ptypes/duration"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+)
+
+const (
+	HttpKind = "http"
+	TcpKind  = "tcp"
+)
+
+// The fields of the records
+const (
+	KindField                    = "kind"
+	LoggerNameField              = "logger_name"
+	NodeIdField                  = "node_id"
+	NodeClusterField             = "node_cluster"
+	StartTimeField               = "start_time"
+	ClusterField                 = "cluster"
+	RouteNameField               = "route_name"
+	UpstreamRemoteAddressField   = "upstream_remote_address"
+	DownstreamRemoteAddressField = "downstream_remote_address"
+	ProtocolVersionField         = "protocol_version"
+	RequestPathField             = "request_path"
+	RequestOriginalPathField     = "request_original_path"
+	RequestMethodField           = "request_method"
+	RequestAuthorityField        = "request_authority"
+	RequestHeadersField          = "request_headers"
+	ResponseCodeField            = "response_code"
+	ResponseHeadersField         = "response_headers"
+	ResponseTrailersField        = "response_trailers"
+	IssuerField                  = "issuer"
+	PodNameField                 = "pod_name"
+	DownstreamRespTimeField      = "downstream_resp_time"
+	UpstreamRespTimeField        = "upstream_resp_time"
+)
+
+// Record is an access log entry, flattened to the fields that can be selected and filtered on.
+type Record map[string]interface{}
+
+// RecordsFromMessage returns the records of the entries of a message of an access log stream.
+func RecordsFromMessage(message *pb.StreamAccessLogsMessage) []Record {
+	identifier := message.GetIdentifier()
+	newRecord := func(kind string, common *envoy_data_accesslog_v3.AccessLogCommon) Record {
+		record := Record{
+			KindField:                    kind,
+			LoggerNameField:              identifier.GetLogName(),
+			NodeIdField:                  identifier.GetNode().GetId(),
+			NodeClusterField:             identifier.GetNode().GetCluster(),
+			ClusterField:                 common.GetUpstreamCluster(),
+			RouteNameField:               common.GetRouteName(),
+			UpstreamRemoteAddressField:   socketAddress(common.GetUpstreamRemoteAddress()),
+			DownstreamRemoteAddressField: socketAddress(common.GetDownstreamRemoteAddress()),
+		}
+		if common.GetStartTime() != nil {
+			record[StartTimeField] = common.GetStartTime().AsTime()
+		}
+		return record
+	}
+
+	var records []Record
+	switch msg := message.GetLogEntries().(type) {
+	case *pb.StreamAccessLogsMessage_HttpLogs:
+		for _, v := range msg.HttpLogs.GetLogEntry() {
+			meta := v.GetCommonProperties().GetMetadata().GetFilterMetadata()
+			record := newRecord(HttpKind, v.GetCommonProperties())
+			record[ProtocolVersionField] = v.GetProtocolVersion().String()
+			record[RequestPathField] = v.GetRequest().GetPath()
+			record[RequestOriginalPathField] = v.GetRequest().GetOriginalPath()
+			record[RequestMethodField] = v.GetRequest().GetRequestMethod().String()
+			record[RequestAuthorityField] = v.GetRequest().GetAuthority()
+			record[RequestHeadersField] = v.GetRequest().GetRequestHeaders()
+			record[ResponseCodeField] = v.GetResponse().GetResponseCode().GetValue()
+			record[ResponseHeadersField] = v.GetResponse().GetResponseHeaders()
+			record[ResponseTrailersField] = v.GetResponse().GetResponseTrailers()
+			record[IssuerField] = JwtClaim("iss", meta)
+			record[PodNameField] = TransformationValue("pod_name", meta)
+			record[DownstreamRespTimeField] = DownstreamRespTimeNs(v)
+			record[UpstreamRespTimeField] = LastToFirstNs(v)
+			records = append(records, record)
+		}
+	case *pb.StreamAccessLogsMessage_TcpLogs:
+		for _, v := range msg.TcpLogs.GetLogEntry() {
+			records = append(records, newRecord(TcpKind, v.GetCommonProperties()))
+		}
+	}
+	return records
+}
+
+// Matches returns whether the record is selected by the filter.
+func (f *Filter) Matches(record Record) bool {
+	if len(f.Kinds) > 0 && !containsString(f.Kinds, record[KindField]) {
+		return false
+	}
+	if len(f.Clusters) > 0 && !containsString(f.Clusters, record[ClusterField]) {
+		return false
+	}
+	if record[KindField] != HttpKind {
+		return true
+	}
+	if len(f.Methods) > 0 && !containsString(f.Methods, record[RequestMethodField]) {
+		return false
+	}
+	if code, _ := record[ResponseCodeField].(uint32); code < f.MinResponseCode {
+		return false
+	}
+	return true
+}
+
+// Select returns the record with only the given fields, or the record itself if none are given.
+func (r Record) Select(fields []string) Record {
+	if len(fields) == 0 {
+		return r
+	}
+	selected := make(Record, len(fields))
+	for _, field := range fields {
+		if value, ok := r[field]; ok {
+			selected[field] = value
+		}
+	}
+	return selected
+}
+
+func containsString(values []string, value interface{}) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func socketAddress(address *envoy_config_core_v3.Address) string {
+	socketAddress := address.GetSocketAddress()
+	if socketAddress == nil {
+		return ""
+	}
+	return net.JoinHostPort(socketAddress.GetAddress(), strconv.Itoa(int(socketAddress.GetPortValue())))
+}
+
+// TransformationValue returns the value of a key of the dynamic metadata set by the transformation filter.
+func TransformationValue(key string, filterMetadata map[string]*_struct.Struct) string {
+	transformationMeta := filterMetadata[transformation.FilterName]
+	for tKey, tVal := range transformationMeta.GetFields() {
+		if tKey == key {
+			return tVal.GetStringValue()
+		}
+	}
+	return ""
+}
+
+// JwtClaim returns the value of a claim of the JWT verified by the jwt_authn filter.
+func JwtClaim(claim string, filterMetadata map[string]*_struct.Struct) string {
+	providerByJwt := filterMetadata["envoy.filters.http.jwt_authn"]
+	jwts := providerByJwt.GetFields()
+	for _, jwt := range jwts {
+		claims := jwt.GetStructValue()
+		if claims != nil {
+			claimsMap := claims.GetFields()
+			if val, ok := claimsMap[claim]; ok {
+				return val.GetStringValue()
+			}
+		}
+	}
+	return ""
+}
+
+// DownstreamRespTimeNs includes the time filters take during the processing of the request and response.
+func DownstreamRespTimeNs(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) int64 {
+	downstreamRespTime := entry.GetCommonProperties().GetTimeToLastDownstreamTxByte()
+	return durationNs(downstreamRespTime)
+}
+
+// FirstToFirstNs is the upstream response time to use if envoy is not buffering the request before sending it upstream.
+func FirstToFirstNs(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) int64 {
+	timeToFirstUpstreamRxByte := entry.GetCommonProperties().GetTimeToFirstUpstreamRxByte()
+	timeToFirstUpstreamRxByteNs := durationNs(timeToFirstUpstreamRxByte)
+	timeToFirstUpstreamTxByte := entry.GetCommonProperties().GetTimeToFirstUpstreamTxByte()
+	timeToFirstUpstreamTxByteNs := durationNs(timeToFirstUpstreamTxByte)
+
+	// this excludes the time filters take during the processing of the request and response.
+	upstreamRespTimeNs := timeToFirstUpstreamRxByteNs - timeToFirstUpstreamTxByteNs
+	return upstreamRespTimeNs
+}
+
+// LastToFirstNs is the upstream response time to use if envoy is buffering the request before sending it upstream.
+func LastToFirstNs(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) int64 {
+	timeToFirstUpstreamRxByte := entry.GetCommonProperties().GetTimeToFirstUpstreamRxByte()
+	timeToFirstUpstreamRxByteNs := durationNs(timeToFirstUpstreamRxByte)
+	timeToLastUpstreamTxByte := entry.GetCommonProperties().GetTimeToLastUpstreamTxByte()
+	timeToLastUpstreamTxByteNs := durationNs(timeToLastUpstreamTxByte)
+
+	// this excludes the time filters take during the processing of the request and response.
+	// this could, in theory, be negative. for example, the upstream could reject based on the
+	// request headers and respond before the request body had finished transmitting upstream.
+	upstreamRespTimeNs := timeToFirstUpstreamRxByteNs - timeToLastUpstreamTxByteNs
+	return upstreamRespTimeNs
+}
+
+func durationNs(d *duration.Duration) int64 {
+	return d.GetSeconds()*int64(time.Second) + int64(d.GetNanos())
+}
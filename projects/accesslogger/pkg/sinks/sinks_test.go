@@ -0,0 +1,162 @@
+package sinks_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
+	collogs "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("Sinks", func() {
+
+	Context("config", func() {
+
+		It("parses the sinks from a list", func() {
+			parsed, err := sinks.ParseSinks("stdout, file:/var/log/access.log,otlp:http://collector:4318/v1/logs")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed).To(Equal([]*sinks.SinkConfig{
+				{Type: sinks.StdoutSink},
+				{Type: sinks.FileSink, Path: "/var/log/access.log"},
+				{Type: sinks.OtlpSink, Url: "http://collector:4318/v1/logs"},
+			}))
+
+			_, err = sinks.ParseSinks("kafka:broker:9092")
+			Expect(err).To(MatchError(ContainSubstring("unknown access log sink type kafka")))
+		})
+
+		It("loads the config from a file", func() {
+			file, err := ioutil.TempFile("", "access-log-config")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.Remove(file.Name())
+			_, err = file.WriteString(`
+fields: [request_path, response_code]
+filter:
+  minResponseCode: 500
+sinks:
+- type: http
+  url: http://logs.example.com
+  headers:
+    authorization: token
+  batchSize: 10
+  flushInterval: 5s
+`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Close()).NotTo(HaveOccurred())
+
+			cfg, err := sinks.LoadConfig(file.Name())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Fields).To(Equal([]string{"request_path", "response_code"}))
+			Expect(cfg.Filter.MinResponseCode).To(BeEquivalentTo(500))
+			Expect(cfg.Sinks).To(HaveLen(1))
+			Expect(cfg.Sinks[0].Headers).To(HaveKeyWithValue("authorization", "token"))
+			Expect(time.Duration(cfg.Sinks[0].FlushInterval)).To(Equal(5 * time.Second))
+		})
+
+		It("rejects sinks missing their target", func() {
+			_, err := sinks.NewSink(&sinks.SinkConfig{Type: sinks.HttpSink})
+			Expect(err).To(MatchError(ContainSubstring("http access log sink requires a url")))
+		})
+	})
+
+	It("rotates the files it writes to", func() {
+		dir, err := ioutil.TempDir("", "access-logs")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "access.log")
+
+		sink, err := sinks.NewFileSink(path, 30, 2)
+		Expect(err).NotTo(HaveOccurred())
+		for _, path := range []string{"/a", "/b", "/c", "/d"} {
+			Expect(sink.Write([]sinks.Record{{sinks.RequestPathField: path}})).NotTo(HaveOccurred())
+		}
+		Expect(sink.Close()).NotTo(HaveOccurred())
+
+		read := func(path string) string {
+			data, err := ioutil.ReadFile(path)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return strings.TrimSpace(string(data))
+		}
+		// each file holds a single line, and only 2 rotated files are kept
+		Expect(read(path)).To(Equal(`{"request_path":"/d"}`))
+		Expect(read(path + ".1")).To(Equal(`{"request_path":"/c"}`))
+		Expect(read(path + ".2")).To(Equal(`{"request_path":"/b"}`))
+		Expect(path + ".3").NotTo(BeAnExistingFile())
+	})
+
+	Context("http sinks", func() {
+
+		var (
+			srv      *httptest.Server
+			requests chan *http.Request
+			bodies   chan []byte
+			status   int
+		)
+
+		BeforeEach(func() {
+			requests = make(chan *http.Request, 10)
+			bodies = make(chan []byte, 10)
+			status = http.StatusOK
+			srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				requests <- r
+				bodies <- body
+				w.WriteHeader(status)
+			}))
+		})
+
+		AfterEach(func() {
+			srv.Close()
+		})
+
+		It("POSTs the records as JSON", func() {
+			sink := sinks.NewHttpSink(srv.URL, map[string]string{"Authorization": "token"}, time.Second)
+			Expect(sink.Write([]sinks.Record{{sinks.RequestPathField: "/a"}})).NotTo(HaveOccurred())
+
+			req := <-requests
+			Expect(req.Method).To(Equal(http.MethodPost))
+			Expect(req.Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(req.Header.Get("Authorization")).To(Equal("token"))
+			var records []map[string]interface{}
+			Expect(json.Unmarshal(<-bodies, &records)).NotTo(HaveOccurred())
+			Expect(records).To(Equal([]map[string]interface{}{{sinks.RequestPathField: "/a"}}))
+		})
+
+		It("fails if the server does not accept the records", func() {
+			status = http.StatusServiceUnavailable
+			sink := sinks.NewHttpSink(srv.URL, nil, time.Second)
+			Expect(sink.Write([]sinks.Record{{}})).To(MatchError(ContainSubstring("returned 503")))
+		})
+
+		It("exports the records as OTLP logs", func() {
+			start := time.Unix(1600000000, 0)
+			sink := sinks.NewOtlpSink(srv.URL, nil, time.Second)
+			Expect(sink.Write([]sinks.Record{{
+				sinks.KindField:         sinks.HttpKind,
+				sinks.StartTimeField:    start,
+				sinks.ResponseCodeField: uint32(200),
+			}})).NotTo(HaveOccurred())
+
+			req := <-requests
+			Expect(req.Header.Get("Content-Type")).To(Equal("application/x-protobuf"))
+			var export collogs.ExportLogsServiceRequest
+			Expect(proto.Unmarshal(<-bodies, &export)).NotTo(HaveOccurred())
+			logs := export.GetResourceLogs()[0].GetInstrumentationLibraryLogs()[0].GetLogs()
+			Expect(logs).To(HaveLen(1))
+			Expect(logs[0].GetTimeUnixNano()).To(BeEquivalentTo(start.UnixNano()))
+			// the attributes are sorted by key
+			Expect(logs[0].GetAttributes()).To(HaveLen(3))
+			Expect(logs[0].GetAttributes()[1].GetKey()).To(Equal(sinks.ResponseCodeField))
+			Expect(logs[0].GetAttributes()[1].GetValue().GetIntValue()).To(BeEquivalentTo(200))
+		})
+	})
+})
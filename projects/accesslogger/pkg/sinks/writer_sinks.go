@@ -0,0 +1,149 @@
+package sinks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/rotisserie/eris"
+)
+
+// Sink writes batches of access log records somewhere. The batches of a sink are written one at a time.
+type Sink interface {
+	Write(records []Record) error
+	Close() error
+}
+
+// NewSink returns the sink configured.
+func NewSink(cfg *SinkConfig) (Sink, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	switch cfg.Type {
+	case StdoutSink:
+		return NewWriterSink(os.Stdout), nil
+	case FileSink:
+		return NewFileSink(cfg.Path, cfg.MaxSizeBytes, cfg.MaxBackups)
+	case HttpSink:
+		return NewHttpSink(cfg.Url, cfg.Headers, cfg.timeout()), nil
+	case OtlpSink:
+		return NewOtlpSink(cfg.Url, cfg.Headers, cfg.timeout()), nil
+	}
+	return nil, UnknownSinkTypeError(cfg.Type)
+}
+
+type writerSink struct {
+	w io.Writer
+}
+
+// NewWriterSink returns a sink writing the records to `w` as JSON lines.
+func NewWriterSink(w io.Writer) Sink {
+	return &writerSink{w: w}
+}
+
+func (s *writerSink) Write(records []Record) error {
+	encoder := json.NewEncoder(s.w)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *writerSink) Close() error {
+	return nil
+}
+
+// fileSink writes the records to a file as JSON lines, renaming it to `<path>.1` once it exceeds its maximum size.
+// The previously rotated files are renamed to `<path>.2` and so on, up to the maximum number of backups.
+type fileSink struct {
+	path         string
+	maxSizeBytes int64
+	maxBackups   int
+
+	file *os.File
+	size int64
+}
+
+// NewFileSink returns a sink writing the records to a rotated file as JSON lines.
+func NewFileSink(path string, maxSizeBytes int64, maxBackups int) (Sink, error) {
+	if maxSizeBytes <= 0 {
+		maxSizeBytes = DefaultMaxSizeBytes
+	}
+	if maxBackups <= 0 {
+		maxBackups = DefaultMaxBackups
+	}
+	s := &fileSink{
+		path:         path,
+		maxSizeBytes: maxSizeBytes,
+		maxBackups:   maxBackups,
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *fileSink) open() error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return eris.Wrapf(err, "creating the directory of access log file %v", s.path)
+	}
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return eris.Wrapf(err, "opening access log file %v", s.path)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return eris.Wrapf(err, "opening access log file %v", s.path)
+	}
+	s.file, s.size = file, info.Size()
+	return nil
+}
+
+func (s *fileSink) Write(records []Record) error {
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		line = append(line, '\n')
+		if s.size > 0 && s.size+int64(len(line)) > s.maxSizeBytes {
+			if err := s.rotate(); err != nil {
+				return err
+			}
+		}
+		n, err := s.file.Write(line)
+		s.size += int64(n)
+		if err != nil {
+			return eris.Wrapf(err, "writing to access log file %v", s.path)
+		}
+	}
+	return nil
+}
+
+func (s *fileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return eris.Wrapf(err, "closing access log file %v", s.path)
+	}
+	for i := s.maxBackups - 1; i > 0; i-- {
+		if err := os.Rename(s.backup(i), s.backup(i+1)); err != nil && !os.IsNotExist(err) {
+			return eris.Wrapf(err, "rotating access log file %v", s.path)
+		}
+	}
+	if err := os.Rename(s.path, s.backup(1)); err != nil {
+		return eris.Wrapf(err, "rotating access log file %v", s.path)
+	}
+	return s.open()
+}
+
+func (s *fileSink) backup(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
+
+func (s *fileSink) Close() error {
+	return s.file.Close()
+}
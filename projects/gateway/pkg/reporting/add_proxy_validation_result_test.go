@@ -68,4 +68,23 @@ var _ = Describe("AddProxyValidationResult", func() {
 	* Route Error: InvalidMatcherError. Reason: bad route. Route Name: route-0`))
 		}
 	})
+	It("it adds virtual host warnings to the reports of the virtual services", func() {
+		proxyReport := validation.MakeReport(proxy)
+
+		for _, lis := range proxyReport.ListenerReports {
+			for _, vHost := range lis.GetHttpListenerReport().GetVirtualHostReports() {
+				validation.AppendVirtualHostWarning(vHost,
+					validationapi.VirtualHostReport_Warning_CertificateExpiryWarning,
+					"expiring cert")
+			}
+		}
+
+		err := AddProxyValidationResult(reports, proxy, proxyReport)
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, vs := range snap.VirtualServices {
+			Expect(reports[vs].Errors).NotTo(HaveOccurred())
+			Expect(reports[vs].Warnings).To(ConsistOf("VirtualHost Warning: CertificateExpiryWarning. Reason: expiring cert"))
+		}
+	})
 })
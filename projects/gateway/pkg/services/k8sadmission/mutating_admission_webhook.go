@@ -0,0 +1,291 @@
+package k8sadmission
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/gloo/pkg/utils/settingsutil"
+	gwv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	MutationPath = "/mutation"
+	// When set to "true" on a VirtualService or RouteTable, its routes are sorted the way `glooctl route sort` does
+	SortRoutesKey   = "gateway.solo.io/sort_routes"
+	SortRoutesValue = "true"
+)
+
+var jsonPatchType = v1beta1.PatchTypeJSONPatch
+
+// The mutating webhook applies to VirtualServices the defaults the controllers would otherwise apply at translation
+// time, and normalizes VirtualServices, RouteTables and Upstreams, so that the resources stored are the ones served.
+// The settings defaults of Upstreams are left to the translation, so that they keep following the settings.
+// It runs before the validating webhook. The defaults are read from the settings of the context.
+type gatewayMutationWebhook struct {
+	ctx             context.Context
+	watchNamespaces []string
+}
+
+func NewGatewayMutationHandler(ctx context.Context, watchNamespaces []string) *gatewayMutationWebhook {
+	return &gatewayMutationWebhook{
+		ctx:             ctx,
+		watchNamespaces: watchNamespaces,
+	}
+}
+
+func (wh *gatewayMutationWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := contextutils.LoggerFrom(wh.ctx)
+
+	logger.Infow("received mutation request")
+
+	contentType := r.Header.Get("Content-Type")
+	if contentType != ApplicationJson && contentType != ApplicationYaml {
+		logger.Errorf("contentType=%s, expecting application/json or application/x-yaml", contentType)
+		http.Error(w, "empty body", http.StatusBadRequest)
+		return
+	}
+
+	var body []byte
+	if r.Body != nil {
+		if data, err := ioutil.ReadAll(r.Body); err == nil {
+			body = data
+		}
+		defer r.Body.Close()
+	}
+	if len(body) == 0 {
+		logger.Errorf("empty body")
+		http.Error(w, "empty body", http.StatusBadRequest)
+		return
+	}
+
+	var (
+		review v1beta1.AdmissionReview
+		err    error
+	)
+	if contentType == ApplicationYaml {
+		err = yaml.Unmarshal(body, &review)
+	} else {
+		_, _, err = deserializer.Decode(body, nil, &review)
+	}
+
+	admissionReview := v1beta1.AdmissionReview{}
+	if err != nil {
+		logger.Errorf("Can't decode body: %v", err)
+		admissionReview.Response = &v1beta1.AdmissionResponse{
+			Result: &metav1.Status{
+				Message: err.Error(),
+			},
+		}
+	} else if review.Request != nil {
+		admissionReview.Response = wh.makeAdmissionResponse(wh.ctx, review.Request)
+		admissionReview.Response.UID = review.Request.UID
+	}
+
+	resp, err := json.Marshal(admissionReview)
+	if err != nil {
+		logger.Errorf("Can't encode response: %v", err)
+		http.Error(w, fmt.Sprintf("could not encode response: %v", err), http.StatusInternalServerError)
+		return
+	}
+	if _, err := w.Write(resp); err != nil {
+		logger.Errorf("Can't write response: %v", err)
+		http.Error(w, fmt.Sprintf("could not write response: %v", err), http.StatusInternalServerError)
+	}
+
+	logger.Debugf("responded with review: %s", resp)
+}
+
+func (wh *gatewayMutationWebhook) makeAdmissionResponse(ctx context.Context, req *v1beta1.AdmissionRequest) *v1beta1.AdmissionResponse {
+	logger := contextutils.LoggerFrom(ctx)
+
+	// mutation never rejects a resource, the validating webhook does
+	allowed := &v1beta1.AdmissionResponse{Allowed: true}
+	if req.Operation != v1beta1.Create && req.Operation != v1beta1.Update {
+		return allowed
+	}
+	if !wh.watchesNamespace(req.Namespace) {
+		return allowed
+	}
+
+	gvk := schema.GroupVersionKind{
+		Group:   req.Kind.Group,
+		Version: req.Kind.Version,
+		Kind:    req.Kind.Kind,
+	}
+	patch, err := wh.mutate(gvk, req.Object.Raw)
+	if err != nil {
+		// leave it to the validating webhook to reject the resource
+		logger.Warnf("Mutation of %v %v.%v skipped: %v", gvk.Kind, req.Namespace, req.Name, err)
+		return allowed
+	}
+	if patch != nil {
+		logger.Debugf("Mutated %v %v.%v", gvk.Kind, req.Namespace, req.Name)
+		allowed.Patch = patch
+		allowed.PatchType = &jsonPatchType
+	}
+	return allowed
+}
+
+func (wh *gatewayMutationWebhook) watchesNamespace(namespace string) bool {
+	if len(wh.watchNamespaces) == 0 {
+		return true
+	}
+	for _, ns := range wh.watchNamespaces {
+		if ns == metav1.NamespaceAll || ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// mutate returns the JSON patch to apply to the raw resource, or nil if it is left unchanged
+func (wh *gatewayMutationWebhook) mutate(gvk schema.GroupVersionKind, rawJson []byte) ([]byte, error) {
+	settings := settingsutil.MaybeFromContext(wh.ctx)
+	var (
+		resource resources.InputResource
+		mutate   func()
+	)
+	switch gvk {
+	case gwv1.VirtualServiceGVK:
+		vs := &gwv1.VirtualService{}
+		resource, mutate = vs, func() { MutateVirtualService(settings, vs) }
+	case gwv1.RouteTableGVK:
+		rt := &gwv1.RouteTable{}
+		resource, mutate = rt, func() { MutateRouteTable(rt) }
+	case gloov1.UpstreamGVK:
+		us := &gloov1.Upstream{}
+		resource, mutate = us, func() { MutateUpstream(us) }
+	default:
+		return nil, nil
+	}
+
+	if err := protoutils.UnmarshalResource(rawJson, resource); err != nil {
+		return nil, WrappedUnmarshalErr(err)
+	}
+	original, err := marshalSpec(resource)
+	if err != nil {
+		return nil, err
+	}
+	mutate()
+	mutated, err := marshalSpec(resource)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(original, mutated) {
+		return nil, nil
+	}
+
+	return json.Marshal([]map[string]interface{}{{
+		"op":    "add",
+		"path":  "/spec",
+		"value": json.RawMessage(mutated),
+	}})
+}
+
+func marshalSpec(resource resources.InputResource) ([]byte, error) {
+	spec, err := protoutils.MarshalMap(resource)
+	if err != nil {
+		return nil, err
+	}
+	// the metadata and statuses are not part of the spec
+	delete(spec, "metadata")
+	delete(spec, "namespacedStatuses")
+	return json.Marshal(spec)
+}
+
+// MutateVirtualService applies the defaults of the settings to a virtual service, normalizes its matchers,
+// and sorts its routes if the virtual service opts into it.
+func MutateVirtualService(settings *gloov1.Settings, vs *gwv1.VirtualService) {
+	// same default as applied by the gateway translator
+	if oneWayTls := settings.GetGateway().GetVirtualServiceOptions().GetOneWayTls(); oneWayTls != nil {
+		if vs.GetSslConfig() != nil && vs.GetSslConfig().GetOneWayTls() == nil {
+			vs.GetSslConfig().OneWayTls = &wrappers.BoolValue{Value: oneWayTls.GetValue()}
+		}
+	}
+
+	routes := vs.GetVirtualHost().GetRoutes()
+	for _, route := range routes {
+		// routes without a path matcher match the `/` prefix
+		if len(route.GetMatchers()) == 0 {
+			route.Matchers = []*matchers.Matcher{defaults.DefaultMatcher()}
+		}
+		for _, matcher := range route.GetMatchers() {
+			if matcher.GetPathSpecifier() == nil {
+				matcher.PathSpecifier = defaults.DefaultMatcher().GetPathSpecifier()
+			}
+		}
+		normalizeMatchers(route.GetMatchers())
+	}
+	if sortRoutes(vs.GetMetadata().GetAnnotations()) {
+		glooutils.SortGatewayRoutesByPath(routes)
+	}
+}
+
+// MutateRouteTable normalizes the matchers of a route table, and sorts its routes if the route table opts into it.
+// Routes without path matchers are left as they are, as they may inherit the path matchers of their parent.
+func MutateRouteTable(rt *gwv1.RouteTable) {
+	for _, route := range rt.GetRoutes() {
+		normalizeMatchers(route.GetMatchers())
+	}
+	if sortRoutes(rt.GetMetadata().GetAnnotations()) {
+		glooutils.SortGatewayRoutesByPath(rt.GetRoutes())
+	}
+}
+
+// MutateUpstream normalizes the host names of an upstream. The defaults of the settings are not applied to it, as
+// the translator applies the ones of the settings at the time of the translation.
+func MutateUpstream(us *gloov1.Upstream) {
+	if sslConfig := us.GetSslConfig(); sslConfig != nil {
+		sslConfig.Sni = normalizeHostname(sslConfig.GetSni())
+	}
+	for _, host := range us.GetStatic().GetHosts() {
+		host.Addr = normalizeHostname(host.GetAddr())
+		host.SniAddr = normalizeHostname(host.GetSniAddr())
+	}
+}
+
+// Host names are case-insensitive, and the ones envoy resolves or sends as SNI must not be padded
+func normalizeHostname(hostname string) string {
+	return strings.ToLower(strings.TrimSpace(hostname))
+}
+
+// HTTP methods are case-sensitive, and matched against the upper-case method of the request
+func normalizeMatchers(routeMatchers []*matchers.Matcher) {
+	for _, matcher := range routeMatchers {
+		if len(matcher.GetMethods()) == 0 {
+			continue
+		}
+		seen := map[string]bool{}
+		var methods []string
+		for _, method := range matcher.GetMethods() {
+			method = strings.ToUpper(strings.TrimSpace(method))
+			if method == "" || seen[method] {
+				continue
+			}
+			seen[method] = true
+			methods = append(methods, method)
+		}
+		matcher.Methods = methods
+	}
+}
+
+func sortRoutes(annotations map[string]string) bool {
+	return annotations[SortRoutesKey] == SortRoutesValue
+}
@@ -0,0 +1,182 @@
+package k8sadmission
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/pkg/utils/settingsutil"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"k8s.io/api/admission/v1beta1"
+)
+
+var _ = Describe("MutatingAdmissionWebhook", func() {
+
+	var (
+		srv      *httptest.Server
+		settings *gloov1.Settings
+	)
+	BeforeEach(func() {
+		settings = &gloov1.Settings{
+			Gateway: &gloov1.GatewayOptions{
+				VirtualServiceOptions: &gloov1.VirtualServiceOptions{
+					OneWayTls: &wrappers.BoolValue{Value: true},
+				},
+			},
+			UpstreamOptions: &gloov1.UpstreamOptions{
+				SslParameters: &gloov1.SslParameters{MinimumProtocolVersion: gloov1.SslParameters_TLSv1_2},
+			},
+			Gloo: &gloov1.GlooOptions{
+				CircuitBreakers: &gloov1.CircuitBreakerConfig{MaxConnections: &wrappers.UInt32Value{Value: 10}},
+			},
+		}
+		ctx := settingsutil.WithSettings(context.TODO(), settings)
+		srv = httptest.NewServer(NewGatewayMutationHandler(ctx, []string{"namespace"}))
+	})
+	AfterEach(func() {
+		srv.Close()
+	})
+
+	// sends the resource to the webhook and returns the response, and the spec of the patch if any
+	review := func(resourceCrd crd.Crd, op v1beta1.Operation, resource resources.InputResource) (*v1beta1.AdmissionResponse, map[string]interface{}) {
+		req, err := makeReviewRequest(srv.URL+MutationPath, resourceCrd, resourceCrd.GroupVersionKind(), op, resource)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		res, err := srv.Client().Do(req)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		defer res.Body.Close()
+		ExpectWithOffset(1, res.StatusCode).To(Equal(http.StatusOK))
+
+		var admissionReview v1beta1.AdmissionReview
+		ExpectWithOffset(1, json.NewDecoder(res.Body).Decode(&admissionReview)).NotTo(HaveOccurred())
+		response := admissionReview.Response
+		ExpectWithOffset(1, response.Allowed).To(BeTrue())
+		ExpectWithOffset(1, response.UID).To(BeEquivalentTo("1234"))
+		if response.Patch == nil {
+			return response, nil
+		}
+
+		var patch []struct {
+			Op    string                 `json:"op"`
+			Path  string                 `json:"path"`
+			Value map[string]interface{} `json:"value"`
+		}
+		ExpectWithOffset(1, json.Unmarshal(response.Patch, &patch)).NotTo(HaveOccurred())
+		ExpectWithOffset(1, patch).To(HaveLen(1))
+		ExpectWithOffset(1, patch[0].Op).To(Equal("add"))
+		ExpectWithOffset(1, patch[0].Path).To(Equal("/spec"))
+		ExpectWithOffset(1, *response.PatchType).To(Equal(v1beta1.PatchTypeJSONPatch))
+		return response, patch[0].Value
+	}
+
+	routeWithPrefix := func(prefix string, methods ...string) *v1.Route {
+		return &v1.Route{
+			Matchers: []*matchers.Matcher{{
+				PathSpecifier: &matchers.Matcher_Prefix{Prefix: prefix},
+				Methods:       methods,
+			}},
+			Action: &v1.Route_DirectResponseAction{DirectResponseAction: &gloov1.DirectResponseAction{Status: 200}},
+		}
+	}
+
+	It("applies the defaults of the settings to virtual services and normalizes their matchers", func() {
+		vs := defaults.DefaultVirtualService("namespace", "vs")
+		vs.SslConfig = &gloov1.SslConfig{}
+		vs.VirtualHost.Routes = []*v1.Route{
+			{Action: &v1.Route_DirectResponseAction{DirectResponseAction: &gloov1.DirectResponseAction{Status: 200}}},
+			routeWithPrefix("/api", "get ", "GET", "post"),
+		}
+
+		_, spec := review(v1.VirtualServiceCrd, v1beta1.Create, vs)
+		Expect(spec).NotTo(BeNil())
+
+		mutated := &v1.VirtualService{}
+		Expect(protoutils.UnmarshalMapToProto(spec, mutated)).NotTo(HaveOccurred())
+		Expect(mutated.GetSslConfig().GetOneWayTls().GetValue()).To(BeTrue())
+		Expect(mutated.GetVirtualHost().GetRoutes()[0].GetMatchers()).To(HaveLen(1))
+		Expect(mutated.GetVirtualHost().GetRoutes()[0].GetMatchers()[0].GetPrefix()).To(Equal("/"))
+		Expect(mutated.GetVirtualHost().GetRoutes()[1].GetMatchers()[0].GetMethods()).To(Equal([]string{"GET", "POST"}))
+	})
+
+	It("sorts the routes of the resources that opt into it", func() {
+		rt := &v1.RouteTable{
+			Metadata: &core.Metadata{Namespace: "namespace", Name: "rt"},
+			Routes:   []*v1.Route{routeWithPrefix("/"), routeWithPrefix("/api")},
+		}
+
+		_, spec := review(v1.RouteTableCrd, v1beta1.Create, rt)
+		Expect(spec).To(BeNil())
+
+		rt.Metadata.Annotations = map[string]string{SortRoutesKey: SortRoutesValue}
+		_, spec = review(v1.RouteTableCrd, v1beta1.Update, rt)
+		Expect(spec).NotTo(BeNil())
+
+		mutated := &v1.RouteTable{}
+		Expect(protoutils.UnmarshalMapToProto(spec, mutated)).NotTo(HaveOccurred())
+		Expect(mutated.GetRoutes()[0].GetMatchers()[0].GetPrefix()).To(Equal("/api"))
+		Expect(mutated.GetRoutes()[1].GetMatchers()[0].GetPrefix()).To(Equal("/"))
+	})
+
+	It("normalizes the host names of upstreams", func() {
+		us := &gloov1.Upstream{
+			Metadata:  &core.Metadata{Namespace: "namespace", Name: "us"},
+			SslConfig: &gloov1.UpstreamSslConfig{Sni: " Example.com"},
+			UpstreamType: &gloov1.Upstream_Static{Static: &static.UpstreamSpec{
+				Hosts: []*static.Host{{Addr: "API.Example.com ", Port: 443, SniAddr: "Api.Example.com"}},
+			}},
+		}
+
+		_, spec := review(gloov1.UpstreamCrd, v1beta1.Create, us)
+		Expect(spec).NotTo(BeNil())
+
+		mutated := &gloov1.Upstream{}
+		Expect(protoutils.UnmarshalMapToProto(spec, mutated)).NotTo(HaveOccurred())
+		Expect(mutated.GetSslConfig().GetSni()).To(Equal("example.com"))
+		Expect(mutated.GetStatic().GetHosts()[0].GetAddr()).To(Equal("api.example.com"))
+		Expect(mutated.GetStatic().GetHosts()[0].GetSniAddr()).To(Equal("api.example.com"))
+		Expect(mutated.GetStatic().GetHosts()[0].GetPort()).To(BeEquivalentTo(443))
+		// the translation applies the defaults of the settings
+		Expect(mutated.GetSslConfig().GetParameters()).To(BeNil())
+		Expect(mutated.GetCircuitBreakers()).To(BeNil())
+	})
+
+	It("leaves the defaults of upstreams to the translation", func() {
+		us := &gloov1.Upstream{
+			Metadata:  &core.Metadata{Namespace: "namespace", Name: "us"},
+			SslConfig: &gloov1.UpstreamSslConfig{Sni: "example.com"},
+		}
+		response, spec := review(gloov1.UpstreamCrd, v1beta1.Create, us)
+		Expect(spec).To(BeNil())
+		Expect(response.PatchType).To(BeNil())
+	})
+
+	It("does not patch resources that are left unchanged", func() {
+		rt := &v1.RouteTable{
+			Metadata: &core.Metadata{Namespace: "namespace", Name: "rt"},
+			Routes:   []*v1.Route{routeWithPrefix("/api", "GET")},
+		}
+		response, spec := review(v1.RouteTableCrd, v1beta1.Create, rt)
+		Expect(spec).To(BeNil())
+		Expect(response.PatchType).To(BeNil())
+	})
+
+	It("ignores the resources of the namespaces not watched", func() {
+		rt := &v1.RouteTable{
+			Metadata: &core.Metadata{Namespace: "other", Name: "rt"},
+			Routes:   []*v1.Route{routeWithPrefix("/api", "get")},
+		}
+		_, spec := review(v1.RouteTableCrd, v1beta1.Create, rt)
+		Expect(spec).To(BeNil())
+	})
+})
@@ -139,6 +139,10 @@ func NewGatewayValidatingWebhook(cfg WebhookConfig) (*http.Server, error) {
 
 	mux := http.NewServeMux()
 	mux.Handle(ValidationPath, handler)
+	mux.Handle(MutationPath, NewGatewayMutationHandler(
+		contextutils.WithLogger(ctx, "gateway-mutation-webhook"),
+		watchNamespaces,
+	))
 
 	return &http.Server{
 		Addr:      fmt.Sprintf(":%v", port),
@@ -415,6 +419,24 @@ func (wh *gatewayValidationWebhook) validate(
 		} else {
 			return wh.validateRouteTable(ctx, rawJson, dryRun)
 		}
+	case gwv1.VirtualHostOptionGVK:
+		if isDelete {
+			err := wh.validator.ValidateDeleteVirtualHostOption(ctx, ref, dryRun)
+			if err != nil {
+				return &validation.Reports{}, &multierror.Error{Errors: []error{err}}
+			}
+		} else {
+			return wh.validateVirtualHostOption(ctx, rawJson, dryRun)
+		}
+	case gwv1.RouteOptionGVK:
+		if isDelete {
+			err := wh.validator.ValidateDeleteRouteOption(ctx, ref, dryRun)
+			if err != nil {
+				return &validation.Reports{}, &multierror.Error{Errors: []error{err}}
+			}
+		} else {
+			return wh.validateRouteOption(ctx, rawJson, dryRun)
+		}
 	case gloov1.UpstreamGVK:
 		if isDelete {
 			err := wh.validator.ValidateDeleteUpstream(ctx, ref, dryRun)
@@ -424,6 +446,15 @@ func (wh *gatewayValidationWebhook) validate(
 		} else {
 			return wh.validateUpstream(ctx, rawJson, dryRun)
 		}
+	case gloov1.UpstreamGroupGVK:
+		if isDelete {
+			err := wh.validator.ValidateDeleteUpstreamGroup(ctx, ref, dryRun)
+			if err != nil {
+				return &validation.Reports{}, &multierror.Error{Errors: []error{err}}
+			}
+		} else {
+			return wh.validateUpstreamGroup(ctx, rawJson, dryRun)
+		}
 	case gloov1.SecretGVK:
 		// We only support validation of secrets for DELETE operations
 		if isDelete {
@@ -506,6 +537,42 @@ func (wh *gatewayValidationWebhook) validateRouteTable(ctx context.Context, rawJ
 	return reports, nil
 }
 
+func (wh *gatewayValidationWebhook) validateVirtualHostOption(ctx context.Context, rawJson []byte, dryRun bool) (*validation.Reports, *multierror.Error) {
+	var (
+		vho     gwv1.VirtualHostOption
+		reports *validation.Reports
+		err     error
+	)
+	if err := protoutils.UnmarshalResource(rawJson, &vho); err != nil {
+		return nil, &multierror.Error{Errors: []error{WrappedUnmarshalErr(err)}}
+	}
+	if skipValidationCheck(vho.GetMetadata().GetAnnotations()) {
+		return nil, nil
+	}
+	if reports, err = wh.validator.ValidateVirtualHostOption(ctx, &vho, dryRun); err != nil {
+		return reports, &multierror.Error{Errors: []error{errors.Wrapf(err, "Validating %T failed", vho)}}
+	}
+	return reports, nil
+}
+
+func (wh *gatewayValidationWebhook) validateRouteOption(ctx context.Context, rawJson []byte, dryRun bool) (*validation.Reports, *multierror.Error) {
+	var (
+		ro      gwv1.RouteOption
+		reports *validation.Reports
+		err     error
+	)
+	if err := protoutils.UnmarshalResource(rawJson, &ro); err != nil {
+		return nil, &multierror.Error{Errors: []error{WrappedUnmarshalErr(err)}}
+	}
+	if skipValidationCheck(ro.GetMetadata().GetAnnotations()) {
+		return nil, nil
+	}
+	if reports, err = wh.validator.ValidateRouteOption(ctx, &ro, dryRun); err != nil {
+		return reports, &multierror.Error{Errors: []error{errors.Wrapf(err, "Validating %T failed", ro)}}
+	}
+	return reports, nil
+}
+
 func (wh *gatewayValidationWebhook) validateUpstream(ctx context.Context, rawJson []byte, dryRun bool) (*validation.Reports, *multierror.Error) {
 	var (
 		us      gloov1.Upstream
@@ -523,3 +590,21 @@ func (wh *gatewayValidationWebhook) validateUpstream(ctx context.Context, rawJso
 	}
 	return reports, nil
 }
+
+func (wh *gatewayValidationWebhook) validateUpstreamGroup(ctx context.Context, rawJson []byte, dryRun bool) (*validation.Reports, *multierror.Error) {
+	var (
+		ug      gloov1.UpstreamGroup
+		reports *validation.Reports
+		err     error
+	)
+	if err := protoutils.UnmarshalResource(rawJson, &ug); err != nil {
+		return nil, &multierror.Error{Errors: []error{WrappedUnmarshalErr(err)}}
+	}
+	if skipValidationCheck(ug.GetMetadata().GetAnnotations()) {
+		return nil, nil
+	}
+	if reports, err = wh.validator.ValidateUpstreamGroup(ctx, &ug, dryRun); err != nil {
+		return reports, &multierror.Error{Errors: []error{errors.Wrapf(err, "Validating %T failed", ug)}}
+	}
+	return reports, nil
+}
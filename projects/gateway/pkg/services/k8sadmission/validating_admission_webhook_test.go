@@ -86,6 +86,9 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 	}
 
 	routeTable := &v1.RouteTable{Metadata: &core.Metadata{Namespace: "namespace", Name: "rt"}}
+	virtualHostOption := &v1.VirtualHostOption{Metadata: &core.Metadata{Namespace: "namespace", Name: "vho"}}
+	routeOption := &v1.RouteOption{Metadata: &core.Metadata{Namespace: "namespace", Name: "ro"}}
+	upstreamGroup := &gloov1.UpstreamGroup{Metadata: &core.Metadata{Namespace: "namespace", Name: "ug"}}
 
 	errMsg := "didn't say the magic word"
 
@@ -118,6 +121,24 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 			mv.fValidateDeleteUpstream = func(ctx context.Context, us *core.ResourceRef, dryRun bool) error {
 				return fmt.Errorf(errMsg)
 			}
+			mv.fValidateVirtualHostOption = func(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (*validation.Reports, error) {
+				return reports(), fmt.Errorf(errMsg)
+			}
+			mv.fValidateDeleteVirtualHostOption = func(ctx context.Context, vho *core.ResourceRef, dryRun bool) error {
+				return fmt.Errorf(errMsg)
+			}
+			mv.fValidateRouteOption = func(ctx context.Context, ro *v1.RouteOption, dryRun bool) (*validation.Reports, error) {
+				return reports(), fmt.Errorf(errMsg)
+			}
+			mv.fValidateDeleteRouteOption = func(ctx context.Context, ro *core.ResourceRef, dryRun bool) error {
+				return fmt.Errorf(errMsg)
+			}
+			mv.fValidateUpstreamGroup = func(ctx context.Context, ug *gloov1.UpstreamGroup, dryRun bool) (*validation.Reports, error) {
+				return reports(), fmt.Errorf(errMsg)
+			}
+			mv.fValidateDeleteUpstreamGroup = func(ctx context.Context, ug *core.ResourceRef, dryRun bool) error {
+				return fmt.Errorf(errMsg)
+			}
 			mv.fValidateDeleteSecret = func(ctx context.Context, secret *core.ResourceRef, dryRun bool) error {
 				return fmt.Errorf(errMsg)
 			}
@@ -151,12 +172,24 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 		Entry("invalid route table", false, v1.RouteTableCrd, v1.RouteTableCrd.GroupVersionKind(), v1beta1.Create, routeTable),
 		Entry("valid route table deletion", true, v1.RouteTableCrd, v1.RouteTableCrd.GroupVersionKind(), v1beta1.Delete, routeTable.GetMetadata().Ref()),
 		Entry("invalid route table deletion", false, v1.RouteTableCrd, v1.RouteTableCrd.GroupVersionKind(), v1beta1.Delete, routeTable.GetMetadata().Ref()),
+		Entry("valid virtual host option", true, v1.VirtualHostOptionCrd, v1.VirtualHostOptionCrd.GroupVersionKind(), v1beta1.Create, virtualHostOption),
+		Entry("invalid virtual host option", false, v1.VirtualHostOptionCrd, v1.VirtualHostOptionCrd.GroupVersionKind(), v1beta1.Create, virtualHostOption),
+		Entry("valid virtual host option deletion", true, v1.VirtualHostOptionCrd, v1.VirtualHostOptionCrd.GroupVersionKind(), v1beta1.Delete, virtualHostOption.GetMetadata().Ref()),
+		Entry("invalid virtual host option deletion", false, v1.VirtualHostOptionCrd, v1.VirtualHostOptionCrd.GroupVersionKind(), v1beta1.Delete, virtualHostOption.GetMetadata().Ref()),
+		Entry("valid route option", true, v1.RouteOptionCrd, v1.RouteOptionCrd.GroupVersionKind(), v1beta1.Create, routeOption),
+		Entry("invalid route option", false, v1.RouteOptionCrd, v1.RouteOptionCrd.GroupVersionKind(), v1beta1.Create, routeOption),
+		Entry("valid route option deletion", true, v1.RouteOptionCrd, v1.RouteOptionCrd.GroupVersionKind(), v1beta1.Delete, routeOption.GetMetadata().Ref()),
+		Entry("invalid route option deletion", false, v1.RouteOptionCrd, v1.RouteOptionCrd.GroupVersionKind(), v1beta1.Delete, routeOption.GetMetadata().Ref()),
 		Entry("valid unstructured list", true, nil, ListGVK, v1beta1.Create, unstructuredList),
 		Entry("invalid unstructured list", false, nil, ListGVK, v1beta1.Create, unstructuredList),
 		Entry("valid upstream", true, gloov1.UpstreamCrd, gloov1.UpstreamCrd.GroupVersionKind(), v1beta1.Create, upstream),
 		Entry("invalid upstream", false, gloov1.UpstreamCrd, gloov1.UpstreamCrd.GroupVersionKind(), v1beta1.Create, upstream),
 		Entry("valid upstream deletion", true, gloov1.UpstreamCrd, gloov1.UpstreamCrd.GroupVersionKind(), v1beta1.Delete, upstream.GetMetadata().Ref()),
 		Entry("invalid upstream deletion", false, gloov1.UpstreamCrd, gloov1.UpstreamCrd.GroupVersionKind(), v1beta1.Delete, upstream.GetMetadata().Ref()),
+		Entry("valid upstream group", true, gloov1.UpstreamGroupCrd, gloov1.UpstreamGroupCrd.GroupVersionKind(), v1beta1.Create, upstreamGroup),
+		Entry("invalid upstream group", false, gloov1.UpstreamGroupCrd, gloov1.UpstreamGroupCrd.GroupVersionKind(), v1beta1.Create, upstreamGroup),
+		Entry("valid upstream group deletion", true, gloov1.UpstreamGroupCrd, gloov1.UpstreamGroupCrd.GroupVersionKind(), v1beta1.Delete, upstreamGroup.GetMetadata().Ref()),
+		Entry("invalid upstream group deletion", false, gloov1.UpstreamGroupCrd, gloov1.UpstreamGroupCrd.GroupVersionKind(), v1beta1.Delete, upstreamGroup.GetMetadata().Ref()),
 		Entry("valid secret deletion", true, gloov1.SecretCrd, gloov1.SecretCrd.GroupVersionKind(), v1beta1.Delete, secret.GetMetadata().Ref()),
 		Entry("invalid secret deletion", false, gloov1.SecretCrd, gloov1.SecretCrd.GroupVersionKind(), v1beta1.Delete, secret.GetMetadata().Ref()),
 	)
@@ -355,16 +388,22 @@ func parseReviewResponse(resp *http.Response) (*AdmissionReviewWithProxies, erro
 }
 
 type mockValidator struct {
-	fSync                         func(context.Context, *v1.ApiSnapshot) error
-	fValidateList                 func(ctx context.Context, ul *unstructured.UnstructuredList, dryRun bool) (*validation.Reports, *multierror.Error)
-	fValidateGateway              func(ctx context.Context, gw *v1.Gateway, dryRun bool) (*validation.Reports, error)
-	fValidateVirtualService       func(ctx context.Context, vs *v1.VirtualService, dryRun bool) (*validation.Reports, error)
-	fValidateDeleteVirtualService func(ctx context.Context, vs *core.ResourceRef, dryRun bool) error
-	fValidateRouteTable           func(ctx context.Context, rt *v1.RouteTable, dryRun bool) (*validation.Reports, error)
-	fValidateDeleteRouteTable     func(ctx context.Context, rt *core.ResourceRef, dryRun bool) error
-	fValidateUpstream             func(ctx context.Context, us *gloov1.Upstream, dryRun bool) (*validation.Reports, error)
-	fValidateDeleteUpstream       func(ctx context.Context, us *core.ResourceRef, dryRun bool) error
-	fValidateDeleteSecret         func(ctx context.Context, secret *core.ResourceRef, dryRun bool) error
+	fSync                            func(context.Context, *v1.ApiSnapshot) error
+	fValidateList                    func(ctx context.Context, ul *unstructured.UnstructuredList, dryRun bool) (*validation.Reports, *multierror.Error)
+	fValidateGateway                 func(ctx context.Context, gw *v1.Gateway, dryRun bool) (*validation.Reports, error)
+	fValidateVirtualService          func(ctx context.Context, vs *v1.VirtualService, dryRun bool) (*validation.Reports, error)
+	fValidateDeleteVirtualService    func(ctx context.Context, vs *core.ResourceRef, dryRun bool) error
+	fValidateRouteTable              func(ctx context.Context, rt *v1.RouteTable, dryRun bool) (*validation.Reports, error)
+	fValidateDeleteRouteTable        func(ctx context.Context, rt *core.ResourceRef, dryRun bool) error
+	fValidateVirtualHostOption       func(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (*validation.Reports, error)
+	fValidateDeleteVirtualHostOption func(ctx context.Context, vho *core.ResourceRef, dryRun bool) error
+	fValidateRouteOption             func(ctx context.Context, ro *v1.RouteOption, dryRun bool) (*validation.Reports, error)
+	fValidateDeleteRouteOption       func(ctx context.Context, ro *core.ResourceRef, dryRun bool) error
+	fValidateUpstream                func(ctx context.Context, us *gloov1.Upstream, dryRun bool) (*validation.Reports, error)
+	fValidateDeleteUpstream          func(ctx context.Context, us *core.ResourceRef, dryRun bool) error
+	fValidateUpstreamGroup           func(ctx context.Context, ug *gloov1.UpstreamGroup, dryRun bool) (*validation.Reports, error)
+	fValidateDeleteUpstreamGroup     func(ctx context.Context, ug *core.ResourceRef, dryRun bool) error
+	fValidateDeleteSecret            func(ctx context.Context, secret *core.ResourceRef, dryRun bool) error
 }
 
 func (v *mockValidator) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
@@ -416,6 +455,34 @@ func (v *mockValidator) ValidateDeleteRouteTable(ctx context.Context, rt *core.R
 	return v.fValidateDeleteRouteTable(ctx, rt, dryRun)
 }
 
+func (v *mockValidator) ValidateVirtualHostOption(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (*validation.Reports, error) {
+	if v.fValidateVirtualHostOption == nil {
+		return reports(), nil
+	}
+	return v.fValidateVirtualHostOption(ctx, vho, dryRun)
+}
+
+func (v *mockValidator) ValidateDeleteVirtualHostOption(ctx context.Context, vho *core.ResourceRef, dryRun bool) error {
+	if v.fValidateDeleteVirtualHostOption == nil {
+		return nil
+	}
+	return v.fValidateDeleteVirtualHostOption(ctx, vho, dryRun)
+}
+
+func (v *mockValidator) ValidateRouteOption(ctx context.Context, ro *v1.RouteOption, dryRun bool) (*validation.Reports, error) {
+	if v.fValidateRouteOption == nil {
+		return reports(), nil
+	}
+	return v.fValidateRouteOption(ctx, ro, dryRun)
+}
+
+func (v *mockValidator) ValidateDeleteRouteOption(ctx context.Context, ro *core.ResourceRef, dryRun bool) error {
+	if v.fValidateDeleteRouteOption == nil {
+		return nil
+	}
+	return v.fValidateDeleteRouteOption(ctx, ro, dryRun)
+}
+
 func (v *mockValidator) ValidateUpstream(ctx context.Context, us *gloov1.Upstream, dryRun bool) (*validation.Reports, error) {
 	if v.fValidateUpstream == nil {
 		return reports(), nil
@@ -430,6 +497,20 @@ func (v *mockValidator) ValidateDeleteUpstream(ctx context.Context, us *core.Res
 	return v.fValidateDeleteUpstream(ctx, us, dryRun)
 }
 
+func (v *mockValidator) ValidateUpstreamGroup(ctx context.Context, ug *gloov1.UpstreamGroup, dryRun bool) (*validation.Reports, error) {
+	if v.fValidateUpstreamGroup == nil {
+		return reports(), nil
+	}
+	return v.fValidateUpstreamGroup(ctx, ug, dryRun)
+}
+
+func (v *mockValidator) ValidateDeleteUpstreamGroup(ctx context.Context, ug *core.ResourceRef, dryRun bool) error {
+	if v.fValidateDeleteUpstreamGroup == nil {
+		return nil
+	}
+	return v.fValidateDeleteUpstreamGroup(ctx, ug, dryRun)
+}
+
 func (v *mockValidator) ValidateDeleteSecret(ctx context.Context, secret *core.ResourceRef, dryRun bool) error {
 	if v.fValidateDeleteSecret == nil {
 		return nil
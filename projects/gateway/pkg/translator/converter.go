@@ -13,6 +13,7 @@ import (
 	errors "github.com/rotisserie/eris"
 	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	matchersv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
@@ -30,12 +31,14 @@ const (
 var (
 	NoActionErr          = errors.New("invalid route: route must specify an action")
 	MatcherCountErr      = errors.New("invalid route: routes with delegate actions must omit or specify a single matcher")
-	MissingPrefixErr     = errors.New("invalid route: routes with delegate actions must use a prefix matcher")
+	MissingPrefixErr     = errors.New("invalid route: routes with delegate actions must use a prefix matcher, or a grpc matcher without method")
 	InvalidPrefixErr     = errors.New("invalid route: route table matchers must begin with the prefix of their parent route's matcher")
 	InvalidPathMatchErr  = errors.New("invalid route: route table matchers must have the same case sensitivity of their parent route's matcher")
 	InvalidHeaderErr     = errors.New("invalid route: route table matchers must have all headers that were specified on their parent route's matcher")
 	InvalidQueryParamErr = errors.New("invalid route: route table matchers must have all query params that were specified on their parent route's matcher")
 	InvalidMethodErr     = errors.New("invalid route: route table matchers must have all methods that were specified on their parent route's matcher")
+	InvalidSourceIpErr   = errors.New("invalid route: route table matchers must have source prefix ranges within those that were specified on their parent route's matcher")
+	InvalidTlsErr        = errors.New("invalid route: route table matchers must have all tls properties that were specified on their parent route's matcher")
 
 	UnnamedRoute = func(index int) string {
 		return fmt.Sprintf("<unnamed-%d>", index)
@@ -55,6 +58,12 @@ var (
 	InvalidRouteTableForDelegateMethodsWarning = func(delegateMethods, childMethods []string) error {
 		return errors.Wrapf(InvalidMethodErr, "required methods: %v, methods: %v", delegateMethods, childMethods)
 	}
+	InvalidRouteTableForDelegateSourcePrefixRangesWarning = func(delegateRanges, childRanges []*v3.CidrRange) error {
+		return errors.Wrapf(InvalidSourceIpErr, "required source prefix ranges: %v, source prefix ranges: %v", delegateRanges, childRanges)
+	}
+	InvalidRouteTableForDelegateTlsWarning = func(delegateTls, childTls *matchersv1.TlsMatcher) error {
+		return errors.Wrapf(InvalidTlsErr, "required tls: %v, tls: %v", delegateTls, childTls)
+	}
 	TopLevelVirtualResourceErr = func(rtRef *core.Metadata, err error) error {
 		return errors.Wrapf(err, "on sub route table %s", rtRef.Ref().Key())
 	}
@@ -453,7 +462,8 @@ func getDelegateRouteMatcher(route *gatewayv1.Route) (*matchersv1.Matcher, error
 		if matcher.GetPathSpecifier() == nil {
 			return defaults.DefaultMatcher(), nil // no path specifier provided, default to '/' prefix matcher
 		}
-		if matcher.GetPrefix() == "" {
+		// a grpc matcher without method matches the path prefix of its service
+		if matcher.GetPrefix() == "" && (matcher.GetGrpc() == nil || matcher.GetGrpc().GetMethod() != "") {
 			return nil, MissingPrefixErr
 		}
 		return matcher, nil
@@ -503,6 +513,11 @@ func validateAndMergeParentRoute(child *gatewayv1.Route, parent *routeInfo) (*ga
 			childMatch.Headers = append(parent.matcher.GetHeaders(), childMatch.GetHeaders()...)
 			childMatch.Methods = append(parent.matcher.GetMethods(), childMatch.GetMethods()...)
 			childMatch.QueryParameters = append(parent.matcher.GetQueryParameters(), childMatch.GetQueryParameters()...)
+			// unlike the conditions above, source prefix ranges are ORed, so they are only inherited if the child has none
+			if len(childMatch.GetSourcePrefixRanges()) == 0 {
+				childMatch.SourcePrefixRanges = parent.matcher.GetSourcePrefixRanges()
+			}
+			childMatch.Tls = mergeTlsMatchers(childMatch.GetTls(), parent.matcher.GetTls())
 		}
 	}
 	// If child has inheritTransformations specified, append transformations from parent to child route
@@ -526,8 +541,9 @@ func isRouteTableValidForDelegateMatcher(parentMatcher *matchersv1.Matcher, chil
 
 	for _, childMatch := range childRoute.GetMatchers() {
 		// ensure all sub-routes in the delegated route table match the parent prefix
-		if pathString := glooutils.PathAsString(childMatch); !strings.HasPrefix(pathString, parentMatcher.GetPrefix()) {
-			return InvalidRouteTableForDelegatePrefixWarning(parentMatcher.GetPrefix(), pathString)
+		parentPrefix := glooutils.PathAsString(parentMatcher)
+		if pathString := glooutils.PathAsString(childMatch); !strings.HasPrefix(pathString, parentPrefix) {
+			return InvalidRouteTableForDelegatePrefixWarning(parentPrefix, pathString)
 		}
 
 		// ensure all sub-routes matches in the delegated route match the parent case sensitivity
@@ -566,10 +582,55 @@ func isRouteTableValidForDelegateMatcher(parentMatcher *matchersv1.Matcher, chil
 		if !childMethodsSet.HasAll(parentMatcher.GetMethods()...) {
 			return InvalidRouteTableForDelegateMethodsWarning(parentMatcher.GetMethods(), childMatch.GetMethods())
 		}
+
+		// ensure all source prefix ranges in the delegated route table fall within those of the parent route resource
+		if !glooutils.CidrRangesWithin(childMatch.GetSourcePrefixRanges(), parentMatcher.GetSourcePrefixRanges()) {
+			return InvalidRouteTableForDelegateSourcePrefixRangesWarning(parentMatcher.GetSourcePrefixRanges(), childMatch.GetSourcePrefixRanges())
+		}
+
+		// ensure all tls properties in the delegated route table are those of the parent route resource
+		if !tlsMatcherHasAll(childMatch.GetTls(), parentMatcher.GetTls()) {
+			return InvalidRouteTableForDelegateTlsWarning(parentMatcher.GetTls(), childMatch.GetTls())
+		}
 	}
 	return nil
 }
 
+// returns true if the child sets every tls property that the parent sets, to the same value,
+// and only matches on SNI domains that the parent matches on
+func tlsMatcherHasAll(child, parent *matchersv1.TlsMatcher) bool {
+	return (parent.GetSecure() == nil || proto.Equal(parent.GetSecure(), child.GetSecure())) &&
+		(parent.GetClientCertificatePresented() == nil || proto.Equal(parent.GetClientCertificatePresented(), child.GetClientCertificatePresented())) &&
+		(parent.GetClientCertificateValidated() == nil || proto.Equal(parent.GetClientCertificateValidated(), child.GetClientCertificateValidated())) &&
+		(len(parent.GetSniDomains()) == 0 || (len(child.GetSniDomains()) > 0 && sets.NewString(parent.GetSniDomains()...).HasAll(child.GetSniDomains()...)))
+}
+
+// returns the tls matcher of the child, with the properties it doesn't specify set to those of the parent
+func mergeTlsMatchers(child, parent *matchersv1.TlsMatcher) *matchersv1.TlsMatcher {
+	if parent == nil {
+		return child
+	}
+	merged := &matchersv1.TlsMatcher{
+		Secure:                     child.GetSecure(),
+		ClientCertificatePresented: child.GetClientCertificatePresented(),
+		ClientCertificateValidated: child.GetClientCertificateValidated(),
+		SniDomains:                 child.GetSniDomains(),
+	}
+	if merged.GetSecure() == nil {
+		merged.Secure = parent.GetSecure()
+	}
+	if merged.GetClientCertificatePresented() == nil {
+		merged.ClientCertificatePresented = parent.GetClientCertificatePresented()
+	}
+	if merged.GetClientCertificateValidated() == nil {
+		merged.ClientCertificateValidated = parent.GetClientCertificateValidated()
+	}
+	if len(merged.GetSniDomains()) == 0 {
+		merged.SniDomains = parent.GetSniDomains()
+	}
+	return merged
+}
+
 // Handles new and deprecated format for referencing a route table
 // TODO: remove this function when we remove the deprecated fields from the API
 func getRouteTableRef(delegate *gatewayv1.DelegateAction) *core.ResourceRef {
@@ -9,12 +9,14 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	glootransformation "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/transformation"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	. "github.com/solo-io/solo-kit/test/matchers"
 )
 
 var _ = Describe("Route converter", func() {
@@ -93,6 +95,27 @@ var _ = Describe("Route converter", func() {
 			translator.MissingPrefixErr,
 		),
 
+		Entry("route has a grpc matcher of a single method",
+			&v1.Route{
+				Matchers: []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Grpc{
+						Grpc: &matchers.GrpcMatcher{Service: "foo.Bar", Method: "Baz"},
+					},
+				}},
+				Action: &v1.Route_DelegateAction{
+					DelegateAction: &v1.DelegateAction{
+						DelegationType: &v1.DelegateAction_Ref{
+							Ref: &core.ResourceRef{
+								Name:      "any",
+								Namespace: "ns",
+							},
+						},
+					},
+				},
+			},
+			translator.MissingPrefixErr,
+		),
+
 		Entry("route has multiple path prefix matchers",
 			&v1.Route{
 				Matchers: []*matchers.Matcher{
@@ -579,6 +602,45 @@ var _ = Describe("Route converter", func() {
 				Expect(converted[0].Matchers[0].GetPrefix()).To(Equal("/foo"))
 			})
 
+			It("inherits the source prefix ranges and tls properties of its parent when inheritance is on", func() {
+				parentRanges := []*v3.CidrRange{{AddressPrefix: "10.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 8}}}
+				childRanges := []*v3.CidrRange{{AddressPrefix: "10.1.0.0", PrefixLen: &wrappers.UInt32Value{Value: 16}}}
+				vs.VirtualHost.Routes[0].Matchers[0].SourcePrefixRanges = parentRanges
+				vs.VirtualHost.Routes[0].Matchers[0].Tls = &matchers.TlsMatcher{Secure: &wrappers.BoolValue{Value: true}, SniDomains: []string{"foo.com"}}
+
+				rt.Routes[0].Matchers = []*matchers.Matcher{
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{
+							Prefix: "/foo/bar",
+						},
+					},
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{
+							Prefix: "/foo/baz",
+						},
+						SourcePrefixRanges: childRanges,
+						Tls:                &matchers.TlsMatcher{ClientCertificatePresented: &wrappers.BoolValue{Value: true}},
+					},
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, gw, "proxy1", snapshot, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(HaveLen(1))
+				Expect(rpt).To(HaveLen(0))
+
+				Expect(converted[0].Matchers).To(HaveLen(2))
+				Expect(converted[0].Matchers[0].SourcePrefixRanges).To(Equal(parentRanges))
+				Expect(converted[0].Matchers[0].Tls).To(MatchProto(&matchers.TlsMatcher{Secure: &wrappers.BoolValue{Value: true}, SniDomains: []string{"foo.com"}}))
+				// the ranges of the child are narrower than those of the parent, so they are kept
+				Expect(converted[0].Matchers[1].SourcePrefixRanges).To(Equal(childRanges))
+				Expect(converted[0].Matchers[1].Tls).To(MatchProto(&matchers.TlsMatcher{
+					Secure:                     &wrappers.BoolValue{Value: true},
+					ClientCertificatePresented: &wrappers.BoolValue{Value: true},
+					SniDomains:                 []string{"foo.com"},
+				}))
+			})
+
 			It("child route inheritance config overrides parent route inheritance config", func() {
 
 				vs.VirtualHost.Routes[0].InheritableMatchers = &wrappers.BoolValue{Value: false}
@@ -919,6 +981,64 @@ var _ = Describe("Route converter", func() {
 			})
 		})
 
+		When("the parent route matches a grpc service", func() {
+
+			BeforeEach(func() {
+				vs.VirtualHost.Routes[0].Matchers = []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Grpc{
+						Grpc: &matchers.GrpcMatcher{Service: "foo.Bar"},
+					},
+				}}
+			})
+
+			It("accepts route table matchers of the service", func() {
+				rt.Routes[0].Matchers = []*matchers.Matcher{
+					{
+						PathSpecifier: &matchers.Matcher_Grpc{
+							Grpc: &matchers.GrpcMatcher{Service: "foo.Bar", Method: "Baz"},
+						},
+					},
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{
+							Prefix: "/foo.Bar/Qux",
+						},
+					},
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, gw, "proxy1", snapshot, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(HaveLen(1))
+				Expect(rpt.ValidateStrict()).NotTo(HaveOccurred())
+			})
+
+			It("reports warning on the route table and on the virtual service for matchers of other paths", func() {
+				rt.Routes[0].Matchers = []*matchers.Matcher{
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{
+							Prefix: "/invalid",
+						},
+					},
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, gw, "proxy1", snapshot, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(BeNil())
+				Expect(rpt).To(HaveLen(2))
+
+				expectedWarning := translator.InvalidRouteTableForDelegatePrefixWarning("/foo.Bar/", "/invalid").Error()
+
+				_, vsReport := rpt.Find("*v1.VirtualService", vs.Metadata.Ref())
+				Expect(vsReport.Warnings).To(HaveLen(1))
+				Expect(vsReport.Warnings[0]).To(ContainSubstring(expectedWarning))
+
+				_, rtReport := rpt.Find("*v1.RouteTable", rt.Metadata.Ref())
+				Expect(rtReport.Warnings).To(HaveLen(1))
+				Expect(rtReport.Warnings[0]).To(ContainSubstring(expectedWarning))
+			})
+		})
+
 		When("route table has a matcher case sensitivity that doesn't match the prefix case sensitivity of the parent route", func() {
 			It("reports warning on the route table and on the virtual service", func() {
 				rtCaseSensitivity := &wrappers.BoolValue{Value: false}
@@ -1350,6 +1470,176 @@ var _ = Describe("Route converter", func() {
 
 		})
 
+		When("route table has source prefix ranges that don't fall within those of the parent route", func() {
+
+			var (
+				ranges []*v3.CidrRange
+			)
+
+			BeforeEach(func() {
+				ranges = []*v3.CidrRange{{AddressPrefix: "10.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 8}}}
+				vs.VirtualHost.Routes[0].Matchers[0].SourcePrefixRanges = ranges
+			})
+
+			It("accepts the route table if its source prefix ranges are narrower than those of its parent", func() {
+				narrower := []*v3.CidrRange{{AddressPrefix: "10.1.2.0", PrefixLen: &wrappers.UInt32Value{Value: 24}}}
+				rt.Routes[0].Matchers = []*matchers.Matcher{
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{
+							Prefix: "/foo/bar",
+						},
+						SourcePrefixRanges: narrower,
+					},
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, gw, "proxy1", snapshot, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(HaveLen(1))
+				Expect(rpt).To(HaveLen(0))
+			})
+
+			It("reports warning on the route table and on the virtual service if the route table has broader source prefix ranges", func() {
+				broader := []*v3.CidrRange{{AddressPrefix: "0.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 0}}}
+				rt.Routes[0].Matchers = []*matchers.Matcher{
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{
+							Prefix: "/foo/bar",
+						},
+						SourcePrefixRanges: broader,
+					},
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, gw, "proxy1", snapshot, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(BeNil())
+				Expect(rpt).To(HaveLen(2))
+
+				expectedWarning := translator.InvalidRouteTableForDelegateSourcePrefixRangesWarning(ranges, broader).Error()
+
+				_, vsReport := rpt.Find("*v1.VirtualService", vs.Metadata.Ref())
+				Expect(vsReport.Errors).NotTo(HaveOccurred())
+				Expect(vsReport.Warnings).To(HaveLen(1))
+				Expect(vsReport.Warnings[0]).To(ContainSubstring(expectedWarning))
+
+				_, rtReport := rpt.Find("*v1.RouteTable", rt.Metadata.Ref())
+				Expect(rtReport.Errors).NotTo(HaveOccurred())
+				Expect(rtReport.Warnings).To(HaveLen(1))
+				Expect(rtReport.Warnings[0]).To(ContainSubstring(expectedWarning))
+			})
+		})
+
+		When("route table has tls properties that don't match the tls properties of the parent route", func() {
+
+			var (
+				tls *matchers.TlsMatcher
+			)
+
+			BeforeEach(func() {
+				tls = &matchers.TlsMatcher{Secure: &wrappers.BoolValue{Value: true}}
+				vs.VirtualHost.Routes[0].Matchers[0].Tls = tls
+			})
+
+			It("accepts the route table if it has all the tls properties of its parent", func() {
+				rt.Routes[0].Matchers = []*matchers.Matcher{
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{
+							Prefix: "/foo/bar",
+						},
+						Tls: &matchers.TlsMatcher{
+							Secure:                     &wrappers.BoolValue{Value: true},
+							ClientCertificateValidated: &wrappers.BoolValue{Value: true},
+						},
+					},
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, gw, "proxy1", snapshot, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(HaveLen(1))
+				Expect(rpt).To(HaveLen(0))
+			})
+
+			It("reports warning on the route table and on the virtual service if the route table has conflicting tls properties", func() {
+				conflicting := &matchers.TlsMatcher{Secure: &wrappers.BoolValue{Value: false}}
+				rt.Routes[0].Matchers = []*matchers.Matcher{
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{
+							Prefix: "/foo/bar",
+						},
+						Tls: conflicting,
+					},
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, gw, "proxy1", snapshot, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(BeNil())
+				Expect(rpt).To(HaveLen(2))
+
+				expectedWarning := translator.InvalidRouteTableForDelegateTlsWarning(tls, conflicting).Error()
+
+				_, vsReport := rpt.Find("*v1.VirtualService", vs.Metadata.Ref())
+				Expect(vsReport.Errors).NotTo(HaveOccurred())
+				Expect(vsReport.Warnings).To(HaveLen(1))
+				Expect(vsReport.Warnings[0]).To(ContainSubstring(expectedWarning))
+
+				_, rtReport := rpt.Find("*v1.RouteTable", rt.Metadata.Ref())
+				Expect(rtReport.Errors).NotTo(HaveOccurred())
+				Expect(rtReport.Warnings).To(HaveLen(1))
+				Expect(rtReport.Warnings[0]).To(ContainSubstring(expectedWarning))
+			})
+
+			It("accepts the route table if it only matches on sni domains of its parent", func() {
+				tls.SniDomains = []string{"foo.com", "bar.com"}
+				rt.Routes[0].Matchers = []*matchers.Matcher{
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{
+							Prefix: "/foo/bar",
+						},
+						Tls: &matchers.TlsMatcher{
+							Secure:     &wrappers.BoolValue{Value: true},
+							SniDomains: []string{"foo.com"},
+						},
+					},
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, gw, "proxy1", snapshot, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(HaveLen(1))
+				Expect(rpt).To(HaveLen(0))
+			})
+
+			It("reports warning on the route table and on the virtual service if the route table matches on other sni domains", func() {
+				tls.SniDomains = []string{"foo.com"}
+				conflicting := &matchers.TlsMatcher{
+					Secure:     &wrappers.BoolValue{Value: true},
+					SniDomains: []string{"foo.com", "bar.com"},
+				}
+				rt.Routes[0].Matchers = []*matchers.Matcher{
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{
+							Prefix: "/foo/bar",
+						},
+						Tls: conflicting,
+					},
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, gw, "proxy1", snapshot, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(BeNil())
+				Expect(rpt).To(HaveLen(2))
+
+				expectedWarning := translator.InvalidRouteTableForDelegateTlsWarning(tls, conflicting).Error()
+				_, rtReport := rpt.Find("*v1.RouteTable", rt.Metadata.Ref())
+				Expect(rtReport.Warnings).To(HaveLen(1))
+				Expect(rtReport.Warnings[0]).To(ContainSubstring(expectedWarning))
+			})
+		})
+
 		When("route table has no matchers and the parent route matcher is not the default one", func() {
 			It("reports warning on the route table and on the virtual service", func() {
 				rpt := reporter.ResourceReports{}
@@ -413,10 +413,11 @@ func validatePrefixHijacking(vs *v1.VirtualService, vh *gloov1.VirtualHost, repo
 			// we are trying to help users avoid misconfiguration and short-circuiting errors
 			for _, prefix := range seenPrefixMatchers {
 				if prefixShortCircuits(matcher, prefix) && nonPathEarlyMatcherShortCircuitsLateMatcher(matcher, prefix) {
-					reports.AddWarning(vs, UnorderedPrefixErr(vh.GetName(), prefix.GetPrefix(), matcher).Error())
+					reports.AddWarning(vs, UnorderedPrefixErr(vh.GetName(), utils.PathAsString(prefix), matcher).Error())
 				}
 			}
-			if matcher.GetPrefix() != "" {
+			// a grpc matcher without method matches the path prefix of its service
+			if matcher.GetPrefix() != "" || matcher.GetGrpc() != nil && matcher.GetGrpc().GetMethod() == "" {
 				seenPrefixMatchers = append(seenPrefixMatchers, matcher)
 			}
 		}
@@ -425,7 +426,7 @@ func validatePrefixHijacking(vs *v1.VirtualService, vh *gloov1.VirtualHost, repo
 
 func prefixShortCircuits(laterMatcher, earlierMatcher *matchers.Matcher) bool {
 	laterPath := utils.PathAsString(laterMatcher)
-	return strings.HasPrefix(laterPath, earlierMatcher.GetPrefix()) && laterMatcher.GetCaseSensitive() == earlierMatcher.GetCaseSensitive()
+	return strings.HasPrefix(laterPath, utils.PathAsString(earlierMatcher)) && laterMatcher.GetCaseSensitive() == earlierMatcher.GetCaseSensitive()
 }
 
 func validateRegexHijacking(vs *v1.VirtualService, vh *gloov1.VirtualHost, reports reporter.ResourceReports) {
@@ -489,7 +490,13 @@ func nonPathEarlyMatcherShortCircuitsLateMatcher(laterMatcher, earlierMatcher *m
 
 	queryParamsShortCircuited := earlyQueryParametersShortCircuitedLaterOnes(lateMatcher, earlyMatcher)
 	headersShortCircuited := earlyHeaderMatchersShortCircuitLaterOnes(lateMatcher, earlyMatcher)
-	return queryParamsShortCircuited && headersShortCircuited
+
+	// an early grpc matcher only matches grpc requests, so it can only short-circuit later grpc matchers
+	grpcShortCircuited := earlierMatcher.GetGrpc() == nil || laterMatcher.GetGrpc() != nil
+	sourcePrefixRangesShortCircuited := utils.CidrRangesWithin(laterMatcher.GetSourcePrefixRanges(), earlierMatcher.GetSourcePrefixRanges())
+	tlsShortCircuited := tlsMatcherHasAll(laterMatcher.GetTls(), earlierMatcher.GetTls())
+
+	return queryParamsShortCircuited && headersShortCircuited && grpcShortCircuited && sourcePrefixRangesShortCircuited && tlsShortCircuited
 }
 
 // returns true if the query parameter matcher conditions (or lack thereof) on the early matcher can completely
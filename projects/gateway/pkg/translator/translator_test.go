@@ -965,6 +965,34 @@ var _ = Describe("Translator", func() {
 						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1"}, Headers: []*matchers.HeaderMatcher{{Name: ":method", Value: "GET", InvertMatch: true}}},
 						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1"}, Methods: []string{"GET", "POST"}}, // The POST method here is unreachable
 						UnorderedPrefixErr("gloo-system.name1", "/1", &matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1"}, Methods: []string{"GET", "POST"}})),
+					Entry("grpc service hijacking",
+						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Grpc{Grpc: &matchers.GrpcMatcher{Service: "foo.Bar"}}},
+						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Grpc{Grpc: &matchers.GrpcMatcher{Service: "foo.Bar", Method: "Baz"}}},
+						UnorderedPrefixErr("gloo-system.name1", "/foo.Bar/", &matchers.Matcher{PathSpecifier: &matchers.Matcher_Grpc{Grpc: &matchers.GrpcMatcher{Service: "foo.Bar", Method: "Baz"}}})),
+					Entry("grpc service doesn't hijack non grpc requests",
+						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Grpc{Grpc: &matchers.GrpcMatcher{Service: "foo.Bar"}}},
+						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo.Bar/Baz"}},
+						nil),
+					Entry("prefix hijacking of grpc method",
+						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo.Bar"}},
+						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Grpc{Grpc: &matchers.GrpcMatcher{Service: "foo.Bar", Method: "Baz"}}},
+						UnorderedPrefixErr("gloo-system.name1", "/foo.Bar", &matchers.Matcher{PathSpecifier: &matchers.Matcher_Grpc{Grpc: &matchers.GrpcMatcher{Service: "foo.Bar", Method: "Baz"}}})),
+					Entry("prefix hijacking with source prefix ranges, late matcher within early ranges",
+						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1"}, SourcePrefixRanges: []*v3.CidrRange{{AddressPrefix: "10.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 8}}}},
+						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1/2"}, SourcePrefixRanges: []*v3.CidrRange{{AddressPrefix: "10.1.0.0", PrefixLen: &wrappers.UInt32Value{Value: 16}}}},
+						UnorderedPrefixErr("gloo-system.name1", "/1", &matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1/2"}, SourcePrefixRanges: []*v3.CidrRange{{AddressPrefix: "10.1.0.0", PrefixLen: &wrappers.UInt32Value{Value: 16}}}})),
+					Entry("prefix hijacking with source prefix ranges, late matcher outside early ranges",
+						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1"}, SourcePrefixRanges: []*v3.CidrRange{{AddressPrefix: "10.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 8}}}},
+						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1/2"}},
+						nil),
+					Entry("prefix hijacking with tls matcher, late matcher has the same tls properties",
+						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1"}, Tls: &matchers.TlsMatcher{ClientCertificatePresented: &wrappers.BoolValue{Value: true}}},
+						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1/2"}, Tls: &matchers.TlsMatcher{ClientCertificatePresented: &wrappers.BoolValue{Value: true}}},
+						UnorderedPrefixErr("gloo-system.name1", "/1", &matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1/2"}, Tls: &matchers.TlsMatcher{ClientCertificatePresented: &wrappers.BoolValue{Value: true}}})),
+					Entry("prefix hijacking with tls matcher, late matcher has different tls properties",
+						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1"}, Tls: &matchers.TlsMatcher{ClientCertificatePresented: &wrappers.BoolValue{Value: true}}},
+						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/1/2"}, Tls: &matchers.TlsMatcher{ClientCertificatePresented: &wrappers.BoolValue{Value: false}}},
+						nil),
 					Entry("invalid regex doesn't crash",
 						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Regex{Regex: "["}},
 						&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"}},
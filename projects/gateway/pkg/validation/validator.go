@@ -29,9 +29,10 @@ import (
 )
 
 type Reports struct {
-	Proxies         []*gloov1.Proxy
-	ProxyReports    *ProxyReports
-	UpstreamReports *UpstreamReports
+	Proxies              []*gloov1.Proxy
+	ProxyReports         *ProxyReports
+	UpstreamReports      *UpstreamReports
+	UpstreamGroupReports *UpstreamGroupReports
 }
 
 func (r *Reports) GetProxies() []*gloov1.Proxy {
@@ -43,6 +44,7 @@ func (r *Reports) GetProxies() []*gloov1.Proxy {
 
 type ProxyReports []*validation.ProxyReport
 type UpstreamReports []*validation.ResourceReport
+type UpstreamGroupReports []*validation.ResourceReport
 
 var (
 	NotReadyErr = errors.Errorf("validation is not yet available. Waiting for first snapshot")
@@ -80,8 +82,14 @@ type Validator interface {
 	ValidateDeleteVirtualService(ctx context.Context, vs *core.ResourceRef, dryRun bool) error
 	ValidateRouteTable(ctx context.Context, rt *v1.RouteTable, dryRun bool) (*Reports, error)
 	ValidateDeleteRouteTable(ctx context.Context, rt *core.ResourceRef, dryRun bool) error
+	ValidateVirtualHostOption(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (*Reports, error)
+	ValidateDeleteVirtualHostOption(ctx context.Context, vho *core.ResourceRef, dryRun bool) error
+	ValidateRouteOption(ctx context.Context, ro *v1.RouteOption, dryRun bool) (*Reports, error)
+	ValidateDeleteRouteOption(ctx context.Context, ro *core.ResourceRef, dryRun bool) error
 	ValidateUpstream(ctx context.Context, us *gloov1.Upstream, dryRun bool) (*Reports, error)
 	ValidateDeleteUpstream(ctx context.Context, us *core.ResourceRef, dryRun bool) error
+	ValidateUpstreamGroup(ctx context.Context, ug *gloov1.UpstreamGroup, dryRun bool) (*Reports, error)
+	ValidateDeleteUpstreamGroup(ctx context.Context, ug *core.ResourceRef, dryRun bool) error
 	ValidateDeleteSecret(ctx context.Context, secret *core.ResourceRef, dryRun bool) error
 }
 
@@ -393,6 +401,22 @@ func (v *validator) processItem(ctx context.Context, item unstructured.Unstructu
 			return &Reports{ProxyReports: &ProxyReports{}}, WrappedUnmarshalErr(unmarshalErr)
 		}
 		return v.validateRouteTableInternal(ctx, &rt, false, false)
+	case v1.VirtualHostOptionGVK:
+		var (
+			vho v1.VirtualHostOption
+		)
+		if unmarshalErr := skprotoutils.UnmarshalResource(jsonBytes, &vho); unmarshalErr != nil {
+			return &Reports{ProxyReports: &ProxyReports{}}, WrappedUnmarshalErr(unmarshalErr)
+		}
+		return v.validateVirtualHostOptionInternal(ctx, &vho, false, false)
+	case v1.RouteOptionGVK:
+		var (
+			ro v1.RouteOption
+		)
+		if unmarshalErr := skprotoutils.UnmarshalResource(jsonBytes, &ro); unmarshalErr != nil {
+			return &Reports{ProxyReports: &ProxyReports{}}, WrappedUnmarshalErr(unmarshalErr)
+		}
+		return v.validateRouteOptionInternal(ctx, &ro, false, false)
 
 	case gloov1.UpstreamGVK:
 		// TODO(mitchaman): Handle upstreams
@@ -573,6 +597,120 @@ func (v *validator) ValidateDeleteRouteTable(ctx context.Context, rtRef *core.Re
 	return nil
 }
 
+func (v *validator) ValidateVirtualHostOption(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (*Reports, error) {
+	return v.validateVirtualHostOptionInternal(ctx, vho, dryRun, true)
+}
+
+func (v *validator) validateVirtualHostOptionInternal(ctx context.Context, vho *v1.VirtualHostOption, dryRun, acquireLock bool) (*Reports, error) {
+	apply := func(snap *v1.ApiSnapshot) ([]string, resources.Resource, *core.ResourceRef) {
+		vhoRef := vho.GetMetadata().Ref()
+
+		// TODO: move this to a function when generics become a thing
+		var isUpdate bool
+		for i, existingVho := range snap.VirtualHostOptions {
+			if existingVho.GetMetadata().Ref().Equal(vhoRef) {
+				// replace the existing virtual host option in the snapshot
+				snap.VirtualHostOptions[i] = vho
+				isUpdate = true
+				break
+			}
+		}
+		if !isUpdate {
+			snap.VirtualHostOptions = append(snap.VirtualHostOptions, vho)
+			snap.VirtualHostOptions.Sort()
+		}
+
+		return proxiesForVirtualHostOption(snap.Gateways, snap.VirtualServices, vhoRef), vho, vhoRef
+	}
+
+	if acquireLock {
+		return v.validateSnapshotThreadSafe(ctx, apply, dryRun)
+	} else {
+		return v.validateSnapshot(ctx, apply, dryRun)
+	}
+}
+
+func (v *validator) ValidateDeleteVirtualHostOption(ctx context.Context, vhoRef *core.ResourceRef, dryRun bool) error {
+	if !v.ready() {
+		return errors.Errorf("Gateway validation is yet not available. Waiting for first snapshot")
+	}
+	apply := func(snap *v1.ApiSnapshot) ([]string, resources.Resource, *core.ResourceRef) {
+		vho, err := snap.VirtualHostOptions.Find(vhoRef.Strings())
+		if err != nil {
+			// if it's not present in the snapshot, no proxy is affected by its deletion
+			return nil, &v1.VirtualHostOption{Metadata: &core.Metadata{Name: vhoRef.GetName(), Namespace: vhoRef.GetNamespace()}}, vhoRef
+		}
+		for i, existingVho := range snap.VirtualHostOptions {
+			if existingVho.GetMetadata().Ref().Equal(vhoRef) {
+				snap.VirtualHostOptions = append(snap.VirtualHostOptions[:i], snap.VirtualHostOptions[i+1:]...)
+				break
+			}
+		}
+		// the virtual services still referencing the option fail to translate once it is removed
+		return proxiesForVirtualHostOption(snap.Gateways, snap.VirtualServices, vhoRef), vho, vhoRef
+	}
+
+	_, err := v.validateSnapshotThreadSafe(ctx, apply, dryRun)
+	return err
+}
+
+func (v *validator) ValidateRouteOption(ctx context.Context, ro *v1.RouteOption, dryRun bool) (*Reports, error) {
+	return v.validateRouteOptionInternal(ctx, ro, dryRun, true)
+}
+
+func (v *validator) validateRouteOptionInternal(ctx context.Context, ro *v1.RouteOption, dryRun, acquireLock bool) (*Reports, error) {
+	apply := func(snap *v1.ApiSnapshot) ([]string, resources.Resource, *core.ResourceRef) {
+		roRef := ro.GetMetadata().Ref()
+
+		// TODO: move this to a function when generics become a thing
+		var isUpdate bool
+		for i, existingRo := range snap.RouteOptions {
+			if existingRo.GetMetadata().Ref().Equal(roRef) {
+				// replace the existing route option in the snapshot
+				snap.RouteOptions[i] = ro
+				isUpdate = true
+				break
+			}
+		}
+		if !isUpdate {
+			snap.RouteOptions = append(snap.RouteOptions, ro)
+			snap.RouteOptions.Sort()
+		}
+
+		return proxiesForRouteOption(snap.Gateways, snap.VirtualServices, snap.RouteTables, roRef), ro, roRef
+	}
+
+	if acquireLock {
+		return v.validateSnapshotThreadSafe(ctx, apply, dryRun)
+	} else {
+		return v.validateSnapshot(ctx, apply, dryRun)
+	}
+}
+
+func (v *validator) ValidateDeleteRouteOption(ctx context.Context, roRef *core.ResourceRef, dryRun bool) error {
+	if !v.ready() {
+		return errors.Errorf("Gateway validation is yet not available. Waiting for first snapshot")
+	}
+	apply := func(snap *v1.ApiSnapshot) ([]string, resources.Resource, *core.ResourceRef) {
+		ro, err := snap.RouteOptions.Find(roRef.Strings())
+		if err != nil {
+			// if it's not present in the snapshot, no proxy is affected by its deletion
+			return nil, &v1.RouteOption{Metadata: &core.Metadata{Name: roRef.GetName(), Namespace: roRef.GetNamespace()}}, roRef
+		}
+		for i, existingRo := range snap.RouteOptions {
+			if existingRo.GetMetadata().Ref().Equal(roRef) {
+				snap.RouteOptions = append(snap.RouteOptions[:i], snap.RouteOptions[i+1:]...)
+				break
+			}
+		}
+		// the routes still referencing the option fail to translate once it is removed
+		return proxiesForRouteOption(snap.Gateways, snap.VirtualServices, snap.RouteTables, roRef), ro, roRef
+	}
+
+	_, err := v.validateSnapshotThreadSafe(ctx, apply, dryRun)
+	return err
+}
+
 func (v *validator) ValidateGateway(ctx context.Context, gw *v1.Gateway, dryRun bool) (*Reports, error) {
 	return v.validateGatewayInternal(ctx, gw, dryRun, true)
 }
@@ -655,6 +793,53 @@ func (v *validator) ValidateDeleteUpstream(ctx context.Context, upstreamRef *cor
 	return err
 }
 
+func (v *validator) ValidateUpstreamGroup(ctx context.Context, ug *gloov1.UpstreamGroup, dryRun bool) (*Reports, error) {
+	response, err := v.sendGlooValidationServiceRequest(ctx, &validation.GlooValidationServiceRequest{
+		// Sending a nil proxy causes the upstream group to be translated with all proxies in gloo's snapshot
+		Proxy: nil,
+		Resources: &validation.GlooValidationServiceRequest_ModifiedResources{
+			ModifiedResources: &validation.ModifiedResources{
+				UpstreamGroups: []*gloov1.UpstreamGroup{ug},
+			},
+		},
+	})
+	logger := contextutils.LoggerFrom(ctx)
+	if err != nil {
+		if v.ignoreProxyValidationFailure {
+			logger.Error(err)
+		} else {
+			return &Reports{}, err
+		}
+	}
+	logger.Debugf("Got response from GlooValidationService: %s", response.String())
+
+	return v.getReportsFromGlooValidationResponse(response)
+}
+
+func (v *validator) ValidateDeleteUpstreamGroup(ctx context.Context, upstreamGroupRef *core.ResourceRef, dryRun bool) error {
+	response, err := v.sendGlooValidationServiceRequest(ctx, &validation.GlooValidationServiceRequest{
+		// Sending a nil proxy causes the proxies in gloo's snapshot to be translated without the upstream group
+		Proxy: nil,
+		Resources: &validation.GlooValidationServiceRequest_DeletedResources{
+			DeletedResources: &validation.DeletedResources{
+				UpstreamGroupRefs: []*core.ResourceRef{upstreamGroupRef},
+			},
+		},
+	})
+	logger := contextutils.LoggerFrom(ctx)
+	if err != nil {
+		if v.ignoreProxyValidationFailure {
+			logger.Error(err)
+		} else {
+			return err
+		}
+	}
+	logger.Debugf("Got response from GlooValidationService: %s", response.String())
+
+	_, err = v.getReportsFromGlooValidationResponse(response)
+	return err
+}
+
 func (v *validator) ValidateDeleteSecret(ctx context.Context, secretRef *core.ResourceRef, dryRun bool) error {
 	response, err := v.sendGlooValidationServiceRequest(ctx, &validation.GlooValidationServiceRequest{
 		// Sending a nil proxy causes the remaining secrets to be translated with all proxies in gloo's snapshot
@@ -682,10 +867,11 @@ func (v *validator) ValidateDeleteSecret(ctx context.Context, secretRef *core.Re
 // Converts the GlooValidationServiceResponse into Reports.
 func (v *validator) getReportsFromGlooValidationResponse(validationResponse *validation.GlooValidationServiceResponse) (*Reports, error) {
 	var (
-		errs            error
-		upstreamReports UpstreamReports
-		proxyReports    ProxyReports
-		proxies         []*gloov1.Proxy
+		errs                 error
+		upstreamReports      UpstreamReports
+		upstreamGroupReports UpstreamGroupReports
+		proxyReports         ProxyReports
+		proxies              []*gloov1.Proxy
 	)
 	for _, report := range validationResponse.GetValidationReports() {
 		// Append upstream errors
@@ -701,6 +887,19 @@ func (v *validator) getReportsFromGlooValidationResponse(validationResponse *val
 			}
 		}
 
+		// Append upstream group errors
+		for _, ugRpt := range report.GetUpstreamGroupReports() {
+			upstreamGroupReports = append(upstreamGroupReports, ugRpt)
+			if err := resourceReportToMultiErr(ugRpt); err != nil {
+				errs = multierr.Append(errs, errors.Wrapf(err, "failed to validate Upstream Group with Gloo validation server"))
+			}
+			if warnings := ugRpt.GetWarnings(); !v.allowWarnings && len(warnings) > 0 {
+				for _, warning := range warnings {
+					errs = multierr.Append(errs, errors.New(warning))
+				}
+			}
+		}
+
 		// Append proxies and proxy reports
 		if report.GetProxy() != nil {
 			proxies = append(proxies, report.GetProxy())
@@ -718,9 +917,10 @@ func (v *validator) getReportsFromGlooValidationResponse(validationResponse *val
 		}
 	}
 	return &Reports{
-		ProxyReports:    &proxyReports,
-		UpstreamReports: &upstreamReports,
-		Proxies:         proxies,
+		ProxyReports:         &proxyReports,
+		UpstreamReports:      &upstreamReports,
+		UpstreamGroupReports: &upstreamGroupReports,
+		Proxies:              proxies,
 	}, errs
 }
 
@@ -780,6 +980,70 @@ func proxiesForRouteTable(gwList v1.GatewayList, vsList v1.VirtualServiceList, r
 	return proxiesToConsider
 }
 
+// gets the proxies of the virtual services that delegate their virtual host options to the given option
+func proxiesForVirtualHostOption(gwList v1.GatewayList, vsList v1.VirtualServiceList, vhoRef *core.ResourceRef) []string {
+	var affectedVirtualServices v1.VirtualServiceList
+	for _, vs := range vsList {
+		if refsContain(vs.GetVirtualHost().GetOptionsConfigRefs().GetDelegateOptions(), vhoRef) {
+			affectedVirtualServices = append(affectedVirtualServices, vs)
+		}
+	}
+	return proxiesForVirtualServices(gwList, affectedVirtualServices)
+}
+
+// gets the proxies of the virtual services with a route delegating its options to the given option,
+// either directly or via a route table they delegate to
+func proxiesForRouteOption(gwList v1.GatewayList, vsList v1.VirtualServiceList, rtList v1.RouteTableList, roRef *core.ResourceRef) []string {
+	var affectedVirtualServices v1.VirtualServiceList
+	for _, vs := range vsList {
+		if routesContainOptionRef(vs.GetVirtualHost().GetRoutes(), roRef) {
+			affectedVirtualServices = append(affectedVirtualServices, vs)
+		}
+	}
+	for _, rt := range rtList {
+		if routesContainOptionRef(rt.GetRoutes(), roRef) {
+			affectedVirtualServices = append(affectedVirtualServices, virtualServicesForRouteTable(rt, vsList, rtList)...)
+		}
+	}
+	return proxiesForVirtualServices(gwList, affectedVirtualServices)
+}
+
+func proxiesForVirtualServices(gwList v1.GatewayList, vsList v1.VirtualServiceList) []string {
+	affectedProxies := make(map[string]struct{})
+	for _, vs := range vsList {
+		for _, proxy := range proxiesForVirtualService(gwList, vs) {
+			affectedProxies[proxy] = struct{}{}
+		}
+	}
+
+	var proxiesToConsider []string
+	for proxy := range affectedProxies {
+		proxiesToConsider = append(proxiesToConsider, proxy)
+	}
+	sort.Strings(proxiesToConsider)
+
+	return proxiesToConsider
+}
+
+// Returns true if any of the given routes delegate their options to the given option
+func routesContainOptionRef(routes []*v1.Route, optionRef *core.ResourceRef) bool {
+	for _, r := range routes {
+		if refsContain(r.GetOptionsConfigRefs().GetDelegateOptions(), optionRef) {
+			return true
+		}
+	}
+	return false
+}
+
+func refsContain(refs []*core.ResourceRef, ref *core.ResourceRef) bool {
+	for _, candidate := range refs {
+		if candidate.Equal(ref) {
+			return true
+		}
+	}
+	return false
+}
+
 type routeTableSet map[string]*v1.RouteTable
 
 // gets all the virtual services that have the given route table as a descendent via delegation
@@ -242,6 +242,78 @@ var _ = Describe("Validator", func() {
 				Expect(err).To(HaveOccurred())
 			})
 		})
+
+		Context("upstream groups", func() {
+			var ug *gloov1.UpstreamGroup
+
+			BeforeEach(func() {
+				us := samples.SimpleUpstream()
+				snap := samples.SimpleGatewaySnapshot(us.Metadata.Ref(), ns)
+				vc.validate = acceptProxy
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+
+				ug = &gloov1.UpstreamGroup{
+					Metadata: &core.Metadata{Name: "ug", Namespace: ns},
+					Destinations: []*gloov1.WeightedDestination{{
+						Destination: &gloov1.Destination{
+							DestinationType: &gloov1.Destination_Upstream{Upstream: us.Metadata.Ref()},
+						},
+					}},
+				}
+			})
+
+			It("accepts an upstream group when validation succeeds", func() {
+				reports, err := v.ValidateUpstreamGroup(context.TODO(), ug, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*(reports.ProxyReports)).To(HaveLen(1))
+			})
+			It("rejects an upstream group when its validation fails", func() {
+				vc.validate = func(ctx context.Context, in *validation.GlooValidationServiceRequest, opts ...grpc.CallOption) (*validation.GlooValidationServiceResponse, error) {
+					Expect(in.GetModifiedResources().GetUpstreamGroups()).To(ConsistOf(ug))
+					return &validation.GlooValidationServiceResponse{
+						ValidationReports: []*validation.ValidationReport{{
+							UpstreamGroupReports: []*validation.ResourceReport{{
+								ResourceRef: ug.GetMetadata().Ref(),
+								Errors:      []string{"upstream not found"},
+							}},
+						}},
+					}, nil
+				}
+
+				reports, err := v.ValidateUpstreamGroup(context.TODO(), ug, false)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("failed to validate Upstream Group with Gloo validation server"))
+				Expect(*(reports.UpstreamGroupReports)).To(HaveLen(1))
+			})
+			It("rejects an upstream group when proxy validation fails", func() {
+				vc.validate = failProxy
+
+				_, err := v.ValidateUpstreamGroup(context.TODO(), ug, false)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("accepts an upstream group deletion when validation succeeds", func() {
+				err := v.ValidateDeleteUpstreamGroup(context.TODO(), ug.GetMetadata().Ref(), false)
+				Expect(err).NotTo(HaveOccurred())
+			})
+			It("rejects an upstream group deletion when validation fails", func() {
+				ref := ug.GetMetadata().Ref()
+				vc.validate = func(ctx context.Context, in *validation.GlooValidationServiceRequest, opts ...grpc.CallOption) (*validation.GlooValidationServiceResponse, error) {
+					Expect(in.GetDeletedResources().GetUpstreamGroupRefs()).To(ConsistOf(ref))
+					return failProxy(ctx, in, opts...)
+				}
+
+				err := v.ValidateDeleteUpstreamGroup(context.TODO(), ref, false)
+				Expect(err).To(HaveOccurred())
+			})
+			It("rejects an upstream group deletion when there is a validation warning and allowWarnings is false", func() {
+				vc.validate = warnProxy
+
+				err := v.ValidateDeleteUpstreamGroup(context.TODO(), ug.GetMetadata().Ref(), false)
+				Expect(err).To(HaveOccurred())
+			})
+		})
 	})
 	Context("validating a route table", func() {
 		Context("proxy validation accepted", func() {
@@ -820,6 +892,157 @@ var _ = Describe("Validator", func() {
 		})
 	})
 
+	Context("validating virtual host options", func() {
+		var (
+			snap *gatewayv1.ApiSnapshot
+			vho  *gatewayv1.VirtualHostOption
+		)
+
+		BeforeEach(func() {
+			us := samples.SimpleUpstream()
+			snap = samples.SimpleGatewaySnapshot(us.Metadata.Ref(), ns)
+			vho = &gatewayv1.VirtualHostOption{
+				Metadata: &core.Metadata{Name: "vho", Namespace: ns},
+				Options:  &gloov1.VirtualHostOptions{},
+			}
+			snap.VirtualHostOptions = gatewayv1.VirtualHostOptionList{vho}
+			snap.VirtualServices[0].GetVirtualHost().ExternalOptionsConfig = &gatewayv1.VirtualHost_OptionsConfigRefs{
+				OptionsConfigRefs: &gatewayv1.DelegateOptionsRefs{
+					DelegateOptions: []*core.ResourceRef{vho.GetMetadata().Ref()},
+				},
+			}
+		})
+
+		It("accepts the option when proxy validation succeeds", func() {
+			vc.validate = acceptProxy
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+
+			reports, err := v.ValidateVirtualHostOption(context.TODO(), vho, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*(reports.ProxyReports)).To(HaveLen(1))
+		})
+		It("rejects the option when proxy validation fails", func() {
+			vc.validate = acceptProxy
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+
+			vc.validate = failProxy
+			reports, err := v.ValidateVirtualHostOption(context.TODO(), vho, false)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to validate Proxy with Gloo validation server"))
+			Expect(*(reports.ProxyReports)).To(HaveLen(1))
+		})
+		It("accepts an option no virtual service references without validating any proxy", func() {
+			snap.VirtualServices[0].GetVirtualHost().ExternalOptionsConfig = nil
+			vc.validate = acceptProxy
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+
+			vc.validate = nil
+			reports, err := v.ValidateVirtualHostOption(context.TODO(), vho, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*(reports.ProxyReports)).To(BeEmpty())
+		})
+
+		It("rejects the deletion of an option a virtual service references", func() {
+			vc.validate = acceptProxy
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+
+			ref := vho.GetMetadata().Ref()
+			err = v.ValidateDeleteVirtualHostOption(context.TODO(), ref, false)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("could not render proxy"))
+
+			// ensure the option was kept in the validator internal snapshot
+			_, err = v.latestSnapshot.VirtualHostOptions.Find(ref.Strings())
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("deletes an option no virtual service references safely", func() {
+			snap.VirtualServices[0].GetVirtualHost().ExternalOptionsConfig = nil
+			vc.validate = acceptProxy
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+
+			ref := vho.GetMetadata().Ref()
+			err = v.ValidateDeleteVirtualHostOption(context.TODO(), ref, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			// ensure the option was removed from the validator internal snapshot
+			_, err = v.latestSnapshot.VirtualHostOptions.Find(ref.Strings())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("validating route options", func() {
+		var (
+			snap *gatewayv1.ApiSnapshot
+			ro   *gatewayv1.RouteOption
+		)
+
+		BeforeEach(func() {
+			us := samples.SimpleUpstream()
+			snap = samples.GatewaySnapshotWithDelegateChain(us.Metadata.Ref(), ns)
+			ro = &gatewayv1.RouteOption{
+				Metadata: &core.Metadata{Name: "ro", Namespace: ns},
+				Options:  &gloov1.RouteOptions{},
+			}
+			snap.RouteOptions = gatewayv1.RouteOptionList{ro}
+			// reference the option from the leaf of the delegation chain
+			leaf := snap.RouteTables[len(snap.RouteTables)-1]
+			leaf.GetRoutes()[0].ExternalOptionsConfig = &gatewayv1.Route_OptionsConfigRefs{
+				OptionsConfigRefs: &gatewayv1.DelegateOptionsRefs{
+					DelegateOptions: []*core.ResourceRef{ro.GetMetadata().Ref()},
+				},
+			}
+		})
+
+		It("accepts the option when proxy validation succeeds", func() {
+			vc.validate = acceptProxy
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+
+			reports, err := v.ValidateRouteOption(context.TODO(), ro, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*(reports.ProxyReports)).To(HaveLen(1))
+		})
+		It("rejects the option when proxy validation fails", func() {
+			vc.validate = acceptProxy
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+
+			vc.validate = failProxy
+			_, err = v.ValidateRouteOption(context.TODO(), ro, false)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to validate Proxy with Gloo validation server"))
+		})
+
+		It("rejects the deletion of an option a delegated route references", func() {
+			vc.validate = acceptProxy
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = v.ValidateDeleteRouteOption(context.TODO(), ro.GetMetadata().Ref(), false)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("could not render proxy"))
+		})
+		It("deletes an option no route references safely", func() {
+			snap.RouteTables[len(snap.RouteTables)-1].GetRoutes()[0].ExternalOptionsConfig = nil
+			vc.validate = acceptProxy
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+
+			ref := ro.GetMetadata().Ref()
+			err = v.ValidateDeleteRouteOption(context.TODO(), ref, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			// ensure the option was removed from the validator internal snapshot
+			_, err = v.latestSnapshot.RouteOptions.Find(ref.Strings())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Context("validating a gateway", func() {
 
 		Context("proxy validation returns error", func() {
@@ -0,0 +1,59 @@
+package v1alpha2
+
+// The condition types and reasons that the Gateway API defines for the status of its resources.
+
+const (
+	GatewayClassConditionAccepted = "Accepted"
+	GatewayClassReasonAccepted    = "Accepted"
+)
+
+const (
+	GatewayConditionScheduled = "Scheduled"
+	GatewayConditionReady     = "Ready"
+
+	GatewayReasonScheduled         = "Scheduled"
+	GatewayReasonReady             = "Ready"
+	GatewayReasonListenersNotValid = "ListenersNotValid"
+	GatewayReasonListenersNotReady = "ListenersNotReady"
+)
+
+const (
+	ListenerConditionConflicted   = "Conflicted"
+	ListenerConditionDetached     = "Detached"
+	ListenerConditionResolvedRefs = "ResolvedRefs"
+	ListenerConditionReady        = "Ready"
+
+	ListenerReasonNoConflicts      = "NoConflicts"
+	ListenerReasonHostnameConflict = "HostnameConflict"
+	ListenerReasonProtocolConflict = "ProtocolConflict"
+
+	ListenerReasonAttached            = "Attached"
+	ListenerReasonPortUnavailable     = "PortUnavailable"
+	ListenerReasonUnsupportedProtocol = "UnsupportedProtocol"
+	ListenerReasonUnsupportedValue    = "UnsupportedValue"
+
+	ListenerReasonResolvedRefs          = "ResolvedRefs"
+	ListenerReasonInvalidCertificateRef = "InvalidCertificateRef"
+	ListenerReasonInvalidRouteKinds     = "InvalidRouteKinds"
+	ListenerReasonRefNotPermitted       = "RefNotPermitted"
+
+	ListenerReasonReady   = "Ready"
+	ListenerReasonInvalid = "Invalid"
+	ListenerReasonPending = "Pending"
+)
+
+const (
+	RouteConditionAccepted     = "Accepted"
+	RouteConditionResolvedRefs = "ResolvedRefs"
+
+	RouteReasonAccepted                   = "Accepted"
+	RouteReasonNotAllowedByListeners      = "NotAllowedByListeners"
+	RouteReasonNoMatchingListenerHostname = "NoMatchingListenerHostname"
+	RouteReasonNoMatchingParent           = "NoMatchingParent"
+	RouteReasonUnsupportedValue           = "UnsupportedValue"
+
+	RouteReasonResolvedRefs    = "ResolvedRefs"
+	RouteReasonRefNotPermitted = "RefNotPermitted"
+	RouteReasonInvalidKind     = "InvalidKind"
+	RouteReasonBackendNotFound = "BackendNotFound"
+)
@@ -0,0 +1,37 @@
+package v1alpha2
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const GroupName = "gateway.networking.k8s.io"
+
+var (
+	GroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha2"}
+
+	GatewayClassesResource  = GroupVersion.WithResource("gatewayclasses")
+	GatewaysResource        = GroupVersion.WithResource("gateways")
+	HTTPRoutesResource      = GroupVersion.WithResource("httproutes")
+	TCPRoutesResource       = GroupVersion.WithResource("tcproutes")
+	ReferenceGrantsResource = GroupVersion.WithResource("referencegrants")
+)
+
+const (
+	GatewayClassKind   = "GatewayClass"
+	GatewayKind        = "Gateway"
+	HTTPRouteKind      = "HTTPRoute"
+	TCPRouteKind       = "TCPRoute"
+	ReferenceGrantKind = "ReferenceGrant"
+)
+
+// FromUnstructured decodes an object served by the Gateway API CRDs into one of the types of this package.
+func FromUnstructured(obj *unstructured.Unstructured, into interface{}) error {
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), into)
+}
+
+// StatusToUnstructured encodes the status of an object to set it on the unstructured object served by the CRDs.
+func StatusToUnstructured(status interface{}) (map[string]interface{}, error) {
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(status)
+}
@@ -0,0 +1,280 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1alpha2"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/controller"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	MissingResourceErr = func(gvr schema.GroupVersionResource) error {
+		return errors.Errorf("the cluster does not serve %s, install the Gateway API CRDs", gvr.String())
+	}
+)
+
+// the resources the cache cannot run without; the others are watched if the cluster serves them
+var requiredResources = []schema.GroupVersionResource{
+	v1alpha2.GatewayClassesResource,
+	v1alpha2.GatewaysResource,
+	v1alpha2.HTTPRoutesResource,
+}
+
+var namespacedResources = []schema.GroupVersionResource{
+	v1alpha2.GatewaysResource,
+	v1alpha2.HTTPRoutesResource,
+	v1alpha2.TCPRoutesResource,
+	v1alpha2.ReferenceGrantsResource,
+}
+
+type Cache interface {
+	// Snapshot lists the resources to translate
+	Snapshot() (*translator.Snapshot, error)
+	// Get returns the resource as served by the cluster, or nil if it does not exist
+	Get(gvr schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error)
+	Subscribe() <-chan struct{}
+	Unsubscribe(<-chan struct{})
+}
+
+type gatewayApiCache struct {
+	gatewayClasses cache.GenericLister
+	// the listers of the namespaced resources, for each of the watched namespaces
+	namespaced map[string]map[schema.GroupVersionResource]cache.GenericLister
+	services   map[string]corelisters.ServiceLister
+	namespaces corelisters.NamespaceLister
+
+	cacheUpdatedWatchers      []chan struct{}
+	cacheUpdatedWatchersMutex sync.Mutex
+}
+
+// ServedResources returns the Gateway API resources that the cluster serves, or an error if it does not serve the
+// resources the cache cannot run without.
+func ServedResources(discoveryClient discovery.DiscoveryInterface) (map[schema.GroupVersionResource]bool, error) {
+	served := map[schema.GroupVersionResource]bool{}
+	resourceList, err := discoveryClient.ServerResourcesForGroupVersion(v1alpha2.GroupVersion.String())
+	if err != nil && !kerrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "discovering the Gateway API resources")
+	}
+	if resourceList != nil {
+		for _, resource := range resourceList.APIResources {
+			served[v1alpha2.GroupVersion.WithResource(resource.Name)] = true
+		}
+	}
+	for _, gvr := range requiredResources {
+		if !served[gvr] {
+			return nil, MissingResourceErr(gvr)
+		}
+	}
+	return served, nil
+}
+
+// This context should live as long as the cache is desired. i.e. if the cache is shared
+// across clients, it should get a context that has a longer lifetime than the clients themselves
+func NewCache(
+	ctx context.Context,
+	kubeClient kubernetes.Interface,
+	dynamicClient dynamic.Interface,
+	watchNamespaces []string,
+	served map[schema.GroupVersionResource]bool,
+) (*gatewayApiCache, error) {
+	resyncDuration := 12 * time.Hour
+
+	c := &gatewayApiCache{
+		namespaced: map[string]map[schema.GroupVersionResource]cache.GenericLister{},
+		services:   map[string]corelisters.ServiceLister{},
+	}
+
+	var informers []cache.SharedIndexInformer
+
+	clusterInformers := dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, resyncDuration)
+	gatewayClasses := clusterInformers.ForResource(v1alpha2.GatewayClassesResource)
+	c.gatewayClasses = gatewayClasses.Lister()
+	informers = append(informers, gatewayClasses.Informer())
+
+	// the listeners of gateways select the namespaces of their routes by their labels
+	kubeInformers := kubeinformers.NewSharedInformerFactory(kubeClient, resyncDuration)
+	namespaces := kubeInformers.Core().V1().Namespaces()
+	c.namespaces = namespaces.Lister()
+	informers = append(informers, namespaces.Informer())
+
+	if len(watchNamespaces) == 0 {
+		watchNamespaces = []string{metav1.NamespaceAll}
+	}
+	for _, namespace := range watchNamespaces {
+		namespacedInformers := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, resyncDuration, namespace, nil)
+		c.namespaced[namespace] = map[schema.GroupVersionResource]cache.GenericLister{}
+		for _, gvr := range namespacedResources {
+			if !served[gvr] {
+				continue
+			}
+			informer := namespacedInformers.ForResource(gvr)
+			c.namespaced[namespace][gvr] = informer.Lister()
+			informers = append(informers, informer.Informer())
+		}
+
+		namespacedKubeInformers := kubeinformers.NewSharedInformerFactoryWithOptions(kubeClient, resyncDuration, kubeinformers.WithNamespace(namespace))
+		services := namespacedKubeInformers.Core().V1().Services()
+		c.services[namespace] = services.Lister()
+		informers = append(informers, services.Informer())
+	}
+
+	kubeController := controller.NewController("gateway-api-resources-cache",
+		controller.NewLockingSyncHandler(c.updatedOccurred),
+		informers...)
+
+	stop := ctx.Done()
+	err := kubeController.Run(2, stop)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *gatewayApiCache) Snapshot() (*translator.Snapshot, error) {
+	snap := &translator.Snapshot{}
+
+	gatewayClasses, err := c.gatewayClasses.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range gatewayClasses {
+		gatewayClass := &v1alpha2.GatewayClass{}
+		if err := v1alpha2.FromUnstructured(obj.(*unstructured.Unstructured), gatewayClass); err != nil {
+			return nil, err
+		}
+		snap.GatewayClasses = append(snap.GatewayClasses, gatewayClass)
+	}
+
+	for namespace, listers := range c.namespaced {
+		for gvr, lister := range listers {
+			objs, err := lister.List(labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			for _, obj := range objs {
+				if err := addToSnapshot(snap, gvr, obj.(*unstructured.Unstructured)); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		services, err := c.services[namespace].List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		snap.Services = append(snap.Services, services...)
+	}
+
+	namespaces, err := c.namespaces.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	snap.Namespaces = append([]*corev1.Namespace{}, namespaces...)
+
+	return snap, nil
+}
+
+func addToSnapshot(snap *translator.Snapshot, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) error {
+	switch gvr {
+	case v1alpha2.GatewaysResource:
+		gateway := &v1alpha2.Gateway{}
+		if err := v1alpha2.FromUnstructured(obj, gateway); err != nil {
+			return err
+		}
+		snap.Gateways = append(snap.Gateways, gateway)
+	case v1alpha2.HTTPRoutesResource:
+		route := &v1alpha2.HTTPRoute{}
+		if err := v1alpha2.FromUnstructured(obj, route); err != nil {
+			return err
+		}
+		snap.HTTPRoutes = append(snap.HTTPRoutes, route)
+	case v1alpha2.TCPRoutesResource:
+		route := &v1alpha2.TCPRoute{}
+		if err := v1alpha2.FromUnstructured(obj, route); err != nil {
+			return err
+		}
+		snap.TCPRoutes = append(snap.TCPRoutes, route)
+	case v1alpha2.ReferenceGrantsResource:
+		grant := &v1alpha2.ReferenceGrant{}
+		if err := v1alpha2.FromUnstructured(obj, grant); err != nil {
+			return err
+		}
+		snap.ReferenceGrants = append(snap.ReferenceGrants, grant)
+	}
+	return nil
+}
+
+func (c *gatewayApiCache) Get(gvr schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	var (
+		obj interface{}
+		err error
+	)
+	if gvr == v1alpha2.GatewayClassesResource {
+		obj, err = c.gatewayClasses.Get(name)
+	} else {
+		listers, ok := c.namespaced[namespace]
+		if !ok {
+			listers = c.namespaced[metav1.NamespaceAll]
+		}
+		lister, ok := listers[gvr]
+		if !ok {
+			return nil, nil
+		}
+		obj, err = lister.ByNamespace(namespace).Get(name)
+	}
+	if kerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*unstructured.Unstructured), nil
+}
+
+func (c *gatewayApiCache) Subscribe() <-chan struct{} {
+	c.cacheUpdatedWatchersMutex.Lock()
+	defer c.cacheUpdatedWatchersMutex.Unlock()
+	ch := make(chan struct{}, 10)
+	c.cacheUpdatedWatchers = append(c.cacheUpdatedWatchers, ch)
+	return ch
+}
+
+func (c *gatewayApiCache) Unsubscribe(ch <-chan struct{}) {
+	c.cacheUpdatedWatchersMutex.Lock()
+	defer c.cacheUpdatedWatchersMutex.Unlock()
+	for i, cacheUpdated := range c.cacheUpdatedWatchers {
+		if cacheUpdated == ch {
+			c.cacheUpdatedWatchers = append(c.cacheUpdatedWatchers[:i], c.cacheUpdatedWatchers[i+1:]...)
+			return
+		}
+	}
+}
+
+func (c *gatewayApiCache) updatedOccurred() {
+	c.cacheUpdatedWatchersMutex.Lock()
+	defer c.cacheUpdatedWatchersMutex.Unlock()
+	for _, cacheUpdated := range c.cacheUpdatedWatchers {
+		select {
+		case cacheUpdated <- struct{}{}:
+		default:
+		}
+	}
+}
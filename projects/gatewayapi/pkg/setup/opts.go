@@ -0,0 +1,15 @@
+package setup
+
+import (
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+)
+
+type Opts struct {
+	ControllerName          string
+	WriteNamespace          string
+	StatusReporterNamespace string
+	WatchNamespaces         []string
+	Proxies                 factory.ResourceClientFactory
+	WatchOpts               clients.WatchOpts
+}
@@ -0,0 +1,143 @@
+package setup
+
+import (
+	"context"
+	"os"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/solo-io/gloo/pkg/utils"
+	"github.com/solo-io/gloo/pkg/utils/statusutils"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/cache"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/syncer"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/translator"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+	gloodefaults "github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/errutils"
+	"github.com/solo-io/k8s-utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory.InMemoryResourceCache, settings *gloov1.Settings) error {
+	var cfg *rest.Config
+
+	params := bootstrap.NewConfigFactoryParams(
+		settings,
+		inMemoryCache,
+		kubeCache,
+		&cfg,
+		nil, // no consul client for the gateway api controller
+	)
+
+	proxyFactory, err := bootstrap.ConfigFactoryForSettings(params, gloov1.ProxyCrd)
+	if err != nil {
+		return err
+	}
+
+	refreshRate, err := ptypes.Duration(settings.GetRefreshRate())
+	if err != nil {
+		return err
+	}
+
+	writeNamespace := settings.GetDiscoveryNamespace()
+	if writeNamespace == "" {
+		writeNamespace = gloodefaults.GlooSystem
+	}
+	statusReporterNamespace := statusutils.GetStatusReporterNamespaceOrDefault(writeNamespace)
+
+	watchNamespaces := utils.ProcessWatchNamespaces(settings.GetWatchNamespaces(), writeNamespace)
+
+	controllerName := os.Getenv("GATEWAY_CONTROLLER_NAME")
+	if controllerName == "" {
+		controllerName = translator.DefaultControllerName
+	}
+
+	opts := Opts{
+		ControllerName:          controllerName,
+		WriteNamespace:          writeNamespace,
+		StatusReporterNamespace: statusReporterNamespace,
+		WatchNamespaces:         watchNamespaces,
+		Proxies:                 proxyFactory,
+		WatchOpts: clients.WatchOpts{
+			Ctx:         ctx,
+			RefreshRate: refreshRate,
+		},
+	}
+
+	return RunGatewayApi(opts)
+}
+
+func RunGatewayApi(opts Opts) error {
+	opts.WatchOpts = opts.WatchOpts.WithDefaults()
+	opts.WatchOpts.Ctx = contextutils.WithLogger(opts.WatchOpts.Ctx, "gatewayapi")
+	ctx := opts.WatchOpts.Ctx
+
+	cfg, err := kubeutils.GetConfig("", "")
+	if err != nil {
+		return errors.Wrapf(err, "getting kube config")
+	}
+	kubeClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return errors.Wrapf(err, "getting kube client")
+	}
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return errors.Wrapf(err, "getting dynamic client")
+	}
+
+	served, err := cache.ServedResources(kubeClient.Discovery())
+	if err != nil {
+		return err
+	}
+	gatewayApiCache, err := cache.NewCache(ctx, kubeClient, dynamicClient, opts.WatchNamespaces, served)
+	if err != nil {
+		return errors.Wrapf(err, "creating gateway api cache")
+	}
+
+	proxyClient, err := gloov1.NewProxyClient(ctx, opts.Proxies)
+	if err != nil {
+		return err
+	}
+	if err := proxyClient.Register(); err != nil {
+		return err
+	}
+
+	statusClient := statusutils.GetStatusClientForNamespace(opts.StatusReporterNamespace)
+	translatorSync := syncer.NewTranslatorSyncer(
+		opts.ControllerName,
+		opts.WriteNamespace,
+		gatewayApiCache,
+		dynamicClient,
+		proxyClient,
+		statusClient,
+	)
+	translatorSyncErrs, err := translatorSync.Run(ctx)
+	if err != nil {
+		return err
+	}
+
+	writeErrs := make(chan error)
+	go errutils.AggregateErrs(ctx, writeErrs, translatorSyncErrs, "gatewayapi_translator_syncer")
+
+	logger := contextutils.LoggerFrom(ctx)
+	logger.Infof("translating the gateways of the classes of controller %s", opts.ControllerName)
+	go func() {
+		for {
+			select {
+			case err := <-writeErrs:
+				logger.Errorf("error: %v", err)
+			case <-ctx.Done():
+				close(writeErrs)
+				return
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,159 @@
+package syncer
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1alpha2"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// writeStatuses merges the reported status into the status of the resources, updating the resources whose status changes.
+// The status that other controllers report on routes is preserved.
+func (s *translatorSyncer) writeStatuses(ctx context.Context, snap *translator.Snapshot, reports *translator.Reports) error {
+	var errs *multierror.Error
+
+	for key, reported := range reports.GatewayClasses {
+		reported := reported
+		errs = multierror.Append(errs, s.updateStatus(ctx, v1alpha2.GatewayClassesResource, key, func(obj *unstructured.Unstructured) (interface{}, interface{}, error) {
+			gatewayClass := &v1alpha2.GatewayClass{}
+			if err := v1alpha2.FromUnstructured(obj, gatewayClass); err != nil {
+				return nil, nil, err
+			}
+			status := v1alpha2.GatewayClassStatus{
+				Conditions: mergeConditions(gatewayClass.Status.Conditions, reported.Conditions),
+			}
+			return gatewayClass.Status, status, nil
+		}))
+	}
+
+	for key, reported := range reports.Gateways {
+		reported := reported
+		errs = multierror.Append(errs, s.updateStatus(ctx, v1alpha2.GatewaysResource, key, func(obj *unstructured.Unstructured) (interface{}, interface{}, error) {
+			gateway := &v1alpha2.Gateway{}
+			if err := v1alpha2.FromUnstructured(obj, gateway); err != nil {
+				return nil, nil, err
+			}
+			return gateway.Status, mergeGatewayStatus(gateway.Status, *reported), nil
+		}))
+	}
+
+	for _, route := range snap.HTTPRoutes {
+		key := types.NamespacedName{Namespace: route.GetNamespace(), Name: route.GetName()}
+		reported := reports.HTTPRoutes[key]
+		errs = multierror.Append(errs, s.updateStatus(ctx, v1alpha2.HTTPRoutesResource, key, func(obj *unstructured.Unstructured) (interface{}, interface{}, error) {
+			current := &v1alpha2.HTTPRoute{}
+			if err := v1alpha2.FromUnstructured(obj, current); err != nil {
+				return nil, nil, err
+			}
+			return current.Status, s.mergeRouteStatus(current.Status, reported), nil
+		}))
+	}
+
+	for _, route := range snap.TCPRoutes {
+		key := types.NamespacedName{Namespace: route.GetNamespace(), Name: route.GetName()}
+		reported := reports.TCPRoutes[key]
+		errs = multierror.Append(errs, s.updateStatus(ctx, v1alpha2.TCPRoutesResource, key, func(obj *unstructured.Unstructured) (interface{}, interface{}, error) {
+			current := &v1alpha2.TCPRoute{}
+			if err := v1alpha2.FromUnstructured(obj, current); err != nil {
+				return nil, nil, err
+			}
+			return current.Status, s.mergeRouteStatus(current.Status, reported), nil
+		}))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// updateStatus updates the status of the resource to the one that merge returns along with the current status,
+// unless they are equal.
+func (s *translatorSyncer) updateStatus(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	key types.NamespacedName,
+	merge func(obj *unstructured.Unstructured) (current interface{}, desired interface{}, err error),
+) error {
+	obj, err := s.cache.Get(gvr, key.Namespace, key.Name)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		// the resource was deleted since the snapshot was taken
+		return nil
+	}
+	current, desired, err := merge(obj)
+	if err != nil {
+		return errors.Wrapf(err, "reading %s %s", gvr.Resource, key.String())
+	}
+	if reflect.DeepEqual(current, desired) {
+		return nil
+	}
+	status, err := v1alpha2.StatusToUnstructured(desired)
+	if err != nil {
+		return err
+	}
+	obj = obj.DeepCopy()
+	obj.Object["status"] = status
+	if _, err := s.dynamicClient.Resource(gvr).Namespace(key.Namespace).UpdateStatus(ctx, obj, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "updating the status of %s %s", gvr.Resource, key.String())
+	}
+	return nil
+}
+
+// mergeConditions returns the reported conditions, keeping the transition time of the current ones whose status
+// did not change.
+func mergeConditions(current, reported []metav1.Condition) []metav1.Condition {
+	var merged []metav1.Condition
+	for _, condition := range reported {
+		if existing := meta.FindStatusCondition(current, condition.Type); existing != nil {
+			merged = append(merged, *existing)
+		}
+		meta.SetStatusCondition(&merged, condition)
+	}
+	return merged
+}
+
+func mergeGatewayStatus(current, reported v1alpha2.GatewayStatus) v1alpha2.GatewayStatus {
+	merged := v1alpha2.GatewayStatus{
+		Addresses:  current.Addresses,
+		Conditions: mergeConditions(current.Conditions, reported.Conditions),
+	}
+	for _, listener := range reported.Listeners {
+		var currentConditions []metav1.Condition
+		for _, currentListener := range current.Listeners {
+			if currentListener.Name == listener.Name {
+				currentConditions = currentListener.Conditions
+			}
+		}
+		listener.Conditions = mergeConditions(currentConditions, listener.Conditions)
+		merged.Listeners = append(merged.Listeners, listener)
+	}
+	return merged
+}
+
+func (s *translatorSyncer) mergeRouteStatus(current v1alpha2.RouteStatus, reported []v1alpha2.RouteParentStatus) v1alpha2.RouteStatus {
+	var merged v1alpha2.RouteStatus
+	for _, parent := range current.Parents {
+		if parent.ControllerName != s.controllerName {
+			merged.Parents = append(merged.Parents, parent)
+		}
+	}
+	for _, parent := range reported {
+		var currentConditions []metav1.Condition
+		for _, currentParent := range current.Parents {
+			if currentParent.ControllerName == s.controllerName && reflect.DeepEqual(currentParent.ParentRef, parent.ParentRef) {
+				currentConditions = currentParent.Conditions
+			}
+		}
+		parent.Conditions = mergeConditions(currentConditions, parent.Conditions)
+		merged.Parents = append(merged.Parents, parent)
+	}
+	return merged
+}
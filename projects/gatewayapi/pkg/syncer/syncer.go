@@ -0,0 +1,133 @@
+package syncer
+
+import (
+	"context"
+
+	"github.com/solo-io/gloo/projects/gateway/pkg/reconciler"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/cache"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/translator"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"k8s.io/client-go/dynamic"
+)
+
+type TranslatorSyncer interface {
+	// Sync translates the resources of the cache, writing the proxies and the status of the resources
+	Sync(ctx context.Context, proxies gloov1.ProxyList) error
+	// Run syncs whenever the resources of the cache or the proxies change, until the context is done
+	Run(ctx context.Context) (<-chan error, error)
+}
+
+type translatorSyncer struct {
+	controllerName  string
+	writeNamespace  string
+	cache           cache.Cache
+	dynamicClient   dynamic.Interface
+	proxyClient     gloov1.ProxyClient
+	proxyReconciler reconciler.ProxyReconciler
+	translator      *translator.Translator
+}
+
+func NewTranslatorSyncer(
+	controllerName string,
+	writeNamespace string,
+	cache cache.Cache,
+	dynamicClient dynamic.Interface,
+	proxyClient gloov1.ProxyClient,
+	statusClient resources.StatusClient,
+) TranslatorSyncer {
+	return &translatorSyncer{
+		controllerName: controllerName,
+		writeNamespace: writeNamespace,
+		cache:          cache,
+		dynamicClient:  dynamicClient,
+		proxyClient:    proxyClient,
+		// the proxies are validated by gloo once written, which reports the result on the gateways
+		proxyReconciler: reconciler.NewProxyReconciler(nil, proxyClient, statusClient),
+		translator:      translator.NewTranslator(controllerName, writeNamespace, statusClient),
+	}
+}
+
+func (s *translatorSyncer) Sync(ctx context.Context, proxies gloov1.ProxyList) error {
+	ctx = contextutils.WithLogger(ctx, "translatorSyncer")
+	logger := contextutils.LoggerFrom(ctx)
+
+	snap, err := s.cache.Snapshot()
+	if err != nil {
+		return errors.Wrapf(err, "listing the gateway api resources")
+	}
+	snap.Proxies = proxies
+	logger.Debugf("begin sync (%v gateways, %v http routes, %v tcp routes)",
+		len(snap.Gateways), len(snap.HTTPRoutes), len(snap.TCPRoutes))
+	defer logger.Debugf("end sync")
+
+	desiredProxies, reports := s.translator.Translate(ctx, snap)
+
+	generated := reconciler.GeneratedProxies{}
+	for _, proxy := range desiredProxies {
+		generated[proxy] = reporter.ResourceReports{}
+	}
+	if err := s.proxyReconciler.ReconcileProxies(ctx, generated, s.writeNamespace, translator.ProxyLabels); err != nil {
+		return errors.Wrapf(err, "writing proxies")
+	}
+
+	return s.writeStatuses(ctx, snap, reports)
+}
+
+func (s *translatorSyncer) Run(ctx context.Context) (<-chan error, error) {
+	proxies, proxyErrs, err := s.proxyClient.Watch(s.writeNamespace, clients.WatchOpts{
+		Ctx:      ctx,
+		Selector: translator.ProxyLabels,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "watching proxies")
+	}
+
+	errs := make(chan error)
+	sendErr := func(err error) {
+		select {
+		case errs <- err:
+		case <-ctx.Done():
+		}
+	}
+
+	updates := s.cache.Subscribe()
+	go func() {
+		defer s.cache.Unsubscribe(updates)
+		var latestProxies gloov1.ProxyList
+		// sync once the proxies are listed, so that the status of the gateways reflects them from the first sync
+		proxiesListed := false
+		sync := func() {
+			if !proxiesListed {
+				return
+			}
+			if err := s.Sync(ctx, latestProxies); err != nil {
+				sendErr(err)
+			}
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err, ok := <-proxyErrs:
+				if !ok {
+					return
+				}
+				sendErr(err)
+			case list, ok := <-proxies:
+				if !ok {
+					return
+				}
+				latestProxies, proxiesListed = list, true
+				sync()
+			case <-updates:
+				sync()
+			}
+		}
+	}()
+	return errs, nil
+}
@@ -0,0 +1,127 @@
+package translator
+
+import (
+	"fmt"
+
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1alpha2"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	coreGroup   = ""
+	serviceKind = "Service"
+	secretKind  = "Secret"
+)
+
+// referenceGranted returns true if a ReferenceGrant in the namespace of the target allows the reference.
+func (t *translation) referenceGranted(fromKind, fromNamespace, toGroup, toKind, toNamespace, toName string) bool {
+	for _, grant := range t.snap.ReferenceGrants {
+		if grant.GetNamespace() != toNamespace {
+			continue
+		}
+		var fromAllowed, toAllowed bool
+		for _, from := range grant.Spec.From {
+			if from.Group == v1alpha2.GroupName && from.Kind == fromKind && from.Namespace == fromNamespace {
+				fromAllowed = true
+			}
+		}
+		for _, to := range grant.Spec.To {
+			if to.Group == toGroup && to.Kind == toKind && (to.Name == nil || *to.Name == toName) {
+				toAllowed = true
+			}
+		}
+		if fromAllowed && toAllowed {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveBackendRef returns the destination of a backend of a route, or records the reason it cannot be resolved.
+func (t *translation) resolveBackendRef(fromKind, fromNamespace string, ref v1alpha2.BackendObjectReference, errs *refErrors) *gloov1.Destination {
+	group, kind := stringOr(ref.Group, coreGroup), stringOr(ref.Kind, serviceKind)
+	if group != coreGroup || kind != serviceKind {
+		errs.add(v1alpha2.RouteReasonInvalidKind, fmt.Sprintf("backend %s of kind %s.%s is not supported", ref.Name, kind, group))
+		return nil
+	}
+	namespace := stringOr(ref.Namespace, fromNamespace)
+	if namespace != fromNamespace && !t.referenceGranted(fromKind, fromNamespace, group, kind, namespace, ref.Name) {
+		errs.add(v1alpha2.RouteReasonRefNotPermitted, fmt.Sprintf("no ReferenceGrant allows the reference to service %s.%s", ref.Name, namespace))
+		return nil
+	}
+	if ref.Port == nil {
+		errs.add(v1alpha2.RouteReasonBackendNotFound, fmt.Sprintf("no port set for service %s.%s", ref.Name, namespace))
+		return nil
+	}
+	service, ok := t.services[namespacedName(namespace, ref.Name)]
+	if !ok {
+		errs.add(v1alpha2.RouteReasonBackendNotFound, fmt.Sprintf("service %s.%s not found", ref.Name, namespace))
+		return nil
+	}
+	var portFound bool
+	for _, port := range service.Spec.Ports {
+		portFound = portFound || port.Port == *ref.Port
+	}
+	if !portFound {
+		errs.add(v1alpha2.RouteReasonBackendNotFound, fmt.Sprintf("service %s.%s has no port %d", ref.Name, namespace, *ref.Port))
+		return nil
+	}
+	return &gloov1.Destination{
+		DestinationType: &gloov1.Destination_Kube{
+			Kube: &gloov1.KubernetesServiceDestination{
+				Ref:  &core.ResourceRef{Name: ref.Name, Namespace: namespace},
+				Port: uint32(*ref.Port),
+			},
+		},
+	}
+}
+
+// resolveBackendRefs returns the action that splits the traffic between the backends that can be resolved,
+// or nil if none of them can.
+func (t *translation) resolveBackendRefs(fromKind, fromNamespace string, refs []v1alpha2.BackendRef, errs *refErrors) *gloov1.MultiDestination {
+	var destinations []*gloov1.WeightedDestination
+	for _, ref := range refs {
+		destination := t.resolveBackendRef(fromKind, fromNamespace, ref.BackendObjectReference, errs)
+		if destination == nil {
+			continue
+		}
+		weight := int32(1)
+		if ref.Weight != nil {
+			weight = *ref.Weight
+		}
+		if weight == 0 {
+			continue
+		}
+		destinations = append(destinations, &gloov1.WeightedDestination{
+			Destination: destination,
+			Weight:      uint32(weight),
+		})
+	}
+	if len(destinations) == 0 {
+		return nil
+	}
+	return &gloov1.MultiDestination{Destinations: destinations}
+}
+
+// resolveCertificateRef returns the secret of a listener certificate, or records the reason it cannot be resolved.
+func (t *translation) resolveCertificateRef(gateway *v1alpha2.Gateway, ref *v1alpha2.SecretObjectReference, errs *refErrors) *core.ResourceRef {
+	group, kind := stringOr(ref.Group, coreGroup), stringOr(ref.Kind, secretKind)
+	if group != coreGroup || kind != secretKind {
+		errs.add(v1alpha2.ListenerReasonInvalidCertificateRef, fmt.Sprintf("certificate %s of kind %s.%s is not supported", ref.Name, kind, group))
+		return nil
+	}
+	namespace := stringOr(ref.Namespace, gateway.GetNamespace())
+	if namespace != gateway.GetNamespace() && !t.referenceGranted(v1alpha2.GatewayKind, gateway.GetNamespace(), group, kind, namespace, ref.Name) {
+		errs.add(v1alpha2.ListenerReasonRefNotPermitted, fmt.Sprintf("no ReferenceGrant allows the reference to secret %s.%s", ref.Name, namespace))
+		return nil
+	}
+	return &core.ResourceRef{Name: ref.Name, Namespace: namespace}
+}
+
+func stringOr(value *string, defaultValue string) string {
+	if value == nil {
+		return defaultValue
+	}
+	return *value
+}
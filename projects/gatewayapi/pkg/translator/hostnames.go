@@ -0,0 +1,65 @@
+package translator
+
+import (
+	"strings"
+)
+
+const anyHost = "*"
+
+// hostnameMatches returns true if every host that the pattern matches is matched by the wildcard or exact hostname.
+// As in the Gateway API, `*.example.com` matches the subdomains of example.com at any depth, but not example.com itself.
+func hostnameMatches(hostname, pattern string) bool {
+	if hostname == "" || hostname == anyHost || hostname == pattern {
+		return true
+	}
+	if !strings.HasPrefix(hostname, "*.") {
+		return false
+	}
+	return strings.HasSuffix(strings.TrimPrefix(pattern, "*"), hostname[1:])
+}
+
+// intersectHostnames returns the hostnames of the route that the listener accepts, narrowed to the hostname of the
+// listener where it is more specific. No hostnames stand for every host; false means the listener accepts none of them.
+func intersectHostnames(listenerHostname *string, routeHostnames []string) ([]string, bool) {
+	if listenerHostname == nil || *listenerHostname == "" {
+		return routeHostnames, true
+	}
+	if len(routeHostnames) == 0 {
+		return []string{*listenerHostname}, true
+	}
+	var hostnames []string
+	for _, hostname := range routeHostnames {
+		switch {
+		case hostnameMatches(*listenerHostname, hostname):
+			hostnames = append(hostnames, hostname)
+		case hostnameMatches(hostname, *listenerHostname):
+			hostnames = append(hostnames, *listenerHostname)
+		}
+	}
+	return dedupe(hostnames), len(hostnames) > 0
+}
+
+// hostnameSpecificity orders the listener hostnames that match a host: exact hostnames beat wildcards,
+// and longer wildcards beat shorter ones.
+func hostnameSpecificity(hostname *string) int {
+	switch {
+	case hostname == nil || *hostname == "":
+		return 0
+	case strings.HasPrefix(*hostname, "*"):
+		return 1 + len(*hostname)
+	default:
+		return 1 << 16
+	}
+}
+
+func dedupe(values []string) []string {
+	seen := map[string]bool{}
+	var deduped []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			deduped = append(deduped, value)
+		}
+	}
+	return deduped
+}
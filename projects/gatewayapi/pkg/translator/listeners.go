@@ -0,0 +1,216 @@
+package translator
+
+import (
+	"fmt"
+
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1alpha2"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// the route kinds that each protocol supports
+var supportedKinds = map[v1alpha2.ProtocolType][]string{
+	v1alpha2.HTTPProtocolType:  {v1alpha2.HTTPRouteKind},
+	v1alpha2.HTTPSProtocolType: {v1alpha2.HTTPRouteKind},
+	v1alpha2.TCPProtocolType:   {v1alpha2.TCPRouteKind},
+}
+
+type listenerTranslation struct {
+	gateway  *v1alpha2.Gateway
+	listener *v1alpha2.Listener
+	status   *v1alpha2.ListenerStatus
+
+	// valid listeners accept routes and are translated to the proxy
+	valid bool
+	kinds []string
+	// the certificate of https listeners
+	secretRef *core.ResourceRef
+
+	httpRoutes []*attachedHTTPRoute
+	tcpRoute   *tcpRouteTranslation
+}
+
+type attachedHTTPRoute struct {
+	route     *httpRouteTranslation
+	hostnames []string
+}
+
+// translateListeners validates the listeners of the gateway, reporting their status.
+func (t *translation) translateListeners(gateway *v1alpha2.Gateway, status *v1alpha2.GatewayStatus) []*listenerTranslation {
+	generation := gateway.GetGeneration()
+	status.Listeners = make([]v1alpha2.ListenerStatus, len(gateway.Spec.Listeners))
+
+	var listeners []*listenerTranslation
+	portProtocols := map[int32]v1alpha2.ProtocolType{}
+	portHostnames := map[int32]map[string]bool{}
+	for i := range gateway.Spec.Listeners {
+		listener := &gateway.Spec.Listeners[i]
+		listenerStatus := &status.Listeners[i]
+		listenerStatus.Name = listener.Name
+		lt := &listenerTranslation{
+			gateway:  gateway,
+			listener: listener,
+			status:   listenerStatus,
+		}
+		listeners = append(listeners, lt)
+
+		detached := newCondition(v1alpha2.ListenerConditionDetached, false, v1alpha2.ListenerReasonAttached, "", generation)
+		conflicted := newCondition(v1alpha2.ListenerConditionConflicted, false, v1alpha2.ListenerReasonNoConflicts, "", generation)
+		refErrs := &refErrors{}
+
+		if _, ok := supportedKinds[listener.Protocol]; !ok {
+			detached = newCondition(v1alpha2.ListenerConditionDetached, true, v1alpha2.ListenerReasonUnsupportedProtocol,
+				fmt.Sprintf("protocol %s is not supported", listener.Protocol), generation)
+		}
+
+		if protocol, ok := portProtocols[listener.Port]; !ok {
+			portProtocols[listener.Port] = listener.Protocol
+			portHostnames[listener.Port] = map[string]bool{}
+		} else if protocol != listener.Protocol {
+			conflicted = newCondition(v1alpha2.ListenerConditionConflicted, true, v1alpha2.ListenerReasonProtocolConflict,
+				fmt.Sprintf("port %d is used with protocol %s", listener.Port, protocol), generation)
+		}
+		hostname := stringOr(listener.Hostname, "")
+		if conflicted.Status == metav1.ConditionFalse {
+			if portHostnames[listener.Port][hostname] {
+				conflicted = newCondition(v1alpha2.ListenerConditionConflicted, true, v1alpha2.ListenerReasonHostnameConflict,
+					fmt.Sprintf("port %d is used with hostname %q", listener.Port, hostname), generation)
+			}
+			portHostnames[listener.Port][hostname] = true
+		}
+
+		if listener.Protocol == v1alpha2.HTTPSProtocolType && detached.Status == metav1.ConditionFalse {
+			switch {
+			case listener.TLS != nil && listener.TLS.Mode != nil && *listener.TLS.Mode != v1alpha2.TLSModeTerminate:
+				detached = newCondition(v1alpha2.ListenerConditionDetached, true, v1alpha2.ListenerReasonUnsupportedValue,
+					fmt.Sprintf("tls mode %s is not supported", *listener.TLS.Mode), generation)
+			case listener.TLS == nil || len(listener.TLS.CertificateRefs) == 0:
+				refErrs.add(v1alpha2.ListenerReasonInvalidCertificateRef, "no certificate set for the https listener")
+			default:
+				// gloo serves a single certificate for each listener
+				lt.secretRef = t.resolveCertificateRef(gateway, listener.TLS.CertificateRefs[0], refErrs)
+			}
+		}
+
+		lt.kinds, listenerStatus.SupportedKinds = t.listenerKinds(listener, refErrs)
+
+		lt.valid = detached.Status == metav1.ConditionFalse &&
+			conflicted.Status == metav1.ConditionFalse &&
+			(listener.Protocol != v1alpha2.HTTPSProtocolType || lt.secretRef != nil) &&
+			len(lt.kinds) > 0
+
+		listenerStatus.Conditions = []metav1.Condition{
+			detached,
+			conflicted,
+			refErrs.condition(v1alpha2.ListenerConditionResolvedRefs, v1alpha2.ListenerReasonResolvedRefs, generation),
+		}
+	}
+	return listeners
+}
+
+// listenerKinds returns the route kinds that the listener allows, recording the kinds it cannot support.
+func (t *translation) listenerKinds(listener *v1alpha2.Listener, errs *refErrors) ([]string, []v1alpha2.RouteGroupKind) {
+	protocolKinds := supportedKinds[listener.Protocol]
+	if listener.AllowedRoutes == nil || len(listener.AllowedRoutes.Kinds) == 0 {
+		groupKinds := []v1alpha2.RouteGroupKind{}
+		for _, kind := range protocolKinds {
+			groupKinds = append(groupKinds, routeGroupKind(kind))
+		}
+		return protocolKinds, groupKinds
+	}
+
+	var kinds []string
+	// the status always lists the supported kinds, even when there are none
+	groupKinds := []v1alpha2.RouteGroupKind{}
+	for _, groupKind := range listener.AllowedRoutes.Kinds {
+		group := stringOr(groupKind.Group, v1alpha2.GroupName)
+		if group == v1alpha2.GroupName && containsString(protocolKinds, groupKind.Kind) {
+			kinds = append(kinds, groupKind.Kind)
+			groupKinds = append(groupKinds, routeGroupKind(groupKind.Kind))
+			continue
+		}
+		errs.add(v1alpha2.ListenerReasonInvalidRouteKinds,
+			fmt.Sprintf("route kind %s.%s is not supported for protocol %s", groupKind.Kind, group, listener.Protocol))
+	}
+	return kinds, groupKinds
+}
+
+// allowsRoute returns true if the listener allows routes of the kind from the namespace.
+func (t *translation) allowsRoute(lt *listenerTranslation, kind, namespace string) bool {
+	if !containsString(lt.kinds, kind) {
+		return false
+	}
+	from := v1alpha2.NamespacesFromSame
+	var selector *metav1.LabelSelector
+	if allowed := lt.listener.AllowedRoutes; allowed != nil && allowed.Namespaces != nil {
+		if allowed.Namespaces.From != nil {
+			from = *allowed.Namespaces.From
+		}
+		selector = allowed.Namespaces.Selector
+	}
+	switch from {
+	case v1alpha2.NamespacesFromAll:
+		return true
+	case v1alpha2.NamespacesFromSelector:
+		if selector == nil {
+			return false
+		}
+		namespaceSelector, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return false
+		}
+		ns, ok := t.namespaces[namespace]
+		return ok && namespaceSelector.Matches(labels.Set(ns.GetLabels()))
+	default:
+		return namespace == lt.gateway.GetNamespace()
+	}
+}
+
+// reportListenersReady reports the listeners, and the gateway, ready once gloo accepts the proxy.
+func reportListenersReady(gateway *v1alpha2.Gateway, listeners []*listenerTranslation, status *v1alpha2.GatewayStatus, proxyState core.Status_State, proxyReason string) {
+	generation := gateway.GetGeneration()
+	allValid := true
+	for _, lt := range listeners {
+		var ready metav1.Condition
+		switch {
+		case !lt.valid:
+			allValid = false
+			ready = newCondition(v1alpha2.ListenerConditionReady, false, v1alpha2.ListenerReasonInvalid, "the listener is invalid", generation)
+		case proxyState == core.Status_Accepted:
+			ready = newCondition(v1alpha2.ListenerConditionReady, true, v1alpha2.ListenerReasonReady, "", generation)
+		case proxyState == core.Status_Rejected:
+			ready = newCondition(v1alpha2.ListenerConditionReady, false, v1alpha2.ListenerReasonInvalid, proxyReason, generation)
+		default:
+			ready = newCondition(v1alpha2.ListenerConditionReady, false, v1alpha2.ListenerReasonPending, "waiting for gloo to accept the proxy", generation)
+		}
+		lt.status.Conditions = append(lt.status.Conditions, ready)
+	}
+
+	var ready metav1.Condition
+	switch {
+	case !allValid:
+		ready = newCondition(v1alpha2.GatewayConditionReady, false, v1alpha2.GatewayReasonListenersNotValid, "some listeners are invalid", generation)
+	case proxyState == core.Status_Accepted:
+		ready = newCondition(v1alpha2.GatewayConditionReady, true, v1alpha2.GatewayReasonReady, "", generation)
+	case proxyState == core.Status_Rejected:
+		ready = newCondition(v1alpha2.GatewayConditionReady, false, v1alpha2.GatewayReasonListenersNotValid, proxyReason, generation)
+	default:
+		ready = newCondition(v1alpha2.GatewayConditionReady, false, v1alpha2.GatewayReasonListenersNotReady, "waiting for gloo to accept the proxy", generation)
+	}
+	status.Conditions = append(status.Conditions, ready)
+}
+
+func routeGroupKind(kind string) v1alpha2.RouteGroupKind {
+	group := v1alpha2.GroupName
+	return v1alpha2.RouteGroupKind{Group: &group, Kind: kind}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
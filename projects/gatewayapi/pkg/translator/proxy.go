@@ -0,0 +1,183 @@
+package translator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1alpha2"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+type tcpRouteTranslation struct {
+	route       *v1alpha2.TCPRoute
+	destination *gloov1.MultiDestination
+	refErrs     *refErrors
+}
+
+// translateTCPRoute splits the connections between the backends of all the rules of the route.
+func (t *translation) translateTCPRoute(route *v1alpha2.TCPRoute) *tcpRouteTranslation {
+	translation := &tcpRouteTranslation{
+		route:   route,
+		refErrs: &refErrors{},
+	}
+	var backendRefs []v1alpha2.BackendRef
+	for _, rule := range route.Spec.Rules {
+		backendRefs = append(backendRefs, rule.BackendRefs...)
+	}
+	translation.destination = t.resolveBackendRefs(v1alpha2.TCPRouteKind, route.GetNamespace(), backendRefs, translation.refErrs)
+	return translation
+}
+
+// translateProxy translates the valid listeners of the gateway into a proxy with a listener for each of their ports.
+func (t *translation) translateProxy(gateway *v1alpha2.Gateway, listeners []*listenerTranslation) *gloov1.Proxy {
+	var ports []int32
+	portListeners := map[int32][]*listenerTranslation{}
+	for _, lt := range listeners {
+		if !lt.valid {
+			continue
+		}
+		if _, ok := portListeners[lt.listener.Port]; !ok {
+			ports = append(ports, lt.listener.Port)
+		}
+		portListeners[lt.listener.Port] = append(portListeners[lt.listener.Port], lt)
+	}
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i] < ports[j]
+	})
+
+	proxy := &gloov1.Proxy{}
+	for _, port := range ports {
+		// listeners of different protocols conflict, so all the listeners of a port share their protocol
+		var listener *gloov1.Listener
+		switch protocol := portListeners[port][0].listener.Protocol; protocol {
+		case v1alpha2.TCPProtocolType:
+			listener = translateTcpListener(portListeners[port][0])
+		default:
+			listener = translateHttpListener(portListeners[port])
+		}
+		if listener == nil {
+			continue
+		}
+		listener.Name = listenerName(string(portListeners[port][0].listener.Protocol), port)
+		listener.BindAddress = bindAddress
+		listener.BindPort = uint32(port)
+		proxy.Listeners = append(proxy.GetListeners(), listener)
+	}
+	return proxy
+}
+
+func translateTcpListener(lt *listenerTranslation) *gloov1.Listener {
+	if lt.tcpRoute == nil || lt.tcpRoute.destination == nil {
+		return nil
+	}
+	route := lt.tcpRoute.route
+	tcpHost := &gloov1.TcpHost{
+		Name:        fmt.Sprintf("%s-%s", route.GetNamespace(), route.GetName()),
+		Destination: &gloov1.TcpHost_TcpAction{},
+	}
+	if destinations := lt.tcpRoute.destination.GetDestinations(); len(destinations) == 1 {
+		tcpHost.Destination.Destination = &gloov1.TcpHost_TcpAction_Single{Single: destinations[0].GetDestination()}
+	} else {
+		tcpHost.Destination.Destination = &gloov1.TcpHost_TcpAction_Multi{Multi: lt.tcpRoute.destination}
+	}
+	return &gloov1.Listener{
+		ListenerType: &gloov1.Listener_TcpListener{
+			TcpListener: &gloov1.TcpListener{
+				TcpHosts: []*gloov1.TcpHost{tcpHost},
+			},
+		},
+	}
+}
+
+// translateHttpListener merges the routes of the listeners of a port into virtual hosts by their hostnames.
+// Like in the Gateway API, the requests for a host are routed by the routes of the listener whose hostname matches the
+// host most specifically, so the routes of the other listeners do not apply to it.
+func translateHttpListener(listeners []*listenerTranslation) *gloov1.Listener {
+	type hostRoutes struct {
+		owner   *listenerTranslation
+		matches []*httpRouteMatch
+	}
+	routesByHost := map[string][]hostRoutes{}
+	for _, lt := range listeners {
+		for _, attached := range lt.httpRoutes {
+			hostnames := attached.hostnames
+			if len(hostnames) == 0 {
+				hostnames = []string{anyHost}
+			}
+			for _, hostname := range hostnames {
+				routesByHost[hostname] = append(routesByHost[hostname], hostRoutes{owner: lt, matches: attached.route.matches})
+			}
+		}
+	}
+
+	var domains []string
+	for domain := range routesByHost {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	httpListener := &gloov1.HttpListener{}
+	for _, domain := range domains {
+		var owner *listenerTranslation
+		for _, lt := range listeners {
+			if hostnameMatches(stringOr(lt.listener.Hostname, ""), domain) &&
+				(owner == nil || hostnameSpecificity(lt.listener.Hostname) > hostnameSpecificity(owner.listener.Hostname)) {
+				owner = lt
+			}
+		}
+
+		var matches []*httpRouteMatch
+		for hostname, routes := range routesByHost {
+			if !hostnameMatches(hostname, domain) {
+				continue
+			}
+			for _, r := range routes {
+				if r.owner == owner {
+					matches = append(matches, r.matches...)
+				}
+			}
+		}
+		if len(matches) == 0 {
+			continue
+		}
+		sort.SliceStable(matches, func(i, j int) bool {
+			return httpRouteMatchLess(matches[i], matches[j])
+		})
+
+		virtualHost := &gloov1.VirtualHost{
+			Name:    virtualHostName(domain),
+			Domains: []string{domain},
+		}
+		for _, match := range matches {
+			for _, route := range match.routes {
+				virtualHost.Routes = append(virtualHost.GetRoutes(), route.Clone().(*gloov1.Route))
+			}
+		}
+		httpListener.VirtualHosts = append(httpListener.GetVirtualHosts(), virtualHost)
+	}
+
+	listener := &gloov1.Listener{
+		ListenerType: &gloov1.Listener_HttpListener{HttpListener: httpListener},
+	}
+	for _, lt := range listeners {
+		if lt.secretRef == nil {
+			continue
+		}
+		sslConfig := &gloov1.SslConfig{
+			SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: lt.secretRef},
+		}
+		if lt.listener.Hostname != nil && *lt.listener.Hostname != "" {
+			sslConfig.SniDomains = []string{*lt.listener.Hostname}
+		}
+		listener.SslConfigurations = append(listener.GetSslConfigurations(), sslConfig)
+	}
+	return listener
+}
+
+func virtualHostName(domain string) string {
+	if domain == anyHost {
+		return "any-host"
+	}
+	return strings.ReplaceAll(domain, "*", "wildcard")
+}
@@ -0,0 +1,337 @@
+package translator
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1alpha2"
+	v32 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/kubernetes"
+	envoycore "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
+)
+
+// httpRouteTranslation holds the gloo routes of an HTTPRoute, which do not depend on the listeners it attaches to.
+type httpRouteTranslation struct {
+	route   *v1alpha2.HTTPRoute
+	matches []*httpRouteMatch
+	refErrs *refErrors
+}
+
+// httpRouteMatch is the gloo routes of a single match of an HTTPRoute rule, with what decides its precedence.
+// A match has several routes when its matchers rewrite the path differently.
+type httpRouteMatch struct {
+	owner    *v1alpha2.HTTPRoute
+	match    v1alpha2.HTTPRouteMatch
+	ruleIdx  int
+	matchIdx int
+	routes   []*gloov1.Route
+}
+
+func (t *translation) translateHTTPRoute(route *v1alpha2.HTTPRoute) *httpRouteTranslation {
+	translation := &httpRouteTranslation{
+		route:   route,
+		refErrs: &refErrors{},
+	}
+	for ruleIdx, rule := range route.Spec.Rules {
+		action, options := t.translateHTTPRouteRule(route, rule, translation.refErrs)
+		matches := rule.Matches
+		if len(matches) == 0 {
+			matches = []v1alpha2.HTTPRouteMatch{{}}
+		}
+		for matchIdx, match := range matches {
+			match = defaultHTTPRouteMatch(match)
+			glooRoute := &gloov1.Route{
+				Name:     fmt.Sprintf("%s-%s-rule-%d-match-%d", route.GetNamespace(), route.GetName(), ruleIdx, matchIdx),
+				Matchers: translateHTTPRouteMatch(match),
+				Options:  options,
+			}
+			action(glooRoute)
+			translation.matches = append(translation.matches, &httpRouteMatch{
+				owner:    route,
+				match:    match,
+				ruleIdx:  ruleIdx,
+				matchIdx: matchIdx,
+				routes:   splitPrefixRewrite(glooRoute),
+			})
+		}
+	}
+	return translation
+}
+
+// translateHTTPRouteRule returns a func setting the action of the gloo routes of the rule, along with their options.
+// Like the Gateway API requires, the rule responds with a 500 if it has a filter that is not supported, or if none
+// of its backends can be resolved.
+func (t *translation) translateHTTPRouteRule(route *v1alpha2.HTTPRoute, rule v1alpha2.HTTPRouteRule, errs *refErrors) (func(*gloov1.Route), *gloov1.RouteOptions) {
+	internalError := func(glooRoute *gloov1.Route) {
+		glooRoute.Action = &gloov1.Route_DirectResponseAction{
+			DirectResponseAction: &gloov1.DirectResponseAction{Status: http.StatusInternalServerError},
+		}
+	}
+
+	options := &gloov1.RouteOptions{}
+	var redirect *gloov1.RedirectAction
+	supported := true
+	for _, filter := range rule.Filters {
+		switch {
+		case filter.Type == v1alpha2.HTTPRouteFilterRequestHeaderModifier && filter.RequestHeaderModifier != nil:
+			options.HeaderManipulation = translateRequestHeaderModifier(filter.RequestHeaderModifier)
+		case filter.Type == v1alpha2.HTTPRouteFilterRequestRedirect && filter.RequestRedirect != nil:
+			redirect = translateRequestRedirect(filter.RequestRedirect)
+		case filter.Type == v1alpha2.HTTPRouteFilterURLRewrite && filter.URLRewrite != nil:
+			translateURLRewrite(filter.URLRewrite, options)
+		case filter.Type == v1alpha2.HTTPRouteFilterRequestMirror && filter.RequestMirror != nil:
+			destination := t.resolveBackendRef(v1alpha2.HTTPRouteKind, route.GetNamespace(), filter.RequestMirror.BackendRef, errs)
+			if destination != nil {
+				options.Shadowing = &shadowing.RouteShadowing{
+					Upstream:   kubernetes.DestinationToUpstreamRef(destination.GetKube()),
+					Percentage: 100,
+				}
+			}
+		default:
+			supported = false
+			errs.add(v1alpha2.RouteReasonInvalidKind, fmt.Sprintf("filter %s is not supported", filter.Type))
+		}
+	}
+
+	if options.Equal(&gloov1.RouteOptions{}) {
+		options = nil
+	}
+
+	if !supported {
+		return internalError, options
+	}
+	if redirect != nil {
+		return func(glooRoute *gloov1.Route) {
+			glooRoute.Action = &gloov1.Route_RedirectAction{RedirectAction: redirect}
+		}, options
+	}
+
+	var backendRefs []v1alpha2.BackendRef
+	for _, backendRef := range rule.BackendRefs {
+		if len(backendRef.Filters) > 0 {
+			errs.add(v1alpha2.RouteReasonInvalidKind, fmt.Sprintf("filters are not supported on backend %s", backendRef.Name))
+			continue
+		}
+		backendRefs = append(backendRefs, backendRef.BackendRef)
+	}
+	multi := t.resolveBackendRefs(v1alpha2.HTTPRouteKind, route.GetNamespace(), backendRefs, errs)
+	if multi == nil {
+		return internalError, options
+	}
+	return func(glooRoute *gloov1.Route) {
+		glooRoute.Action = &gloov1.Route_RouteAction{RouteAction: routeAction(multi)}
+	}, options
+}
+
+func routeAction(multi *gloov1.MultiDestination) *gloov1.RouteAction {
+	if len(multi.GetDestinations()) == 1 {
+		return &gloov1.RouteAction{
+			Destination: &gloov1.RouteAction_Single{Single: multi.GetDestinations()[0].GetDestination()},
+		}
+	}
+	return &gloov1.RouteAction{
+		Destination: &gloov1.RouteAction_Multi{Multi: multi},
+	}
+}
+
+func translateRequestHeaderModifier(filter *v1alpha2.HTTPRequestHeaderFilter) *headers.HeaderManipulation {
+	headerManipulation := &headers.HeaderManipulation{
+		RequestHeadersToRemove: filter.Remove,
+	}
+	addHeaders := func(hs []v1alpha2.HTTPHeader, appendValue bool) {
+		for _, header := range hs {
+			headerManipulation.RequestHeadersToAdd = append(headerManipulation.GetRequestHeadersToAdd(), &envoycore.HeaderValueOption{
+				HeaderOption: &envoycore.HeaderValueOption_Header{
+					Header: &envoycore.HeaderValue{Key: header.Name, Value: header.Value},
+				},
+				Append: &wrappers.BoolValue{Value: appendValue},
+			})
+		}
+	}
+	addHeaders(filter.Set, false)
+	addHeaders(filter.Add, true)
+	return headerManipulation
+}
+
+func translateRequestRedirect(filter *v1alpha2.HTTPRequestRedirectFilter) *gloov1.RedirectAction {
+	redirect := &gloov1.RedirectAction{
+		HttpsRedirect: filter.Scheme != nil && *filter.Scheme == "https",
+		ResponseCode:  gloov1.RedirectAction_FOUND,
+	}
+	if filter.StatusCode != nil && *filter.StatusCode == http.StatusMovedPermanently {
+		redirect.ResponseCode = gloov1.RedirectAction_MOVED_PERMANENTLY
+	}
+	if filter.Hostname != nil {
+		redirect.HostRedirect = *filter.Hostname
+		// gloo redirects to a port as part of the host
+		if filter.Port != nil {
+			redirect.HostRedirect = fmt.Sprintf("%s:%d", *filter.Hostname, *filter.Port)
+		}
+	}
+	if path := filter.Path; path != nil {
+		switch {
+		case path.Type == v1alpha2.FullPathHTTPPathModifier && path.ReplaceFullPath != nil:
+			redirect.PathRewriteSpecifier = &gloov1.RedirectAction_PathRedirect{PathRedirect: *path.ReplaceFullPath}
+		case path.Type == v1alpha2.PrefixMatchHTTPPathModifier && path.ReplacePrefixMatch != nil:
+			redirect.PathRewriteSpecifier = &gloov1.RedirectAction_PrefixRewrite{PrefixRewrite: *path.ReplacePrefixMatch}
+		}
+	}
+	return redirect
+}
+
+func translateURLRewrite(filter *v1alpha2.HTTPURLRewriteFilter, options *gloov1.RouteOptions) {
+	if filter.Hostname != nil {
+		options.HostRewriteType = &gloov1.RouteOptions_HostRewrite{HostRewrite: *filter.Hostname}
+	}
+	if path := filter.Path; path != nil {
+		switch {
+		case path.Type == v1alpha2.FullPathHTTPPathModifier && path.ReplaceFullPath != nil:
+			options.RegexRewrite = &v32.RegexMatchAndSubstitute{
+				Pattern:      &v32.RegexMatcher{Regex: ".*"},
+				Substitution: *path.ReplaceFullPath,
+			}
+		case path.Type == v1alpha2.PrefixMatchHTTPPathModifier && path.ReplacePrefixMatch != nil:
+			options.PrefixRewrite = &wrappers.StringValue{Value: *path.ReplacePrefixMatch}
+		}
+	}
+}
+
+// splitPrefixRewrite gives each matcher of a route rewriting the path prefix a route of its own, as the prefix matchers
+// include the `/` ending the path element: their rewrite ends with a `/` too, so that `/foo/bar` is rewritten to
+// `/xyz/bar` and not `/xyzbar` when `/foo` is replaced with `/xyz`.
+func splitPrefixRewrite(glooRoute *gloov1.Route) []*gloov1.Route {
+	var rewrite string
+	if prefixRewrite := glooRoute.GetOptions().GetPrefixRewrite(); prefixRewrite != nil {
+		rewrite = prefixRewrite.GetValue()
+	} else if redirect, ok := glooRoute.GetRedirectAction().GetPathRewriteSpecifier().(*gloov1.RedirectAction_PrefixRewrite); ok {
+		rewrite = redirect.PrefixRewrite
+	} else {
+		return []*gloov1.Route{glooRoute}
+	}
+
+	var routes []*gloov1.Route
+	for i, matcher := range glooRoute.GetMatchers() {
+		// the options and redirect are shared by the routes of the rule
+		route := glooRoute.Clone().(*gloov1.Route)
+		route.Matchers = []*matchers.Matcher{matcher}
+		if i > 0 {
+			route.Name = fmt.Sprintf("%s-%d", glooRoute.GetName(), i)
+		}
+		if matcher.GetPrefix() != "" {
+			elementRewrite := strings.TrimSuffix(rewrite, "/") + "/"
+			if route.GetOptions().GetPrefixRewrite() != nil {
+				route.GetOptions().PrefixRewrite = &wrappers.StringValue{Value: elementRewrite}
+			} else {
+				route.GetRedirectAction().PathRewriteSpecifier = &gloov1.RedirectAction_PrefixRewrite{PrefixRewrite: elementRewrite}
+			}
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+func defaultHTTPRouteMatch(match v1alpha2.HTTPRouteMatch) v1alpha2.HTTPRouteMatch {
+	pathType, pathValue := v1alpha2.PathMatchPathPrefix, "/"
+	if match.Path != nil {
+		if match.Path.Type != nil {
+			pathType = *match.Path.Type
+		}
+		if match.Path.Value != nil {
+			pathValue = *match.Path.Value
+		}
+	}
+	match.Path = &v1alpha2.HTTPPathMatch{Type: &pathType, Value: &pathValue}
+	return match
+}
+
+// translateHTTPRouteMatch returns the gloo matchers of a defaulted match.
+// Path prefixes of the Gateway API match whole path elements, so `/foo` matches `/foo` and `/foo/bar` but not `/foobar`.
+func translateHTTPRouteMatch(match v1alpha2.HTTPRouteMatch) []*matchers.Matcher {
+	newMatcher := func() *matchers.Matcher {
+		matcher := &matchers.Matcher{}
+		for _, header := range match.Headers {
+			matcher.Headers = append(matcher.GetHeaders(), &matchers.HeaderMatcher{
+				Name:  header.Name,
+				Value: header.Value,
+				Regex: header.Type != nil && *header.Type == v1alpha2.MatchRegularExpression,
+			})
+		}
+		for _, queryParam := range match.QueryParams {
+			matcher.QueryParameters = append(matcher.GetQueryParameters(), &matchers.QueryParameterMatcher{
+				Name:  queryParam.Name,
+				Value: queryParam.Value,
+				Regex: queryParam.Type != nil && *queryParam.Type == v1alpha2.MatchRegularExpression,
+			})
+		}
+		if match.Method != nil {
+			matcher.Methods = []string{*match.Method}
+		}
+		return matcher
+	}
+
+	path := *match.Path.Value
+	switch *match.Path.Type {
+	case v1alpha2.PathMatchExact:
+		matcher := newMatcher()
+		matcher.PathSpecifier = &matchers.Matcher_Exact{Exact: path}
+		return []*matchers.Matcher{matcher}
+	case v1alpha2.PathMatchRegularExpression:
+		matcher := newMatcher()
+		matcher.PathSpecifier = &matchers.Matcher_Regex{Regex: path}
+		return []*matchers.Matcher{matcher}
+	default:
+		if strings.HasSuffix(path, "/") {
+			matcher := newMatcher()
+			matcher.PathSpecifier = &matchers.Matcher_Prefix{Prefix: path}
+			return []*matchers.Matcher{matcher}
+		}
+		exact, prefix := newMatcher(), newMatcher()
+		exact.PathSpecifier = &matchers.Matcher_Exact{Exact: path}
+		prefix.PathSpecifier = &matchers.Matcher_Prefix{Prefix: path + "/"}
+		return []*matchers.Matcher{exact, prefix}
+	}
+}
+
+// httpRouteMatchLess orders the routes of a virtual host by the precedence of the Gateway API: exact paths first,
+// then the longest prefixes, then the matches of a method, of the most headers and of the most query parameters.
+// Ties go to the oldest route, then to the first route by namespace and name, then to the first rule and match.
+func httpRouteMatchLess(a, b *httpRouteMatch) bool {
+	pathRank := func(m *httpRouteMatch) int {
+		switch *m.match.Path.Type {
+		case v1alpha2.PathMatchExact:
+			return 0
+		case v1alpha2.PathMatchPathPrefix:
+			return 1
+		default:
+			return 2
+		}
+	}
+	if pathRank(a) != pathRank(b) {
+		return pathRank(a) < pathRank(b)
+	}
+	if pathRank(a) < 2 && len(*a.match.Path.Value) != len(*b.match.Path.Value) {
+		return len(*a.match.Path.Value) > len(*b.match.Path.Value)
+	}
+	if (a.match.Method != nil) != (b.match.Method != nil) {
+		return a.match.Method != nil
+	}
+	if len(a.match.Headers) != len(b.match.Headers) {
+		return len(a.match.Headers) > len(b.match.Headers)
+	}
+	if len(a.match.QueryParams) != len(b.match.QueryParams) {
+		return len(a.match.QueryParams) > len(b.match.QueryParams)
+	}
+	if a.owner != b.owner {
+		return objectLess(a.owner, b.owner)
+	}
+	if a.ruleIdx != b.ruleIdx {
+		return a.ruleIdx < b.ruleIdx
+	}
+	return a.matchIdx < b.matchIdx
+}
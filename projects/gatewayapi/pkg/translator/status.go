@@ -0,0 +1,63 @@
+package translator
+
+import (
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Reports hold the status of the Gateway API resources that the controller manages.
+// Conditions are reported without a transition time, which is set when the status is written.
+type Reports struct {
+	GatewayClasses map[types.NamespacedName]*v1alpha2.GatewayClassStatus
+	Gateways       map[types.NamespacedName]*v1alpha2.GatewayStatus
+	// the status of the routes for the gateways that the controller manages; the other parents are not reported
+	HTTPRoutes map[types.NamespacedName][]v1alpha2.RouteParentStatus
+	TCPRoutes  map[types.NamespacedName][]v1alpha2.RouteParentStatus
+}
+
+func newReports() *Reports {
+	return &Reports{
+		GatewayClasses: map[types.NamespacedName]*v1alpha2.GatewayClassStatus{},
+		Gateways:       map[types.NamespacedName]*v1alpha2.GatewayStatus{},
+		HTTPRoutes:     map[types.NamespacedName][]v1alpha2.RouteParentStatus{},
+		TCPRoutes:      map[types.NamespacedName][]v1alpha2.RouteParentStatus{},
+	}
+}
+
+func newCondition(conditionType string, status bool, reason, message string, generation int64) metav1.Condition {
+	conditionStatus := metav1.ConditionFalse
+	if status {
+		conditionStatus = metav1.ConditionTrue
+	}
+	return metav1.Condition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	}
+}
+
+// refErrors collect the references of a resource that could not be resolved.
+// The first error decides the reason of the condition, and the condition lists the messages of all of them.
+type refErrors struct {
+	reason   string
+	messages []string
+}
+
+func (e *refErrors) add(reason, message string) {
+	if e.reason == "" {
+		e.reason = reason
+	}
+	e.messages = append(e.messages, message)
+}
+
+func (e *refErrors) condition(conditionType, resolvedReason string, generation int64) metav1.Condition {
+	if e.reason == "" {
+		return newCondition(conditionType, true, resolvedReason, "", generation)
+	}
+	return newCondition(conditionType, false, e.reason, strings.Join(e.messages, "; "), generation)
+}
@@ -0,0 +1,270 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1alpha2"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// the controller name of the gateway classes that gloo implements
+	DefaultControllerName = "solo.io/gloo-gateway"
+
+	bindAddress = "::"
+)
+
+var ProxyLabels = map[string]string{
+	"created_by": "gloo-gatewayapi-translator",
+}
+
+// Snapshot holds the resources that the Gateway API resources are translated from.
+type Snapshot struct {
+	GatewayClasses  []*v1alpha2.GatewayClass
+	Gateways        []*v1alpha2.Gateway
+	HTTPRoutes      []*v1alpha2.HTTPRoute
+	TCPRoutes       []*v1alpha2.TCPRoute
+	ReferenceGrants []*v1alpha2.ReferenceGrant
+	Services        []*corev1.Service
+	Namespaces      []*corev1.Namespace
+
+	// the proxies written for the gateways, which tell whether gloo accepted them
+	Proxies gloov1.ProxyList
+}
+
+// The Translator turns every Gateway of a GatewayClass of its controller into a Proxy.
+type Translator struct {
+	controllerName string
+	writeNamespace string
+	statusClient   resources.StatusClient
+}
+
+func NewTranslator(controllerName, writeNamespace string, statusClient resources.StatusClient) *Translator {
+	return &Translator{
+		controllerName: controllerName,
+		writeNamespace: writeNamespace,
+		statusClient:   statusClient,
+	}
+}
+
+// ProxyName returns the name of the proxy of the gateway.
+func ProxyName(gateway *v1alpha2.Gateway) string {
+	return fmt.Sprintf("%s-%s", gateway.GetNamespace(), gateway.GetName())
+}
+
+// translation holds the state of a single translation of a snapshot.
+type translation struct {
+	controllerName string
+	snap           *Snapshot
+	services       map[types.NamespacedName]*corev1.Service
+	namespaces     map[string]*corev1.Namespace
+	gateways       map[types.NamespacedName][]*listenerTranslation
+}
+
+// Translate returns the proxies of the gateways, along with the status of the resources that the controller manages.
+func (t *Translator) Translate(ctx context.Context, snap *Snapshot) (gloov1.ProxyList, *Reports) {
+	logger := contextutils.LoggerFrom(ctx)
+	reports := newReports()
+	tr := &translation{
+		controllerName: t.controllerName,
+		snap:           snap,
+		services:       map[types.NamespacedName]*corev1.Service{},
+		namespaces:     map[string]*corev1.Namespace{},
+		gateways:       map[types.NamespacedName][]*listenerTranslation{},
+	}
+	for _, service := range snap.Services {
+		tr.services[namespacedName(service.GetNamespace(), service.GetName())] = service
+	}
+	for _, ns := range snap.Namespaces {
+		tr.namespaces[ns.GetName()] = ns
+	}
+
+	classes := map[string]bool{}
+	for _, class := range snap.GatewayClasses {
+		if class.Spec.ControllerName != t.controllerName {
+			continue
+		}
+		classes[class.GetName()] = true
+		reports.GatewayClasses[namespacedName("", class.GetName())] = &v1alpha2.GatewayClassStatus{
+			Conditions: []metav1.Condition{
+				newCondition(v1alpha2.GatewayClassConditionAccepted, true, v1alpha2.GatewayClassReasonAccepted, "", class.GetGeneration()),
+			},
+		}
+	}
+
+	var gateways []*v1alpha2.Gateway
+	for _, gateway := range snap.Gateways {
+		if !classes[gateway.Spec.GatewayClassName] {
+			continue
+		}
+		gateways = append(gateways, gateway)
+		status := &v1alpha2.GatewayStatus{
+			Addresses: gateway.Status.Addresses,
+			Conditions: []metav1.Condition{
+				newCondition(v1alpha2.GatewayConditionScheduled, true, v1alpha2.GatewayReasonScheduled, "", gateway.GetGeneration()),
+			},
+		}
+		key := namespacedName(gateway.GetNamespace(), gateway.GetName())
+		reports.Gateways[key] = status
+		tr.gateways[key] = tr.translateListeners(gateway, status)
+	}
+	sort.SliceStable(gateways, func(i, j int) bool {
+		return objectLess(gateways[i], gateways[j])
+	})
+
+	httpRoutes := append([]*v1alpha2.HTTPRoute{}, snap.HTTPRoutes...)
+	sort.SliceStable(httpRoutes, func(i, j int) bool {
+		return objectLess(httpRoutes[i], httpRoutes[j])
+	})
+	for _, route := range httpRoutes {
+		translation := tr.translateHTTPRoute(route)
+		parents := tr.attachRoute(v1alpha2.HTTPRouteKind, route, route.Spec.ParentRefs, func(lt *listenerTranslation) bool {
+			hostnames, ok := intersectHostnames(lt.listener.Hostname, route.Spec.Hostnames)
+			if ok {
+				lt.httpRoutes = append(lt.httpRoutes, &attachedHTTPRoute{route: translation, hostnames: hostnames})
+			}
+			return ok
+		}, translation.refErrs)
+		if len(parents) > 0 {
+			reports.HTTPRoutes[namespacedName(route.GetNamespace(), route.GetName())] = parents
+		}
+	}
+
+	tcpRoutes := append([]*v1alpha2.TCPRoute{}, snap.TCPRoutes...)
+	sort.SliceStable(tcpRoutes, func(i, j int) bool {
+		return objectLess(tcpRoutes[i], tcpRoutes[j])
+	})
+	for _, route := range tcpRoutes {
+		translation := tr.translateTCPRoute(route)
+		parents := tr.attachRoute(v1alpha2.TCPRouteKind, route, route.Spec.ParentRefs, func(lt *listenerTranslation) bool {
+			// a tcp listener forwards all its connections to the backends of a single route, the oldest one
+			if lt.tcpRoute != nil {
+				return false
+			}
+			lt.tcpRoute = translation
+			return true
+		}, translation.refErrs)
+		if len(parents) > 0 {
+			reports.TCPRoutes[namespacedName(route.GetNamespace(), route.GetName())] = parents
+		}
+	}
+
+	proxyStates := map[string]*core.Status{}
+	for _, proxy := range snap.Proxies {
+		proxyStates[proxy.GetMetadata().GetName()] = t.statusClient.GetStatus(proxy)
+	}
+
+	var proxies gloov1.ProxyList
+	for _, gateway := range gateways {
+		key := namespacedName(gateway.GetNamespace(), gateway.GetName())
+		listeners := tr.gateways[key]
+		proxy := tr.translateProxy(gateway, listeners)
+		proxy.Metadata = &core.Metadata{
+			Name:      ProxyName(gateway),
+			Namespace: t.writeNamespace,
+			Labels:    ProxyLabels,
+		}
+		proxies = append(proxies, proxy)
+
+		proxyStatus := proxyStates[proxy.GetMetadata().GetName()]
+		reportListenersReady(gateway, listeners, reports.Gateways[key], proxyStatus.GetState(), proxyStatus.GetReason())
+		logger.Debugf("translated gateway %s.%s to proxy %s", gateway.GetName(), gateway.GetNamespace(), proxy.GetMetadata().GetName())
+	}
+	return proxies, reports
+}
+
+// attachRoute attaches the route to the listeners of its parents that allow it, returning the status of the parents
+// managed by the controller. Once a listener allows a route, attach decides whether the route attaches to it.
+func (t *translation) attachRoute(
+	kind string,
+	route metav1.Object,
+	parentRefs []v1alpha2.ParentReference,
+	attach func(lt *listenerTranslation) bool,
+	errs *refErrors,
+) []v1alpha2.RouteParentStatus {
+	var parents []v1alpha2.RouteParentStatus
+	for _, parentRef := range parentRefs {
+		if stringOr(parentRef.Group, v1alpha2.GroupName) != v1alpha2.GroupName || stringOr(parentRef.Kind, v1alpha2.GatewayKind) != v1alpha2.GatewayKind {
+			continue
+		}
+		listeners, ok := t.gateways[namespacedName(stringOr(parentRef.Namespace, route.GetNamespace()), parentRef.Name)]
+		if !ok {
+			continue
+		}
+
+		var matched, allowed, attached int
+		for _, lt := range listeners {
+			if parentRef.SectionName != nil && *parentRef.SectionName != lt.listener.Name {
+				continue
+			}
+			if parentRef.Port != nil && *parentRef.Port != lt.listener.Port {
+				continue
+			}
+			matched++
+			if !lt.valid || !t.allowsRoute(lt, kind, route.GetNamespace()) {
+				continue
+			}
+			allowed++
+			if attach(lt) {
+				attached++
+				lt.status.AttachedRoutes++
+			}
+		}
+
+		var accepted metav1.Condition
+		switch {
+		case matched == 0:
+			accepted = newCondition(v1alpha2.RouteConditionAccepted, false, v1alpha2.RouteReasonNoMatchingParent,
+				"no listener of the gateway matches the parent reference", route.GetGeneration())
+		case allowed == 0:
+			accepted = newCondition(v1alpha2.RouteConditionAccepted, false, v1alpha2.RouteReasonNotAllowedByListeners,
+				"no listener of the gateway allows the route", route.GetGeneration())
+		case attached == 0 && kind == v1alpha2.HTTPRouteKind:
+			accepted = newCondition(v1alpha2.RouteConditionAccepted, false, v1alpha2.RouteReasonNoMatchingListenerHostname,
+				"no listener of the gateway matches the hostnames of the route", route.GetGeneration())
+		case attached == 0:
+			accepted = newCondition(v1alpha2.RouteConditionAccepted, false, v1alpha2.RouteReasonNotAllowedByListeners,
+				"the listeners of the gateway already forward their connections to another route", route.GetGeneration())
+		default:
+			accepted = newCondition(v1alpha2.RouteConditionAccepted, true, v1alpha2.RouteReasonAccepted, "", route.GetGeneration())
+		}
+		parents = append(parents, v1alpha2.RouteParentStatus{
+			ParentRef:      parentRef,
+			ControllerName: t.controllerName,
+			Conditions: []metav1.Condition{
+				accepted,
+				errs.condition(v1alpha2.RouteConditionResolvedRefs, v1alpha2.RouteReasonResolvedRefs, route.GetGeneration()),
+			},
+		})
+	}
+	return parents
+}
+
+// objectLess orders resources by age, then by namespace and name.
+func objectLess(a, b metav1.Object) bool {
+	aTime, bTime := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !aTime.Equal(&bTime) {
+		return aTime.Before(&bTime)
+	}
+	if a.GetNamespace() != b.GetNamespace() {
+		return a.GetNamespace() < b.GetNamespace()
+	}
+	return a.GetName() < b.GetName()
+}
+
+func namespacedName(namespace, name string) types.NamespacedName {
+	return types.NamespacedName{Namespace: namespace, Name: name}
+}
+
+func listenerName(protocol string, port int32) string {
+	return fmt.Sprintf("%s-%d", strings.ToLower(protocol), port)
+}
@@ -0,0 +1,672 @@
+package translator_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/pkg/utils/statusutils"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1alpha2"
+	. "github.com/solo-io/gloo/projects/gatewayapi/pkg/translator"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+	envoycore "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	. "github.com/solo-io/solo-kit/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Translator", func() {
+
+	const (
+		writeNamespace = "gloo-system"
+		gatewayNs      = "infra"
+		appNs          = "app"
+	)
+
+	var (
+		ctx          context.Context
+		statusClient resources.StatusClient
+		translator   *Translator
+
+		snap    *Snapshot
+		gateway *v1alpha2.Gateway
+		route   *v1alpha2.HTTPRoute
+	)
+
+	strPtr := func(s string) *string { return &s }
+	int32Ptr := func(i int32) *int32 { return &i }
+
+	kubeDestination := func(name, namespace string, port uint32) *gloov1.Destination {
+		return &gloov1.Destination{
+			DestinationType: &gloov1.Destination_Kube{
+				Kube: &gloov1.KubernetesServiceDestination{
+					Ref:  &core.ResourceRef{Name: name, Namespace: namespace},
+					Port: port,
+				},
+			},
+		}
+	}
+
+	service := func(name, namespace string, port int32) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: port}}},
+		}
+	}
+
+	backendRef := func(name string, namespace *string, port int32) v1alpha2.HTTPBackendRef {
+		return v1alpha2.HTTPBackendRef{
+			BackendRef: v1alpha2.BackendRef{
+				BackendObjectReference: v1alpha2.BackendObjectReference{
+					Name:      name,
+					Namespace: namespace,
+					Port:      int32Ptr(port),
+				},
+			},
+		}
+	}
+
+	translate := func() (gloov1.ProxyList, *Reports) {
+		return translator.Translate(ctx, snap)
+	}
+
+	gatewayStatus := func(reports *Reports) *v1alpha2.GatewayStatus {
+		return reports.Gateways[types.NamespacedName{Namespace: gateway.GetNamespace(), Name: gateway.GetName()}]
+	}
+
+	routeCondition := func(reports *Reports, conditionType string) *metav1.Condition {
+		parents := reports.HTTPRoutes[types.NamespacedName{Namespace: route.GetNamespace(), Name: route.GetName()}]
+		ExpectWithOffset(1, parents).To(HaveLen(1))
+		return meta.FindStatusCondition(parents[0].Conditions, conditionType)
+	}
+
+	listenerCondition := func(reports *Reports, listenerIdx int, conditionType string) *metav1.Condition {
+		return meta.FindStatusCondition(gatewayStatus(reports).Listeners[listenerIdx].Conditions, conditionType)
+	}
+
+	virtualHosts := func(proxy *gloov1.Proxy) []*gloov1.VirtualHost {
+		return proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		statusClient = statusutils.GetStatusClientForNamespace(writeNamespace)
+		translator = NewTranslator(DefaultControllerName, writeNamespace, statusClient)
+
+		gateway = &v1alpha2.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: gatewayNs, Generation: 2},
+			Spec: v1alpha2.GatewaySpec{
+				GatewayClassName: "gloo",
+				Listeners: []v1alpha2.Listener{{
+					Name:     "http",
+					Port:     80,
+					Protocol: v1alpha2.HTTPProtocolType,
+					AllowedRoutes: &v1alpha2.AllowedRoutes{
+						Namespaces: &v1alpha2.RouteNamespaces{
+							From: func() *v1alpha2.FromNamespaces { from := v1alpha2.NamespacesFromAll; return &from }(),
+						},
+					},
+				}},
+			},
+		}
+		route = &v1alpha2.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: appNs, Generation: 3},
+			Spec: v1alpha2.HTTPRouteSpec{
+				CommonRouteSpec: v1alpha2.CommonRouteSpec{
+					ParentRefs: []v1alpha2.ParentReference{{Name: "gw", Namespace: strPtr(gatewayNs)}},
+				},
+				Hostnames: []string{"example.com"},
+				Rules: []v1alpha2.HTTPRouteRule{{
+					BackendRefs: []v1alpha2.HTTPBackendRef{backendRef("svc", nil, 8080)},
+				}},
+			},
+		}
+		snap = &Snapshot{
+			GatewayClasses: []*v1alpha2.GatewayClass{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "gloo", Generation: 1},
+					Spec:       v1alpha2.GatewayClassSpec{ControllerName: DefaultControllerName},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "other"},
+					Spec:       v1alpha2.GatewayClassSpec{ControllerName: "example.com/other"},
+				},
+			},
+			Gateways:   []*v1alpha2.Gateway{gateway},
+			HTTPRoutes: []*v1alpha2.HTTPRoute{route},
+			Services:   []*corev1.Service{service("svc", appNs, 8080)},
+			Namespaces: []*corev1.Namespace{
+				{ObjectMeta: metav1.ObjectMeta{Name: appNs, Labels: map[string]string{"team": "app"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: gatewayNs}},
+			},
+		}
+	})
+
+	It("translates a gateway and its http routes into a proxy", func() {
+		proxies, reports := translate()
+		Expect(proxies).To(HaveLen(1))
+		Expect(proxies[0]).To(MatchProto(&gloov1.Proxy{
+			Metadata: &core.Metadata{
+				Name:      "infra-gw",
+				Namespace: writeNamespace,
+				Labels:    ProxyLabels,
+			},
+			Listeners: []*gloov1.Listener{{
+				Name:        "http-80",
+				BindAddress: "::",
+				BindPort:    80,
+				ListenerType: &gloov1.Listener_HttpListener{
+					HttpListener: &gloov1.HttpListener{
+						VirtualHosts: []*gloov1.VirtualHost{{
+							Name:    "example.com",
+							Domains: []string{"example.com"},
+							Routes: []*gloov1.Route{{
+								Name:     "app-route-rule-0-match-0",
+								Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"}}},
+								Action: &gloov1.Route_RouteAction{
+									RouteAction: &gloov1.RouteAction{
+										Destination: &gloov1.RouteAction_Single{Single: kubeDestination("svc", appNs, 8080)},
+									},
+								},
+							}},
+						}},
+					},
+				},
+			}},
+		}))
+
+		Expect(reports.GatewayClasses).To(HaveLen(1))
+		classStatus := reports.GatewayClasses[types.NamespacedName{Name: "gloo"}]
+		Expect(meta.IsStatusConditionTrue(classStatus.Conditions, v1alpha2.GatewayClassConditionAccepted)).To(BeTrue())
+
+		status := gatewayStatus(reports)
+		Expect(meta.IsStatusConditionTrue(status.Conditions, v1alpha2.GatewayConditionScheduled)).To(BeTrue())
+		Expect(status.Listeners).To(HaveLen(1))
+		Expect(status.Listeners[0].AttachedRoutes).To(Equal(int32(1)))
+		Expect(status.Listeners[0].SupportedKinds).To(HaveLen(1))
+		Expect(status.Listeners[0].SupportedKinds[0].Kind).To(Equal(v1alpha2.HTTPRouteKind))
+		Expect(listenerCondition(reports, 0, v1alpha2.ListenerConditionDetached).Status).To(Equal(metav1.ConditionFalse))
+		Expect(listenerCondition(reports, 0, v1alpha2.ListenerConditionConflicted).Status).To(Equal(metav1.ConditionFalse))
+		Expect(listenerCondition(reports, 0, v1alpha2.ListenerConditionResolvedRefs).Status).To(Equal(metav1.ConditionTrue))
+
+		accepted := routeCondition(reports, v1alpha2.RouteConditionAccepted)
+		Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+		Expect(accepted.ObservedGeneration).To(Equal(int64(3)))
+		Expect(routeCondition(reports, v1alpha2.RouteConditionResolvedRefs).Status).To(Equal(metav1.ConditionTrue))
+		parents := reports.HTTPRoutes[types.NamespacedName{Namespace: appNs, Name: "route"}]
+		Expect(parents[0].ControllerName).To(Equal(DefaultControllerName))
+	})
+
+	It("ignores the gateways of the classes of other controllers", func() {
+		gateway.Spec.GatewayClassName = "other"
+		proxies, reports := translate()
+		Expect(proxies).To(BeEmpty())
+		Expect(reports.Gateways).To(BeEmpty())
+		Expect(reports.HTTPRoutes).To(BeEmpty())
+	})
+
+	Context("ready condition", func() {
+
+		It("reports the gateway pending until gloo accepts its proxy", func() {
+			_, reports := translate()
+			ready := meta.FindStatusCondition(gatewayStatus(reports).Conditions, v1alpha2.GatewayConditionReady)
+			Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+			Expect(ready.Reason).To(Equal(v1alpha2.GatewayReasonListenersNotReady))
+			Expect(listenerCondition(reports, 0, v1alpha2.ListenerConditionReady).Reason).To(Equal(v1alpha2.ListenerReasonPending))
+		})
+
+		It("reports the gateway ready once gloo accepts its proxy", func() {
+			proxies, _ := translate()
+			statusClient.SetStatus(proxies[0], &core.Status{State: core.Status_Accepted})
+			snap.Proxies = proxies
+
+			_, reports := translate()
+			Expect(meta.IsStatusConditionTrue(gatewayStatus(reports).Conditions, v1alpha2.GatewayConditionReady)).To(BeTrue())
+			Expect(listenerCondition(reports, 0, v1alpha2.ListenerConditionReady).Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("reports the reason gloo rejected the proxy", func() {
+			proxies, _ := translate()
+			statusClient.SetStatus(proxies[0], &core.Status{State: core.Status_Rejected, Reason: "bad config"})
+			snap.Proxies = proxies
+
+			_, reports := translate()
+			ready := meta.FindStatusCondition(gatewayStatus(reports).Conditions, v1alpha2.GatewayConditionReady)
+			Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+			Expect(ready.Reason).To(Equal(v1alpha2.GatewayReasonListenersNotValid))
+			Expect(ready.Message).To(Equal("bad config"))
+		})
+	})
+
+	Context("listeners", func() {
+
+		It("reports conflicting listeners and leaves them out of the proxy", func() {
+			gateway.Spec.Listeners = append(gateway.Spec.Listeners,
+				v1alpha2.Listener{Name: "tcp", Port: 80, Protocol: v1alpha2.TCPProtocolType},
+				v1alpha2.Listener{Name: "http-dup", Port: 80, Protocol: v1alpha2.HTTPProtocolType},
+			)
+			proxies, reports := translate()
+			Expect(proxies[0].GetListeners()).To(HaveLen(1))
+
+			conflicted := listenerCondition(reports, 1, v1alpha2.ListenerConditionConflicted)
+			Expect(conflicted.Status).To(Equal(metav1.ConditionTrue))
+			Expect(conflicted.Reason).To(Equal(v1alpha2.ListenerReasonProtocolConflict))
+			conflicted = listenerCondition(reports, 2, v1alpha2.ListenerConditionConflicted)
+			Expect(conflicted.Status).To(Equal(metav1.ConditionTrue))
+			Expect(conflicted.Reason).To(Equal(v1alpha2.ListenerReasonHostnameConflict))
+			Expect(meta.FindStatusCondition(gatewayStatus(reports).Conditions, v1alpha2.GatewayConditionReady).Reason).
+				To(Equal(v1alpha2.GatewayReasonListenersNotValid))
+		})
+
+		It("detaches the listeners of unsupported protocols", func() {
+			gateway.Spec.Listeners[0].Protocol = v1alpha2.UDPProtocolType
+			proxies, reports := translate()
+			Expect(proxies[0].GetListeners()).To(BeEmpty())
+			detached := listenerCondition(reports, 0, v1alpha2.ListenerConditionDetached)
+			Expect(detached.Status).To(Equal(metav1.ConditionTrue))
+			Expect(detached.Reason).To(Equal(v1alpha2.ListenerReasonUnsupportedProtocol))
+			Expect(routeCondition(reports, v1alpha2.RouteConditionAccepted).Reason).To(Equal(v1alpha2.RouteReasonNotAllowedByListeners))
+		})
+
+		It("reports the route kinds that the listener cannot support", func() {
+			gateway.Spec.Listeners[0].AllowedRoutes.Kinds = []v1alpha2.RouteGroupKind{{Kind: v1alpha2.HTTPRouteKind}, {Kind: v1alpha2.TCPRouteKind}}
+			_, reports := translate()
+			Expect(gatewayStatus(reports).Listeners[0].SupportedKinds).To(HaveLen(1))
+			resolvedRefs := listenerCondition(reports, 0, v1alpha2.ListenerConditionResolvedRefs)
+			Expect(resolvedRefs.Status).To(Equal(metav1.ConditionFalse))
+			Expect(resolvedRefs.Reason).To(Equal(v1alpha2.ListenerReasonInvalidRouteKinds))
+		})
+
+		It("translates https listeners into ssl configurations", func() {
+			gateway.Spec.Listeners = append(gateway.Spec.Listeners, v1alpha2.Listener{
+				Name:     "https",
+				Hostname: strPtr("example.com"),
+				Port:     443,
+				Protocol: v1alpha2.HTTPSProtocolType,
+				TLS: &v1alpha2.GatewayTLSConfig{
+					CertificateRefs: []*v1alpha2.SecretObjectReference{{Name: "cert"}},
+				},
+				AllowedRoutes: gateway.Spec.Listeners[0].AllowedRoutes,
+			})
+			proxies, reports := translate()
+			Expect(proxies[0].GetListeners()).To(HaveLen(2))
+			httpsListener := proxies[0].GetListeners()[1]
+			Expect(httpsListener.GetName()).To(Equal("https-443"))
+			Expect(httpsListener.GetSslConfigurations()).To(ConsistOf(MatchProto(&gloov1.SslConfig{
+				SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: &core.ResourceRef{Name: "cert", Namespace: gatewayNs}},
+				SniDomains: []string{"example.com"},
+			})))
+			Expect(httpsListener.GetHttpListener().GetVirtualHosts()).To(HaveLen(1))
+			Expect(gatewayStatus(reports).Listeners[1].AttachedRoutes).To(Equal(int32(1)))
+		})
+
+		It("requires a reference grant for certificates in other namespaces", func() {
+			gateway.Spec.Listeners[0] = v1alpha2.Listener{
+				Name:     "https",
+				Port:     443,
+				Protocol: v1alpha2.HTTPSProtocolType,
+				TLS: &v1alpha2.GatewayTLSConfig{
+					CertificateRefs: []*v1alpha2.SecretObjectReference{{Name: "cert", Namespace: strPtr("certs")}},
+				},
+			}
+			proxies, reports := translate()
+			Expect(proxies[0].GetListeners()).To(BeEmpty())
+			resolvedRefs := listenerCondition(reports, 0, v1alpha2.ListenerConditionResolvedRefs)
+			Expect(resolvedRefs.Status).To(Equal(metav1.ConditionFalse))
+			Expect(resolvedRefs.Reason).To(Equal(v1alpha2.ListenerReasonRefNotPermitted))
+
+			snap.ReferenceGrants = []*v1alpha2.ReferenceGrant{{
+				ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "certs"},
+				Spec: v1alpha2.ReferenceGrantSpec{
+					From: []v1alpha2.ReferenceGrantFrom{{Group: v1alpha2.GroupName, Kind: v1alpha2.GatewayKind, Namespace: gatewayNs}},
+					To:   []v1alpha2.ReferenceGrantTo{{Kind: "Secret"}},
+				},
+			}}
+			proxies, reports = translate()
+			Expect(proxies[0].GetListeners()).To(HaveLen(1))
+			Expect(listenerCondition(reports, 0, v1alpha2.ListenerConditionResolvedRefs).Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("translates tcp listeners with the oldest tcp route", func() {
+			gateway.Spec.Listeners[0] = v1alpha2.Listener{
+				Name:          "tcp",
+				Port:          9000,
+				Protocol:      v1alpha2.TCPProtocolType,
+				AllowedRoutes: gateway.Spec.Listeners[0].AllowedRoutes,
+			}
+			tcpRoute := func(name string, created time.Time) *v1alpha2.TCPRoute {
+				return &v1alpha2.TCPRoute{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: appNs, CreationTimestamp: metav1.NewTime(created)},
+					Spec: v1alpha2.TCPRouteSpec{
+						CommonRouteSpec: route.Spec.CommonRouteSpec,
+						Rules: []v1alpha2.TCPRouteRule{{
+							BackendRefs: []v1alpha2.BackendRef{backendRef("svc", nil, 8080).BackendRef},
+						}},
+					},
+				}
+			}
+			now := time.Now()
+			snap.TCPRoutes = []*v1alpha2.TCPRoute{tcpRoute("newer", now), tcpRoute("older", now.Add(-time.Hour))}
+
+			proxies, reports := translate()
+			Expect(proxies[0].GetListeners()).To(HaveLen(1))
+			Expect(proxies[0].GetListeners()[0].GetTcpListener()).To(MatchProto(&gloov1.TcpListener{
+				TcpHosts: []*gloov1.TcpHost{{
+					Name: "app-older",
+					Destination: &gloov1.TcpHost_TcpAction{
+						Destination: &gloov1.TcpHost_TcpAction_Single{Single: kubeDestination("svc", appNs, 8080)},
+					},
+				}},
+			}))
+			older := reports.TCPRoutes[types.NamespacedName{Namespace: appNs, Name: "older"}]
+			Expect(meta.IsStatusConditionTrue(older[0].Conditions, v1alpha2.RouteConditionAccepted)).To(BeTrue())
+			newer := reports.TCPRoutes[types.NamespacedName{Namespace: appNs, Name: "newer"}]
+			Expect(meta.FindStatusCondition(newer[0].Conditions, v1alpha2.RouteConditionAccepted).Reason).
+				To(Equal(v1alpha2.RouteReasonNotAllowedByListeners))
+		})
+	})
+
+	Context("route attachment", func() {
+
+		It("only attaches routes from the namespace of the gateway by default", func() {
+			gateway.Spec.Listeners[0].AllowedRoutes = nil
+			proxies, reports := translate()
+			Expect(virtualHosts(proxies[0])).To(BeEmpty())
+			accepted := routeCondition(reports, v1alpha2.RouteConditionAccepted)
+			Expect(accepted.Status).To(Equal(metav1.ConditionFalse))
+			Expect(accepted.Reason).To(Equal(v1alpha2.RouteReasonNotAllowedByListeners))
+		})
+
+		It("attaches routes from the namespaces that the listener selects", func() {
+			from := v1alpha2.NamespacesFromSelector
+			gateway.Spec.Listeners[0].AllowedRoutes.Namespaces = &v1alpha2.RouteNamespaces{
+				From:     &from,
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "app"}},
+			}
+			_, reports := translate()
+			Expect(routeCondition(reports, v1alpha2.RouteConditionAccepted).Status).To(Equal(metav1.ConditionTrue))
+
+			gateway.Spec.Listeners[0].AllowedRoutes.Namespaces.Selector.MatchLabels["team"] = "other"
+			_, reports = translate()
+			Expect(routeCondition(reports, v1alpha2.RouteConditionAccepted).Status).To(Equal(metav1.ConditionFalse))
+		})
+
+		It("reports routes for a missing section of the gateway", func() {
+			route.Spec.ParentRefs[0].SectionName = strPtr("missing")
+			_, reports := translate()
+			Expect(routeCondition(reports, v1alpha2.RouteConditionAccepted).Reason).To(Equal(v1alpha2.RouteReasonNoMatchingParent))
+		})
+
+		It("intersects the hostnames of the route with the hostname of the listener", func() {
+			gateway.Spec.Listeners[0].Hostname = strPtr("*.example.com")
+			route.Spec.Hostnames = []string{"foo.example.com", "example.org"}
+			proxies, _ := translate()
+			Expect(virtualHosts(proxies[0])).To(HaveLen(1))
+			Expect(virtualHosts(proxies[0])[0].GetDomains()).To(Equal([]string{"foo.example.com"}))
+
+			route.Spec.Hostnames = nil
+			proxies, _ = translate()
+			Expect(virtualHosts(proxies[0])[0].GetDomains()).To(Equal([]string{"*.example.com"}))
+			Expect(virtualHosts(proxies[0])[0].GetName()).To(Equal("wildcard.example.com"))
+
+			route.Spec.Hostnames = []string{"example.org"}
+			proxies, reports := translate()
+			Expect(virtualHosts(proxies[0])).To(BeEmpty())
+			Expect(routeCondition(reports, v1alpha2.RouteConditionAccepted).Reason).To(Equal(v1alpha2.RouteReasonNoMatchingListenerHostname))
+		})
+
+		It("routes the requests for a host with the routes of the most specific listener", func() {
+			gateway.Spec.Listeners = append(gateway.Spec.Listeners, v1alpha2.Listener{
+				Name:          "foo",
+				Hostname:      strPtr("foo.example.com"),
+				Port:          80,
+				Protocol:      v1alpha2.HTTPProtocolType,
+				AllowedRoutes: gateway.Spec.Listeners[0].AllowedRoutes,
+			})
+			route.Spec.Hostnames = nil
+			route.Spec.ParentRefs[0].SectionName = strPtr("http")
+			fooRoute := &v1alpha2.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: appNs},
+				Spec: v1alpha2.HTTPRouteSpec{
+					CommonRouteSpec: v1alpha2.CommonRouteSpec{
+						ParentRefs: []v1alpha2.ParentReference{{Name: "gw", Namespace: strPtr(gatewayNs), SectionName: strPtr("foo")}},
+					},
+					Rules: route.Spec.Rules,
+				},
+			}
+			snap.HTTPRoutes = append(snap.HTTPRoutes, fooRoute)
+
+			proxies, reports := translate()
+			Expect(virtualHosts(proxies[0])).To(HaveLen(2))
+			anyHost, fooHost := virtualHosts(proxies[0])[0], virtualHosts(proxies[0])[1]
+			Expect(anyHost.GetDomains()).To(Equal([]string{"*"}))
+			Expect(anyHost.GetRoutes()).To(HaveLen(1))
+			Expect(anyHost.GetRoutes()[0].GetName()).To(Equal("app-route-rule-0-match-0"))
+			Expect(fooHost.GetDomains()).To(Equal([]string{"foo.example.com"}))
+			Expect(fooHost.GetRoutes()).To(HaveLen(1))
+			Expect(fooHost.GetRoutes()[0].GetName()).To(Equal("app-foo-rule-0-match-0"))
+			Expect(gatewayStatus(reports).Listeners[0].AttachedRoutes).To(Equal(int32(1)))
+			Expect(gatewayStatus(reports).Listeners[1].AttachedRoutes).To(Equal(int32(1)))
+		})
+	})
+
+	Context("backends", func() {
+
+		It("splits the traffic between the backends by their weights", func() {
+			snap.Services = append(snap.Services, service("canary", appNs, 8080))
+			stable, canary := backendRef("svc", nil, 8080), backendRef("canary", nil, 8080)
+			stable.Weight, canary.Weight = int32Ptr(9), int32Ptr(1)
+			route.Spec.Rules[0].BackendRefs = []v1alpha2.HTTPBackendRef{stable, canary}
+
+			proxies, _ := translate()
+			Expect(virtualHosts(proxies[0])[0].GetRoutes()[0].GetRouteAction()).To(MatchProto(&gloov1.RouteAction{
+				Destination: &gloov1.RouteAction_Multi{
+					Multi: &gloov1.MultiDestination{
+						Destinations: []*gloov1.WeightedDestination{
+							{Destination: kubeDestination("svc", appNs, 8080), Weight: 9},
+							{Destination: kubeDestination("canary", appNs, 8080), Weight: 1},
+						},
+					},
+				},
+			}))
+		})
+
+		It("responds with a 500 when no backend can be resolved", func() {
+			route.Spec.Rules[0].BackendRefs = []v1alpha2.HTTPBackendRef{backendRef("missing", nil, 8080)}
+			proxies, reports := translate()
+			Expect(virtualHosts(proxies[0])[0].GetRoutes()[0].GetDirectResponseAction().GetStatus()).To(Equal(uint32(http.StatusInternalServerError)))
+			resolvedRefs := routeCondition(reports, v1alpha2.RouteConditionResolvedRefs)
+			Expect(resolvedRefs.Status).To(Equal(metav1.ConditionFalse))
+			Expect(resolvedRefs.Reason).To(Equal(v1alpha2.RouteReasonBackendNotFound))
+			// the route is still accepted by the gateway
+			Expect(routeCondition(reports, v1alpha2.RouteConditionAccepted).Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("requires a reference grant for backends in other namespaces", func() {
+			snap.Services = append(snap.Services, service("shared", "shared", 80))
+			route.Spec.Rules[0].BackendRefs = []v1alpha2.HTTPBackendRef{backendRef("shared", strPtr("shared"), 80)}
+			_, reports := translate()
+			Expect(routeCondition(reports, v1alpha2.RouteConditionResolvedRefs).Reason).To(Equal(v1alpha2.RouteReasonRefNotPermitted))
+
+			snap.ReferenceGrants = []*v1alpha2.ReferenceGrant{{
+				ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "shared"},
+				Spec: v1alpha2.ReferenceGrantSpec{
+					From: []v1alpha2.ReferenceGrantFrom{{Group: v1alpha2.GroupName, Kind: v1alpha2.HTTPRouteKind, Namespace: appNs}},
+					To:   []v1alpha2.ReferenceGrantTo{{Kind: "Service", Name: strPtr("shared")}},
+				},
+			}}
+			proxies, reports := translate()
+			Expect(routeCondition(reports, v1alpha2.RouteConditionResolvedRefs).Status).To(Equal(metav1.ConditionTrue))
+			Expect(virtualHosts(proxies[0])[0].GetRoutes()[0].GetRouteAction().GetSingle()).To(MatchProto(kubeDestination("shared", "shared", 80)))
+		})
+	})
+
+	Context("matches and filters", func() {
+
+		It("translates path, header, query parameter and method matches", func() {
+			exact, regex := v1alpha2.PathMatchExact, v1alpha2.MatchRegularExpression
+			route.Spec.Rules[0].Matches = []v1alpha2.HTTPRouteMatch{
+				{
+					Path:   &v1alpha2.HTTPPathMatch{Value: strPtr("/api")},
+					Method: strPtr("GET"),
+				},
+				{
+					Path:        &v1alpha2.HTTPPathMatch{Type: &exact, Value: strPtr("/healthz")},
+					Headers:     []v1alpha2.HTTPHeaderMatch{{Name: "x-version", Value: "v[12]", Type: &regex}},
+					QueryParams: []v1alpha2.HTTPQueryParamMatch{{Name: "debug", Value: "true"}},
+				},
+			}
+			proxies, _ := translate()
+			routes := virtualHosts(proxies[0])[0].GetRoutes()
+			Expect(routes).To(HaveLen(2))
+			// exact paths take precedence
+			Expect(routes[0].GetMatchers()).To(ConsistOf(MatchProto(&matchers.Matcher{
+				PathSpecifier:   &matchers.Matcher_Exact{Exact: "/healthz"},
+				Headers:         []*matchers.HeaderMatcher{{Name: "x-version", Value: "v[12]", Regex: true}},
+				QueryParameters: []*matchers.QueryParameterMatcher{{Name: "debug", Value: "true"}},
+			})))
+			// path prefixes match whole path elements
+			Expect(routes[1].GetMatchers()).To(ConsistOf(
+				MatchProto(&matchers.Matcher{PathSpecifier: &matchers.Matcher_Exact{Exact: "/api"}, Methods: []string{"GET"}}),
+				MatchProto(&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/api/"}, Methods: []string{"GET"}}),
+			))
+		})
+
+		It("orders the routes of a host by the precedence of their matches", func() {
+			now := time.Now()
+			newRoute := func(name string, created time.Time, path string) *v1alpha2.HTTPRoute {
+				r := &v1alpha2.HTTPRoute{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: appNs, CreationTimestamp: metav1.NewTime(created)},
+					Spec:       route.Spec,
+				}
+				r.Spec.Rules = []v1alpha2.HTTPRouteRule{{
+					Matches:     []v1alpha2.HTTPRouteMatch{{Path: &v1alpha2.HTTPPathMatch{Value: strPtr(path)}}},
+					BackendRefs: route.Spec.Rules[0].BackendRefs,
+				}}
+				return r
+			}
+			snap.HTTPRoutes = []*v1alpha2.HTTPRoute{
+				newRoute("short", now.Add(-time.Hour), "/"),
+				newRoute("newer", now, "/api/"),
+				newRoute("older", now.Add(-time.Minute), "/api/"),
+			}
+			proxies, _ := translate()
+			var names []string
+			for _, r := range virtualHosts(proxies[0])[0].GetRoutes() {
+				names = append(names, r.GetName())
+			}
+			Expect(names).To(Equal([]string{"app-older-rule-0-match-0", "app-newer-rule-0-match-0", "app-short-rule-0-match-0"}))
+		})
+
+		It("translates header modifiers and url rewrites", func() {
+			route.Spec.Rules[0].Filters = []v1alpha2.HTTPRouteFilter{
+				{
+					Type: v1alpha2.HTTPRouteFilterRequestHeaderModifier,
+					RequestHeaderModifier: &v1alpha2.HTTPRequestHeaderFilter{
+						Set:    []v1alpha2.HTTPHeader{{Name: "x-set", Value: "a"}},
+						Add:    []v1alpha2.HTTPHeader{{Name: "x-add", Value: "b"}},
+						Remove: []string{"x-remove"},
+					},
+				},
+				{
+					Type: v1alpha2.HTTPRouteFilterURLRewrite,
+					URLRewrite: &v1alpha2.HTTPURLRewriteFilter{
+						Hostname: strPtr("internal.example.com"),
+						Path:     &v1alpha2.HTTPPathModifier{Type: v1alpha2.PrefixMatchHTTPPathModifier, ReplacePrefixMatch: strPtr("/v2")},
+					},
+				},
+			}
+			proxies, _ := translate()
+			Expect(virtualHosts(proxies[0])[0].GetRoutes()[0].GetOptions()).To(MatchProto(&gloov1.RouteOptions{
+				HeaderManipulation: &headers.HeaderManipulation{
+					RequestHeadersToAdd: []*envoycore.HeaderValueOption{
+						{
+							HeaderOption: &envoycore.HeaderValueOption_Header{Header: &envoycore.HeaderValue{Key: "x-set", Value: "a"}},
+							Append:       &wrappers.BoolValue{Value: false},
+						},
+						{
+							HeaderOption: &envoycore.HeaderValueOption_Header{Header: &envoycore.HeaderValue{Key: "x-add", Value: "b"}},
+							Append:       &wrappers.BoolValue{Value: true},
+						},
+					},
+					RequestHeadersToRemove: []string{"x-remove"},
+				},
+				HostRewriteType: &gloov1.RouteOptions_HostRewrite{HostRewrite: "internal.example.com"},
+				PrefixRewrite:   &wrappers.StringValue{Value: "/v2/"},
+			}))
+		})
+
+		It("rewrites the path prefixes by path element", func() {
+			route.Spec.Rules[0].Matches = []v1alpha2.HTTPRouteMatch{{Path: &v1alpha2.HTTPPathMatch{Value: strPtr("/api")}}}
+			route.Spec.Rules[0].Filters = []v1alpha2.HTTPRouteFilter{{
+				Type: v1alpha2.HTTPRouteFilterURLRewrite,
+				URLRewrite: &v1alpha2.HTTPURLRewriteFilter{
+					Path: &v1alpha2.HTTPPathModifier{Type: v1alpha2.PrefixMatchHTTPPathModifier, ReplacePrefixMatch: strPtr("/v2")},
+				},
+			}}
+			proxies, _ := translate()
+			routes := virtualHosts(proxies[0])[0].GetRoutes()
+			Expect(routes).To(HaveLen(2))
+			Expect(routes[0].GetName()).To(Equal("app-route-rule-0-match-0"))
+			Expect(routes[0].GetMatchers()).To(ConsistOf(MatchProto(&matchers.Matcher{PathSpecifier: &matchers.Matcher_Exact{Exact: "/api"}})))
+			Expect(routes[0].GetOptions().GetPrefixRewrite().GetValue()).To(Equal("/v2"))
+			Expect(routes[1].GetName()).To(Equal("app-route-rule-0-match-0-1"))
+			Expect(routes[1].GetMatchers()).To(ConsistOf(MatchProto(&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/api/"}})))
+			Expect(routes[1].GetOptions().GetPrefixRewrite().GetValue()).To(Equal("/v2/"))
+		})
+
+		It("translates redirects", func() {
+			statusCode := http.StatusMovedPermanently
+			route.Spec.Rules[0].Filters = []v1alpha2.HTTPRouteFilter{{
+				Type: v1alpha2.HTTPRouteFilterRequestRedirect,
+				RequestRedirect: &v1alpha2.HTTPRequestRedirectFilter{
+					Scheme:     strPtr("https"),
+					Hostname:   strPtr("secure.example.com"),
+					StatusCode: &statusCode,
+				},
+			}}
+			proxies, _ := translate()
+			Expect(virtualHosts(proxies[0])[0].GetRoutes()[0].GetRedirectAction()).To(MatchProto(&gloov1.RedirectAction{
+				HostRedirect:  "secure.example.com",
+				HttpsRedirect: true,
+				ResponseCode:  gloov1.RedirectAction_MOVED_PERMANENTLY,
+			}))
+		})
+
+		It("redirects the path prefixes by path element", func() {
+			route.Spec.Rules[0].Matches = []v1alpha2.HTTPRouteMatch{{Path: &v1alpha2.HTTPPathMatch{Value: strPtr("/api")}}}
+			route.Spec.Rules[0].Filters = []v1alpha2.HTTPRouteFilter{{
+				Type: v1alpha2.HTTPRouteFilterRequestRedirect,
+				RequestRedirect: &v1alpha2.HTTPRequestRedirectFilter{
+					Path: &v1alpha2.HTTPPathModifier{Type: v1alpha2.PrefixMatchHTTPPathModifier, ReplacePrefixMatch: strPtr("/v2")},
+				},
+			}}
+			proxies, _ := translate()
+			routes := virtualHosts(proxies[0])[0].GetRoutes()
+			Expect(routes).To(HaveLen(2))
+			Expect(routes[0].GetMatchers()).To(ConsistOf(MatchProto(&matchers.Matcher{PathSpecifier: &matchers.Matcher_Exact{Exact: "/api"}})))
+			Expect(routes[0].GetRedirectAction().GetPrefixRewrite()).To(Equal("/v2"))
+			Expect(routes[1].GetMatchers()).To(ConsistOf(MatchProto(&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/api/"}})))
+			Expect(routes[1].GetRedirectAction().GetPrefixRewrite()).To(Equal("/v2/"))
+		})
+
+		It("responds with a 500 for filters that are not supported", func() {
+			route.Spec.Rules[0].Filters = []v1alpha2.HTTPRouteFilter{{
+				Type:         v1alpha2.HTTPRouteFilterExtensionRef,
+				ExtensionRef: &v1alpha2.LocalObjectReference{Group: "example.com", Kind: "Custom", Name: "custom"},
+			}}
+			proxies, reports := translate()
+			Expect(virtualHosts(proxies[0])[0].GetRoutes()[0].GetDirectResponseAction().GetStatus()).To(Equal(uint32(http.StatusInternalServerError)))
+			Expect(routeCondition(reports, v1alpha2.RouteConditionResolvedRefs).Reason).To(Equal(v1alpha2.RouteReasonInvalidKind))
+		})
+	})
+})
@@ -22,6 +22,7 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	rlopts "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/cliutils"
 	"github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
@@ -170,6 +171,14 @@ func CheckResources(opts *options.Options) error {
 		multiErr = multierror.Append(multiErr, err)
 	}
 
+	includeExpiringCerts := doesNotContain(opts.Top.CheckName, "expiring-certs")
+	if includeExpiringCerts {
+		err := checkExpiringCertificates(opts, namespaces, settings)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+	}
+
 	includeGateway := doesNotContain(opts.Top.CheckName, "gateways")
 	if includeGateway {
 		err := checkGateways(opts, namespaces)
@@ -814,6 +823,56 @@ func checkSecrets(opts *options.Options, namespaces []string) error {
 	return nil
 }
 
+func checkExpiringCertificates(opts *options.Options, namespaces []string, settings *v1.Settings) error {
+	printer.AppendCheck("Checking expiring certificates... ")
+	var multiErr *multierror.Error
+
+	var secretRefs []*core.ResourceRef
+	knownSecretRefs := map[string]bool{}
+	addSecretRef := func(ref *core.ResourceRef) {
+		if ref != nil && !knownSecretRefs[ref.Key()] {
+			knownSecretRefs[ref.Key()] = true
+			secretRefs = append(secretRefs, ref)
+		}
+	}
+	for _, ns := range namespaces {
+		virtualServices, err := helpers.MustNamespacedVirtualServiceClient(opts.Top.Ctx, ns).List(ns, clients.ListOpts{})
+		if err != nil {
+			return err
+		}
+		for _, virtualService := range virtualServices {
+			addSecretRef(virtualService.GetSslConfig().GetSecretRef())
+		}
+		upstreams, err := helpers.MustNamespacedUpstreamClient(opts.Top.Ctx, ns).List(ns, clients.ListOpts{})
+		if err != nil {
+			return err
+		}
+		for _, upstream := range upstreams {
+			addSecretRef(upstream.GetSslConfig().GetSecretRef())
+		}
+	}
+
+	client := helpers.MustSecretClientWithOptions(opts.Top.Ctx, 5*time.Second, namespaces)
+	window := utils.CertificateExpiryWarningWindow(settings)
+	now := time.Now()
+	for _, ref := range secretRefs {
+		secret, err := client.Read(ref.GetNamespace(), ref.GetName(), clients.ReadOpts{Ctx: opts.Top.Ctx})
+		if err != nil {
+			// missing secrets are reported on the virtual services and upstreams referencing them
+			continue
+		}
+		if warning := utils.CertificateExpiryWarning(secret, window, now); warning != "" {
+			multiErr = multierror.Append(multiErr, errors.New(warning))
+		}
+	}
+	if multiErr != nil {
+		printer.AppendStatus("expiring certificates", fmt.Sprintf("%v Errors!", multiErr.Len()))
+		return multiErr
+	}
+	printer.AppendStatus("expiring certificates", fmt.Sprintf("OK"))
+	return nil
+}
+
 func renderMetadata(metadata *core.Metadata) string {
 	return renderNamespaceName(metadata.GetNamespace(), metadata.GetName())
 }
@@ -0,0 +1,125 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/admin"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+)
+
+const glooDeployment = "gloo"
+
+func historyCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "list the revisions of the Envoy config served to the proxy kept by Gloo",
+		Long: "list the latest revisions of the Envoy config served by the Gloo xDS server to the proxy, along with the " +
+			"changes to resources that triggered them. The number of revisions kept is set with settings.gloo.xdsSnapshotHistorySize.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := admin.HistoryPath + "/" + url.PathEscape(proxySnapshotKey(opts))
+			if !opts.Top.Output.IsTable() {
+				return printGlooAdminApi(opts, path, url.Values{})
+			}
+			var entries []*admin.SnapshotHistoryEntry
+			if err := getGlooAdminApi(opts, path, url.Values{}, &entries); err != nil {
+				return err
+			}
+			printers.SnapshotHistoryTable(entries, os.Stdout)
+			return nil
+		},
+	}
+	flagutils.AddOutputFlag(cmd.PersistentFlags(), &opts.Top.Output)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func diffCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "compare the clusters, routes and listeners of two revisions of the Envoy config served to the proxy",
+		Long: "compare the clusters, routes and listeners of two revisions of the Envoy config served by the Gloo xDS server " +
+			"to the proxy, as listed by glooctl proxy history. By default, the latest revision is compared to the previous one.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := admin.HistoryPath + "/" + url.PathEscape(proxySnapshotKey(opts)) + "/diff"
+			query := url.Values{}
+			if cmd.Flags().Changed("from") {
+				query.Set(admin.FromParam, strconv.FormatUint(opts.Proxy.DiffFrom, 10))
+			}
+			if cmd.Flags().Changed("to") {
+				query.Set(admin.ToParam, strconv.FormatUint(opts.Proxy.DiffTo, 10))
+			}
+			if !opts.Top.Output.IsTable() {
+				return printGlooAdminApi(opts, path, query)
+			}
+			var diff admin.SnapshotRevisionDiff
+			if err := getGlooAdminApi(opts, path, query, &diff); err != nil {
+				return err
+			}
+			printers.PrintSnapshotRevisionDiff(&diff, os.Stdout)
+			return nil
+		},
+	}
+	pflags := cmd.PersistentFlags()
+	pflags.Uint64Var(&opts.Proxy.DiffFrom, "from", 0, "the revision to compare from, 0 being an empty config. Defaults to the revision preceding --to")
+	pflags.Uint64Var(&opts.Proxy.DiffTo, "to", 0, "the revision to compare to. Defaults to the latest revision")
+	flagutils.AddOutputFlag(pflags, &opts.Top.Output)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+// the key of the xDS snapshot of the proxy, which shares its name and namespace with its deployment
+func proxySnapshotKey(opts *options.Options) string {
+	return fmt.Sprintf("%v~%v", opts.Metadata.GetNamespace(), opts.Proxy.Name)
+}
+
+// printGlooAdminApi prints the response of the admin API as YAML or JSON, depending on the output type
+func printGlooAdminApi(opts *options.Options, path string, query url.Values) error {
+	if !opts.Top.Output.IsJSON() {
+		query.Set(admin.FormatParam, "yaml")
+	}
+	out, err := glooAdminApiGet(opts, path, query)
+	if err != nil {
+		return err
+	}
+	fmt.Println(out)
+	return nil
+}
+
+func getGlooAdminApi(opts *options.Options, path string, query url.Values, out interface{}) error {
+	response, err := glooAdminApiGet(opts, path, query)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(response), out)
+}
+
+// glooAdminApiGet port-forwards the gloo deployment to make a GET request to its admin API
+func glooAdminApiGet(opts *options.Options, path string, query url.Values) (string, error) {
+	freePort, err := cliutil.GetFreePort()
+	if err != nil {
+		return "", err
+	}
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	out, portFwdCmd, err := cliutil.PortForwardGet(opts.Top.Ctx, opts.Metadata.GetNamespace(), "deployment/"+glooDeployment,
+		strconv.Itoa(freePort), strconv.Itoa(defaults.GlooAdminApiPort), opts.Top.Verbose, path)
+	if err != nil {
+		return "", err
+	}
+	if portFwdCmd.Process != nil {
+		defer portFwdCmd.Process.Release()
+		defer portFwdCmd.Process.Kill()
+	}
+	return out, nil
+}
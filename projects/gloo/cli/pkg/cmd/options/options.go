@@ -92,6 +92,8 @@ type Proxy struct {
 	Port             string
 	FollowLogs       bool
 	DebugLogs        bool
+	DiffFrom         uint64
+	DiffTo           uint64
 }
 
 type Upgrade struct {
@@ -23,5 +23,5 @@ func AddNamespaceFlag(set *pflag.FlagSet, strptr *string) {
 }
 
 func AddExcludecheckFlag(set *pflag.FlagSet, strarrptr *[]string) {
-	set.StringSliceVarP(strarrptr, "exclude", "x", []string{}, "check to exclude: (pods, upstreamgroup, secrets, gateways, proxies)")
+	set.StringSliceVarP(strarrptr, "exclude", "x", []string{}, "check to exclude: (pods, upstreamgroup, secrets, expiring-certs, gateways, proxies)")
 }
@@ -0,0 +1,132 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/solo-io/gloo/projects/gloo/pkg/admin"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+)
+
+// the xDS resource types, in the order they are printed
+var xdsResourceTypes = []struct {
+	typeURL string
+	name    string
+}{
+	{resource.ClusterTypeV3, "clusters"},
+	{resource.EndpointTypeV3, "endpoints"},
+	{resource.RouteTypeV3, "routes"},
+	{resource.ListenerTypeV3, "listeners"},
+}
+
+// SnapshotHistoryTable prints the revisions of the xDS snapshot of a proxy using tables to io.Writer
+func SnapshotHistoryTable(entries []*admin.SnapshotHistoryEntry, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Revision", "Time", "Changed xDS Resources", "Triggered By"})
+
+	for _, entry := range entries {
+		changes := xdsChanges(entry.Changes)
+		var triggers []string
+		for _, change := range entry.TriggeredBy {
+			triggers = append(triggers, fmt.Sprintf("%v %v %v.%v", change.Change, change.Kind, change.Namespace, change.Name))
+		}
+		rows := len(changes)
+		if len(triggers) > rows {
+			rows = len(triggers)
+		}
+		if rows == 0 {
+			rows = 1
+		}
+		for i := 0; i < rows; i++ {
+			row := []string{"", "", lineAt(changes, i), lineAt(triggers, i)}
+			if i == 0 {
+				row[0] = strconv.FormatUint(entry.Revision, 10)
+				row[1] = entry.Time.Format(time.RFC3339)
+			}
+			table.Append(row)
+		}
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+// PrintSnapshotRevisionDiff prints the differences between two revisions of the xDS snapshot of a proxy to io.Writer
+func PrintSnapshotRevisionDiff(diff *admin.SnapshotRevisionDiff, w io.Writer) {
+	fmt.Fprintf(w, "%v: revision %v -> %v\n", diff.Key, diff.From, diff.To)
+	if len(diff.Resources) == 0 {
+		fmt.Fprintln(w, "no differences in clusters, routes or listeners")
+		return
+	}
+	for _, resourceType := range xdsResourceTypes {
+		resourceDiffs, ok := diff.Resources[resourceType.name]
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(w, "\n%v:\n", resourceType.name)
+		for _, resourceDiff := range resourceDiffs {
+			fmt.Fprintf(w, "  %v %v\n", changeSymbol(resourceDiff.Change), resourceDiff.Name)
+			for _, field := range resourceDiff.Fields {
+				fmt.Fprintf(w, "      %v: %v -> %v\n", field.Path, fieldValue(field.From), fieldValue(field.To))
+			}
+		}
+	}
+}
+
+// xdsChanges summarizes the changes to each type of xDS resources, such as `clusters: +1 -2 ~3`
+func xdsChanges(diff xds.SnapshotDiff) []string {
+	var changes []string
+	for _, resourceType := range xdsResourceTypes {
+		resourcesDiff, ok := diff[resourceType.typeURL]
+		if !ok {
+			continue
+		}
+		var counts []string
+		if n := len(resourcesDiff.Added); n > 0 {
+			counts = append(counts, fmt.Sprintf("+%d", n))
+		}
+		if n := len(resourcesDiff.Removed); n > 0 {
+			counts = append(counts, fmt.Sprintf("-%d", n))
+		}
+		if n := len(resourcesDiff.Modified); n > 0 {
+			counts = append(counts, fmt.Sprintf("~%d", n))
+		}
+		changes = append(changes, fmt.Sprintf("%v: %v", resourceType.name, strings.Join(counts, " ")))
+	}
+	return changes
+}
+
+func changeSymbol(change string) string {
+	switch change {
+	case admin.ResourceAdded:
+		return "+"
+	case admin.ResourceRemoved:
+		return "-"
+	default:
+		return "~"
+	}
+}
+
+func fieldValue(value interface{}) string {
+	if value == nil {
+		return "<unset>"
+	}
+	out, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(out)
+}
+
+func lineAt(lines []string, i int) string {
+	if i < len(lines) {
+		return lines[i]
+	}
+	return ""
+}
@@ -0,0 +1,37 @@
+package printers
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/admin"
+)
+
+var _ = Describe("PrintSnapshotRevisionDiff", func() {
+	It("prints the changed fields of modified resources", func() {
+		out := &bytes.Buffer{}
+		PrintSnapshotRevisionDiff(&admin.SnapshotRevisionDiff{
+			Key:  "gloo-system~gateway-proxy",
+			From: 1,
+			To:   2,
+			Resources: map[string][]*admin.ResourceDiff{
+				"clusters": {
+					{Name: "added", Change: admin.ResourceAdded},
+					{Name: "modified", Change: admin.ResourceModified, Fields: []*admin.FieldDiff{
+						{Path: "connectTimeout", From: "1s", To: "2s"},
+						{Path: "lbPolicy", To: "RANDOM"},
+					}},
+				},
+			},
+		}, out)
+		Expect(out.String()).To(Equal(`gloo-system~gateway-proxy: revision 1 -> 2
+
+clusters:
+  + added
+  ~ modified
+      connectTimeout: "1s" -> "2s"
+      lbPolicy: <unset> -> "RANDOM"
+`))
+	})
+})
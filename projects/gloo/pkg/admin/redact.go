@@ -0,0 +1,75 @@
+package admin
+
+import (
+	"github.com/cncf/xds/go/udpa/annotations"
+	"github.com/golang/protobuf/proto"
+	proto2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// redactSensitive returns a copy of the message without the fields envoy considers sensitive (such as private keys),
+// the same way the envoy admin config dump does.
+func redactSensitive(msg proto.Message) proto.Message {
+	redacted := proto.Clone(msg)
+	redactMessage(proto.MessageReflect(redacted))
+	return redacted
+}
+
+func redactMessage(msg protoreflect.Message) {
+	if anyMsg, ok := msg.Interface().(*anypb.Any); ok {
+		redactAny(anyMsg)
+		return
+	}
+
+	var sensitive []protoreflect.FieldDescriptor
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if isSensitive(field) {
+			sensitive = append(sensitive, field)
+			return true
+		}
+		switch {
+		case field.IsList():
+			if field.Message() == nil {
+				return true
+			}
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(list.Get(i).Message())
+			}
+		case field.IsMap():
+			if field.MapValue().Message() == nil {
+				return true
+			}
+			value.Map().Range(func(_ protoreflect.MapKey, mapValue protoreflect.Value) bool {
+				redactMessage(mapValue.Message())
+				return true
+			})
+		case field.Message() != nil:
+			redactMessage(value.Message())
+		}
+		return true
+	})
+	for _, field := range sensitive {
+		msg.Clear(field)
+	}
+}
+
+// the typed configs of envoy resources are packed in Any messages, which are redacted if their type is known
+func redactAny(anyMsg *anypb.Any) {
+	inner, err := anyMsg.UnmarshalNew()
+	if err != nil {
+		return
+	}
+	redactMessage(inner.ProtoReflect())
+	value, err := proto2.MarshalOptions{Deterministic: true}.Marshal(inner)
+	if err != nil {
+		return
+	}
+	anyMsg.Value = value
+}
+
+func isSensitive(field protoreflect.FieldDescriptor) bool {
+	sensitive, _ := proto2.GetExtension(field.Options(), annotations.E_Sensitive).(bool)
+	return sensitive
+}
@@ -0,0 +1,258 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// the resource types compared field by field, endpoints changing too often for their diff to be of interest
+var diffedTypeURLs = []string{
+	resource.ClusterTypeV3,
+	resource.RouteTypeV3,
+	resource.ListenerTypeV3,
+}
+
+// SnapshotRevisionDiff lists the differences between two revisions of the xDS snapshot of a proxy.
+type SnapshotRevisionDiff struct {
+	Key  string `json:"key"`
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+	// The resources that differ, by type (clusters, routes and listeners)
+	Resources map[string][]*ResourceDiff `json:"resources"`
+}
+
+// ResourceDiff is the difference between two versions of an xDS resource.
+type ResourceDiff struct {
+	Name string `json:"name"`
+	// Either added, removed or modified
+	Change string `json:"change"`
+	// The fields that differ, if the resource was modified
+	Fields []*FieldDiff `json:"fields,omitempty"`
+}
+
+// FieldDiff is the difference between two values of a field of an xDS resource.
+// The fields envoy considers sensitive are not compared.
+type FieldDiff struct {
+	// The path to the field, such as `filterChains[0].filters[0].typedConfig.statPrefix`,
+	// where the messages packed in Any fields are compared as if they were inlined
+	Path string `json:"path"`
+	// The values of the field, as JSON. Nil if the field is not set.
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+func diffRevisions(key string, fromRevision uint64, from envoycache.Snapshot, toRevision uint64, to envoycache.Snapshot) *SnapshotRevisionDiff {
+	out := &SnapshotRevisionDiff{
+		Key:       key,
+		From:      fromRevision,
+		To:        toRevision,
+		Resources: map[string][]*ResourceDiff{},
+	}
+	snapshotDiff := xds.DiffSnapshots(from, to)
+	for _, typeURL := range diffedTypeURLs {
+		resourcesDiff, ok := snapshotDiff[typeURL]
+		if !ok {
+			continue
+		}
+		var diffs []*ResourceDiff
+		for _, name := range resourcesDiff.Added {
+			diffs = append(diffs, &ResourceDiff{Name: name, Change: ResourceAdded})
+		}
+		for _, name := range resourcesDiff.Removed {
+			diffs = append(diffs, &ResourceDiff{Name: name, Change: ResourceRemoved})
+		}
+		for _, name := range resourcesDiff.Modified {
+			diffs = append(diffs, &ResourceDiff{
+				Name:   name,
+				Change: ResourceModified,
+				Fields: DiffResources(
+					from.GetResources(typeURL).Items[name].ResourceProto(),
+					to.GetResources(typeURL).Items[name].ResourceProto(),
+				),
+			})
+		}
+		sort.SliceStable(diffs, func(i, j int) bool {
+			return diffs[i].Name < diffs[j].Name
+		})
+		out.Resources[xdsResourceNames[typeURL]] = diffs
+	}
+	return out
+}
+
+// DiffResources returns the fields that differ between two versions of an xDS resource.
+func DiffResources(from, to proto.Message) []*FieldDiff {
+	var diffs []*FieldDiff
+	diffMessages("", proto.MessageReflect(redactSensitive(from)), proto.MessageReflect(redactSensitive(to)), &diffs)
+	return diffs
+}
+
+func diffMessages(path string, from, to protoreflect.Message, diffs *[]*FieldDiff) {
+	fromAny, fromIsAny := from.Interface().(*anypb.Any)
+	toAny, toIsAny := to.Interface().(*anypb.Any)
+	if fromIsAny && toIsAny && fromAny.GetTypeUrl() == toAny.GetTypeUrl() {
+		fromInner, fromErr := fromAny.UnmarshalNew()
+		toInner, toErr := toAny.UnmarshalNew()
+		if fromErr == nil && toErr == nil {
+			diffMessages(path, fromInner.ProtoReflect(), toInner.ProtoReflect(), diffs)
+			return
+		}
+	}
+	if fromIsAny || isWellKnownType(from.Descriptor()) {
+		// compare the other well known types as a whole, such as durations and wrappers, as they are marshalled as scalars
+		if !proto.Equal(proto.MessageV1(from.Interface()), proto.MessageV1(to.Interface())) {
+			appendDiff(diffs, path, messageValue(from), messageValue(to))
+		}
+		return
+	}
+
+	fields := from.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		fieldPath := field.JSONName()
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+		fromHas, toHas := from.Has(field), to.Has(field)
+		switch {
+		case !fromHas && !toHas:
+			continue
+		case !toHas:
+			appendDiff(diffs, fieldPath, fieldValue(field, from.Get(field)), nil)
+		case !fromHas:
+			appendDiff(diffs, fieldPath, nil, fieldValue(field, to.Get(field)))
+		case field.IsList():
+			diffLists(fieldPath, field, from.Get(field).List(), to.Get(field).List(), diffs)
+		case field.IsMap():
+			diffMaps(fieldPath, field, from.Get(field).Map(), to.Get(field).Map(), diffs)
+		case field.Message() != nil:
+			diffMessages(fieldPath, from.Get(field).Message(), to.Get(field).Message(), diffs)
+		default:
+			if !scalarsEqual(from.Get(field), to.Get(field)) {
+				appendDiff(diffs, fieldPath, fieldValue(field, from.Get(field)), fieldValue(field, to.Get(field)))
+			}
+		}
+	}
+}
+
+func diffLists(path string, field protoreflect.FieldDescriptor, from, to protoreflect.List, diffs *[]*FieldDiff) {
+	for i := 0; i < from.Len() || i < to.Len(); i++ {
+		elementPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= to.Len():
+			appendDiff(diffs, elementPath, singularValue(field, from.Get(i)), nil)
+		case i >= from.Len():
+			appendDiff(diffs, elementPath, nil, singularValue(field, to.Get(i)))
+		case field.Message() != nil:
+			diffMessages(elementPath, from.Get(i).Message(), to.Get(i).Message(), diffs)
+		case !scalarsEqual(from.Get(i), to.Get(i)):
+			appendDiff(diffs, elementPath, singularValue(field, from.Get(i)), singularValue(field, to.Get(i)))
+		}
+	}
+}
+
+func diffMaps(path string, field protoreflect.FieldDescriptor, from, to protoreflect.Map, diffs *[]*FieldDiff) {
+	keys := map[string]protoreflect.MapKey{}
+	for _, m := range []protoreflect.Map{from, to} {
+		m.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+			keys[key.String()] = key
+			return true
+		})
+	}
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	valueField := field.MapValue()
+	for _, keyString := range sortedKeys {
+		key := keys[keyString]
+		entryPath := fmt.Sprintf("%s[%s]", path, keyString)
+		fromHas, toHas := from.Has(key), to.Has(key)
+		switch {
+		case !toHas:
+			appendDiff(diffs, entryPath, singularValue(valueField, from.Get(key)), nil)
+		case !fromHas:
+			appendDiff(diffs, entryPath, nil, singularValue(valueField, to.Get(key)))
+		case valueField.Message() != nil:
+			diffMessages(entryPath, from.Get(key).Message(), to.Get(key).Message(), diffs)
+		case !scalarsEqual(from.Get(key), to.Get(key)):
+			appendDiff(diffs, entryPath, singularValue(valueField, from.Get(key)), singularValue(valueField, to.Get(key)))
+		}
+	}
+}
+
+func appendDiff(diffs *[]*FieldDiff, path string, from, to interface{}) {
+	*diffs = append(*diffs, &FieldDiff{Path: path, From: from, To: to})
+}
+
+func scalarsEqual(a, b protoreflect.Value) bool {
+	if aBytes, ok := a.Interface().([]byte); ok {
+		bBytes, _ := b.Interface().([]byte)
+		return bytes.Equal(aBytes, bBytes)
+	}
+	return a.Interface() == b.Interface()
+}
+
+func isWellKnownType(descriptor protoreflect.MessageDescriptor) bool {
+	return strings.HasPrefix(string(descriptor.FullName()), "google.protobuf.")
+}
+
+// fieldValue returns the value of a field as it would be marshalled to JSON
+func fieldValue(field protoreflect.FieldDescriptor, value protoreflect.Value) interface{} {
+	switch {
+	case field.IsList():
+		list := value.List()
+		values := make([]interface{}, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			values = append(values, singularValue(field, list.Get(i)))
+		}
+		return values
+	case field.IsMap():
+		values := map[string]interface{}{}
+		value.Map().Range(func(key protoreflect.MapKey, mapValue protoreflect.Value) bool {
+			values[key.String()] = singularValue(field.MapValue(), mapValue)
+			return true
+		})
+		return values
+	default:
+		return singularValue(field, value)
+	}
+}
+
+func singularValue(field protoreflect.FieldDescriptor, value protoreflect.Value) interface{} {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageValue(value.Message())
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return value.Enum()
+	default:
+		return value.Interface()
+	}
+}
+
+func messageValue(msg protoreflect.Message) interface{} {
+	marshalled, err := (&jsonpb.Marshaler{}).MarshalToString(proto.MessageV1(msg.Interface()))
+	if err != nil {
+		return msg.Interface()
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(marshalled), &value); err != nil {
+		return marshalled
+	}
+	return value
+}
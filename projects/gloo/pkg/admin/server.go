@@ -0,0 +1,320 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/ghodss/yaml"
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/go-multierror"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+)
+
+const (
+	ApiSnapshotPath = "/snapshots/api"
+	XdsSnapshotPath = "/snapshots/xds"
+	ReportsPath     = "/reports"
+	NodesPath       = "/nodes"
+	HistoryPath     = "/snapshots/history"
+
+	// the query parameters to select the revisions to compare with the diff of the history of a proxy
+	FromParam = "from"
+	ToParam   = "to"
+
+	// the query parameter to select the format of the responses, either json (the default) or yaml
+	FormatParam = "format"
+)
+
+// the names of the xDS resource types in the responses
+var xdsResourceNames = map[string]string{
+	resource.ClusterTypeV3:  "clusters",
+	resource.EndpointTypeV3: "endpoints",
+	resource.RouteTypeV3:    "routes",
+	resource.ListenerTypeV3: "listeners",
+}
+
+// XdsSnapshot is the xDS snapshot served to a proxy.
+type XdsSnapshot struct {
+	Key string `json:"key"`
+	// The resources of the snapshot, by type (clusters, endpoints, routes and listeners)
+	Resources map[string]*XdsResources `json:"resources"`
+}
+
+type XdsResources struct {
+	Version string                   `json:"version"`
+	Items   []map[string]interface{} `json:"items"`
+}
+
+// Reports are the reports of the resources of the latest snapshot, along with the actions of the xDS sanitizers.
+type Reports struct {
+	Resources []*ResourceReport `json:"resources"`
+	// The actions of the xDS sanitizers, by xDS snapshot key
+	SanitizerActions map[string]*SanitizerActions `json:"sanitizerActions,omitempty"`
+}
+
+type ResourceReport struct {
+	Kind      string   `json:"kind"`
+	Namespace string   `json:"namespace"`
+	Name      string   `json:"name"`
+	Errors    []string `json:"errors,omitempty"`
+	Warnings  []string `json:"warnings,omitempty"`
+}
+
+// Server serves the state of gloo for debugging purposes, as JSON or YAML:
+//   - /snapshots/api: the latest snapshot of resources (the data of the secrets is omitted)
+//   - /snapshots/xds: the keys of the xDS snapshots
+//   - /snapshots/xds/{key}: the xDS snapshot of a proxy (the fields envoy considers sensitive are omitted)
+//   - /reports: the resource reports and the actions of the xDS sanitizers
+//   - /nodes: the envoy nodes connected to the xDS server
+//   - /snapshots/history: the keys of the proxies with an xDS snapshot history
+//   - /snapshots/history/{key}: the revisions of the xDS snapshot of a proxy kept in its history
+//   - /snapshots/history/{key}/diff?from={revision}&to={revision}: the differences between two revisions
+type Server struct {
+	xdsCache     envoycache.SnapshotCache
+	nodeTracker  *xds.NodeTracker
+	translations *TranslationState
+}
+
+func NewServer(xdsCache envoycache.SnapshotCache, nodeTracker *xds.NodeTracker, translations *TranslationState) *Server {
+	return &Server{
+		xdsCache:     xdsCache,
+		nodeTracker:  nodeTracker,
+		translations: translations,
+	}
+}
+
+func (s *Server) Handler() http.Handler {
+	r := mux.NewRouter()
+	r.HandleFunc(ApiSnapshotPath, s.apiSnapshot).Methods(http.MethodGet)
+	r.HandleFunc(XdsSnapshotPath, s.xdsSnapshotKeys).Methods(http.MethodGet)
+	r.HandleFunc(XdsSnapshotPath+"/{key}", s.xdsSnapshot).Methods(http.MethodGet)
+	r.HandleFunc(ReportsPath, s.reports).Methods(http.MethodGet)
+	r.HandleFunc(NodesPath, s.nodes).Methods(http.MethodGet)
+	r.HandleFunc(HistoryPath, s.historyKeys).Methods(http.MethodGet)
+	r.HandleFunc(HistoryPath+"/{key}", s.history).Methods(http.MethodGet)
+	r.HandleFunc(HistoryPath+"/{key}/diff", s.historyDiff).Methods(http.MethodGet)
+	return r
+}
+
+func (s *Server) apiSnapshot(w http.ResponseWriter, r *http.Request) {
+	snap := s.translations.Snapshot()
+	if snap == nil {
+		http.Error(w, "no snapshot has been synced yet", http.StatusServiceUnavailable)
+		return
+	}
+	out, err := marshalApiSnapshot(snap)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeResponse(w, r, out)
+}
+
+func (s *Server) xdsSnapshotKeys(w http.ResponseWriter, r *http.Request) {
+	keys := map[string]bool{}
+	if snap := s.translations.Snapshot(); snap != nil {
+		for _, proxy := range snap.Proxies {
+			keys[xds.SnapshotKey(proxy)] = true
+		}
+	}
+	// the keys of the nodes that are not served a proxy (such as the ext auth and rate limit servers)
+	for _, key := range s.xdsCache.GetStatusKeys() {
+		if _, err := s.xdsCache.GetSnapshot(key); err == nil {
+			keys[key] = true
+		}
+	}
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+	writeResponse(w, r, sortedKeys)
+}
+
+func (s *Server) xdsSnapshot(w http.ResponseWriter, r *http.Request) {
+	key := mux.Vars(r)["key"]
+	snap, err := s.xdsCache.GetSnapshot(key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	out := &XdsSnapshot{
+		Key:       key,
+		Resources: make(map[string]*XdsResources, len(xdsResourceNames)),
+	}
+	for typeURL, name := range xdsResourceNames {
+		resources := snap.GetResources(typeURL)
+		items := make([]map[string]interface{}, 0, len(resources.Items))
+		for _, resourceName := range sortedResourceNames(resources.Items) {
+			item, err := protoutils.MarshalMapFromProto(redactSensitive(resources.Items[resourceName].ResourceProto()))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			items = append(items, item)
+		}
+		out.Resources[name] = &XdsResources{
+			Version: resources.Version,
+			Items:   items,
+		}
+	}
+	writeResponse(w, r, out)
+}
+
+func (s *Server) reports(w http.ResponseWriter, r *http.Request) {
+	out := &Reports{
+		Resources:        []*ResourceReport{},
+		SanitizerActions: s.translations.SanitizerActions(),
+	}
+	for res, report := range s.translations.Reports() {
+		resourceReport := &ResourceReport{
+			Kind:      resources.Kind(res),
+			Namespace: res.GetMetadata().GetNamespace(),
+			Name:      res.GetMetadata().GetName(),
+			Warnings:  report.Warnings,
+		}
+		if report.Errors != nil {
+			if multiErr, ok := report.Errors.(*multierror.Error); ok {
+				for _, err := range multiErr.WrappedErrors() {
+					resourceReport.Errors = append(resourceReport.Errors, err.Error())
+				}
+			} else {
+				resourceReport.Errors = []string{report.Errors.Error()}
+			}
+		}
+		out.Resources = append(out.Resources, resourceReport)
+	}
+	sort.SliceStable(out.Resources, func(i, j int) bool {
+		a, b := out.Resources[i], out.Resources[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	writeResponse(w, r, out)
+}
+
+func (s *Server) nodes(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, r, s.nodeTracker.Nodes())
+}
+
+func (s *Server) historyKeys(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, r, s.translations.History().Keys())
+}
+
+func (s *Server) history(w http.ResponseWriter, r *http.Request) {
+	entries, err := s.translations.History().Entries(mux.Vars(r)["key"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	writeResponse(w, r, entries)
+}
+
+func (s *Server) historyDiff(w http.ResponseWriter, r *http.Request) {
+	var revisions []*uint64
+	for _, param := range []string{FromParam, ToParam} {
+		value := r.URL.Query().Get(param)
+		if value == "" {
+			revisions = append(revisions, nil)
+			continue
+		}
+		revision, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid %v revision %q", param, value), http.StatusBadRequest)
+			return
+		}
+		revisions = append(revisions, &revision)
+	}
+	diff, err := s.translations.History().Diff(mux.Vars(r)["key"], revisions[0], revisions[1])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	writeResponse(w, r, diff)
+}
+
+// marshalApiSnapshot returns the resources of the snapshot by type, without the data of the secrets
+func marshalApiSnapshot(snap *v1snap.ApiSnapshot) (map[string][]map[string]interface{}, error) {
+	secrets := make(v1.SecretList, 0, len(snap.Secrets))
+	for _, secret := range snap.Secrets {
+		secrets = append(secrets, &v1.Secret{Metadata: secret.GetMetadata()})
+	}
+
+	snapResources := apiSnapshotResources(snap)
+	snapResources["secrets"] = secrets.AsResources()
+
+	out := map[string][]map[string]interface{}{}
+	for name, list := range snapResources {
+		items := make([]map[string]interface{}, 0, len(list))
+		for _, res := range list {
+			item, err := protoutils.MarshalMap(res)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		out[name] = items
+	}
+	return out, nil
+}
+
+// apiSnapshotResources returns the resources of the snapshot by type
+func apiSnapshotResources(snap *v1snap.ApiSnapshot) map[string]resources.ResourceList {
+	return map[string]resources.ResourceList{
+		"artifacts":          snap.Artifacts.AsResources(),
+		"endpoints":          snap.Endpoints.AsResources(),
+		"proxies":            snap.Proxies.AsResources(),
+		"upstreamGroups":     snap.UpstreamGroups.AsResources(),
+		"secrets":            snap.Secrets.AsResources(),
+		"upstreams":          snap.Upstreams.AsResources(),
+		"authConfigs":        snap.AuthConfigs.AsResources(),
+		"ratelimitConfigs":   snap.Ratelimitconfigs.AsResources(),
+		"virtualServices":    snap.VirtualServices.AsResources(),
+		"routeTables":        snap.RouteTables.AsResources(),
+		"gateways":           snap.Gateways.AsResources(),
+		"virtualHostOptions": snap.VirtualHostOptions.AsResources(),
+		"routeOptions":       snap.RouteOptions.AsResources(),
+		"graphqlSchemas":     snap.GraphqlSchemas.AsResources(),
+	}
+}
+
+func sortedResourceNames(items map[string]envoycache.Resource) []string {
+	names := make([]string, 0, len(items))
+	for name := range items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func writeResponse(w http.ResponseWriter, r *http.Request, out interface{}) {
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if r.URL.Query().Get(FormatParam) == "yaml" {
+		data, err = yaml.JSONToYAML(data)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/yaml")
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,228 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/admin"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = Describe("Server", func() {
+
+	var (
+		xdsCache     envoycache.SnapshotCache
+		nodeTracker  *xds.NodeTracker
+		translations *admin.TranslationState
+		handler      http.Handler
+	)
+
+	BeforeEach(func() {
+		xdsCache = envoycache.NewSnapshotCache(true, xds.NewNodeHasher(), nil)
+		nodeTracker = xds.NewNodeTracker()
+		translations = admin.NewTranslationState(admin.DefaultSnapshotHistorySize)
+		handler = admin.NewServer(xdsCache, nodeTracker, translations).Handler()
+	})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder
+	}
+
+	getJson := func(path string, out interface{}) {
+		recorder := get(path)
+		ExpectWithOffset(1, recorder.Code).To(Equal(http.StatusOK), recorder.Body.String())
+		ExpectWithOffset(1, json.Unmarshal(recorder.Body.Bytes(), out)).NotTo(HaveOccurred())
+	}
+
+	Context("api snapshot", func() {
+
+		It("returns unavailable before the first sync", func() {
+			Expect(get(admin.ApiSnapshotPath).Code).To(Equal(http.StatusServiceUnavailable))
+		})
+
+		It("omits the data of the secrets", func() {
+			translations.SetSnapshot(&v1snap.ApiSnapshot{
+				Secrets: v1.SecretList{{
+					Metadata: &core.Metadata{Name: "tls", Namespace: "gloo-system"},
+					Kind: &v1.Secret_Tls{Tls: &v1.TlsSecret{
+						CertChain:  "cert",
+						PrivateKey: "key",
+					}},
+				}},
+				Upstreams: v1.UpstreamList{{
+					Metadata: &core.Metadata{Name: "us", Namespace: "gloo-system"},
+				}},
+			}, nil)
+
+			var out map[string][]map[string]interface{}
+			getJson(admin.ApiSnapshotPath, &out)
+			Expect(out["secrets"]).To(HaveLen(1))
+			Expect(out["secrets"][0]).To(HaveKey("metadata"))
+			Expect(out["secrets"][0]).NotTo(HaveKey("tls"))
+			Expect(out["upstreams"]).To(HaveLen(1))
+			Expect(out["upstreams"][0]["metadata"]).To(HaveKeyWithValue("name", "us"))
+		})
+
+		It("returns yaml when requested", func() {
+			translations.SetSnapshot(&v1snap.ApiSnapshot{}, nil)
+
+			recorder := get(admin.ApiSnapshotPath + "?" + admin.FormatParam + "=yaml")
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/yaml"))
+			var out map[string]interface{}
+			Expect(yaml.Unmarshal(recorder.Body.Bytes(), &out)).NotTo(HaveOccurred())
+			Expect(out).To(HaveKey("upstreams"))
+		})
+	})
+
+	Context("xds snapshots", func() {
+
+		BeforeEach(func() {
+			tlsContext, err := ptypes.MarshalAny(&envoy_extensions_transport_sockets_tls_v3.UpstreamTlsContext{
+				CommonTlsContext: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext{
+					TlsCertificates: []*envoy_extensions_transport_sockets_tls_v3.TlsCertificate{{
+						CertificateChain: &envoy_config_core_v3.DataSource{
+							Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: "cert"},
+						},
+						PrivateKey: &envoy_config_core_v3.DataSource{
+							Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: "key"},
+						},
+					}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			cluster := &envoy_config_cluster_v3.Cluster{
+				Name: "cluster",
+				TransportSocket: &envoy_config_core_v3.TransportSocket{
+					Name:       "envoy.transport_sockets.tls",
+					ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: tlsContext},
+				},
+			}
+			snap := xds.NewSnapshot("1", nil, []envoycache.Resource{resource.NewEnvoyResource(cluster)}, nil, nil)
+			Expect(xdsCache.SetSnapshot("gloo-system~proxy", snap)).NotTo(HaveOccurred())
+		})
+
+		It("lists the snapshot keys of the proxies", func() {
+			translations.SetSnapshot(&v1snap.ApiSnapshot{
+				Proxies: v1.ProxyList{{Metadata: &core.Metadata{Name: "proxy", Namespace: "gloo-system"}}},
+			}, nil)
+
+			var keys []string
+			getJson(admin.XdsSnapshotPath, &keys)
+			Expect(keys).To(ConsistOf("gloo-system~proxy"))
+		})
+
+		It("omits sensitive fields", func() {
+			var out admin.XdsSnapshot
+			getJson(admin.XdsSnapshotPath+"/gloo-system~proxy", &out)
+			Expect(out.Key).To(Equal("gloo-system~proxy"))
+			Expect(out.Resources["clusters"].Version).To(Equal("1"))
+			Expect(out.Resources["clusters"].Items).To(HaveLen(1))
+			Expect(out.Resources["listeners"].Items).To(BeEmpty())
+
+			data, err := json.Marshal(out.Resources["clusters"].Items[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(ContainSubstring(`"inlineString":"cert"`))
+			Expect(string(data)).NotTo(ContainSubstring(`"inlineString":"key"`))
+		})
+
+		It("returns not found for unknown keys", func() {
+			Expect(get(admin.XdsSnapshotPath + "/unknown").Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	It("returns the reports and sanitizer actions", func() {
+		upstream := &v1.Upstream{Metadata: &core.Metadata{Name: "us", Namespace: "gloo-system"}}
+		proxy := &v1.Proxy{Metadata: &core.Metadata{Name: "proxy", Namespace: "gloo-system"}}
+		translations.SetSnapshot(&v1snap.ApiSnapshot{}, reporter.ResourceReports{
+			upstream: {Warnings: []string{"warning"}},
+			proxy:    {Errors: eris.New("error")},
+		})
+		translations.SetSanitizerActions(map[string]*admin.SanitizerActions{
+			"gloo-system~proxy": {Rejected: "invalid"},
+		})
+
+		var out admin.Reports
+		getJson(admin.ReportsPath, &out)
+		Expect(out.Resources).To(Equal([]*admin.ResourceReport{
+			{Kind: "*v1.Proxy", Namespace: "gloo-system", Name: "proxy", Errors: []string{"error"}},
+			{Kind: "*v1.Upstream", Namespace: "gloo-system", Name: "us", Warnings: []string{"warning"}},
+		}))
+		Expect(out.SanitizerActions).To(HaveKeyWithValue("gloo-system~proxy", &admin.SanitizerActions{Rejected: "invalid"}))
+	})
+
+	Context("history", func() {
+
+		BeforeEach(func() {
+			history := translations.History()
+			history.Record("gloo-system~proxy", xds.NewSnapshot("1", nil, nil, nil, nil), nil)
+			history.Record("gloo-system~proxy", xds.NewSnapshot("2", nil, []envoycache.Resource{
+				resource.NewEnvoyResource(&envoy_config_cluster_v3.Cluster{Name: "cluster"}),
+			}, nil, nil), nil)
+		})
+
+		It("returns the revisions of a proxy", func() {
+			var keys []string
+			getJson(admin.HistoryPath, &keys)
+			Expect(keys).To(Equal([]string{"gloo-system~proxy"}))
+
+			var entries []*admin.SnapshotHistoryEntry
+			getJson(admin.HistoryPath+"/gloo-system~proxy", &entries)
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[1].Revision).To(Equal(uint64(2)))
+			Expect(entries[1].Versions).To(HaveKeyWithValue("clusters", "2"))
+
+			Expect(get(admin.HistoryPath + "/unknown").Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("returns the diff between revisions", func() {
+			var diff admin.SnapshotRevisionDiff
+			getJson(admin.HistoryPath+"/gloo-system~proxy/diff?"+admin.FromParam+"=1&"+admin.ToParam+"=2", &diff)
+			Expect(diff.Resources["clusters"]).To(Equal([]*admin.ResourceDiff{
+				{Name: "cluster", Change: admin.ResourceAdded},
+			}))
+
+			Expect(get(admin.HistoryPath + "/gloo-system~proxy/diff?" + admin.FromParam + "=a").Code).To(Equal(http.StatusBadRequest))
+			Expect(get(admin.HistoryPath + "/gloo-system~proxy/diff?" + admin.ToParam + "=5").Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	It("returns the connected nodes", func() {
+		Expect(nodeTracker.OnStreamOpen(context.Background(), 1, "")).NotTo(HaveOccurred())
+		Expect(nodeTracker.OnStreamRequest(1, &envoy_service_discovery_v3.DiscoveryRequest{
+			Node: &envoy_config_core_v3.Node{
+				Id: "envoy",
+				Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"role": structpb.NewStringValue("gloo-system~proxy"),
+				}},
+			},
+			TypeUrl: resource.ClusterTypeV3,
+		})).NotTo(HaveOccurred())
+
+		var out []*xds.NodeStatus
+		getJson(admin.NodesPath, &out)
+		Expect(out).To(HaveLen(1))
+		Expect(out[0].ID).To(Equal("envoy"))
+		Expect(out[0].SnapshotKey).To(Equal("gloo-system~proxy"))
+	})
+})
@@ -0,0 +1,311 @@
+package admin
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rotisserie/eris"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/go-utils/hashutils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+)
+
+// DefaultSnapshotHistorySize is the number of xDS snapshots kept for each proxy if not specified in the settings
+const DefaultSnapshotHistorySize = 10
+
+var (
+	UnknownProxyHistoryError = func(key string) error {
+		return eris.Errorf("no xDS snapshot history for proxy %v", key)
+	}
+	UnknownRevisionError = func(key string, revision uint64) error {
+		return eris.Errorf("revision %v of the xDS snapshot of proxy %v is not in the history", revision, key)
+	}
+)
+
+const (
+	ResourceAdded    = "added"
+	ResourceRemoved  = "removed"
+	ResourceModified = "modified"
+)
+
+// ResourceChange is a change to a resource of the API snapshot.
+type ResourceChange struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Either added, removed or modified
+	Change string `json:"change"`
+}
+
+// SnapshotHistoryEntry describes a revision of the xDS snapshot of a proxy.
+type SnapshotHistoryEntry struct {
+	// The revision of the snapshot, incremented every time the snapshot of the proxy changes
+	Revision uint64 `json:"revision"`
+	// When the snapshot was set in the xDS cache
+	Time time.Time `json:"time"`
+	// The versions of the resources of the snapshot, by type (clusters, endpoints, routes and listeners)
+	Versions map[string]string `json:"versions"`
+	// The changes to the resources of the API snapshot since the previous revision, which triggered this one.
+	// Empty for the first revision recorded.
+	TriggeredBy []ResourceChange `json:"triggeredBy,omitempty"`
+	// The xDS resources that differ from the previous revision, by type URL
+	Changes xds.SnapshotDiff `json:"changes,omitempty"`
+}
+
+// ResourceHashes are the hashes of the resources of an API snapshot, used to find which resources changed between syncs.
+type ResourceHashes map[resourceId]uint64
+
+type resourceId struct {
+	kind, namespace, name string
+}
+
+// HashResources returns the hashes of the resources of the snapshot.
+// As for the snapshot hash, the statuses and resource versions of the resources are not hashed.
+func HashResources(snap *v1snap.ApiSnapshot) ResourceHashes {
+	hashes := make(ResourceHashes)
+	for _, list := range apiSnapshotResources(snap) {
+		for _, res := range list {
+			hashes[resourceId{
+				kind:      resources.Kind(res),
+				namespace: res.GetMetadata().GetNamespace(),
+				name:      res.GetMetadata().GetName(),
+			}] = hashutils.MustHash(res)
+		}
+	}
+	return hashes
+}
+
+// SnapshotHistory keeps the latest revisions of the xDS snapshot of each proxy.
+// It is safe for concurrent use.
+type SnapshotHistory struct {
+	size int
+
+	lock    sync.RWMutex
+	proxies map[string]*proxyHistory
+}
+
+type proxyHistory struct {
+	// a ring buffer of the latest revisions: once it is full, entries[next] is the oldest one
+	entries []*historyEntry
+	next    int
+	// the hashes of the resources the latest revision was translated from
+	resourceHashes ResourceHashes
+}
+
+type historyEntry struct {
+	*SnapshotHistoryEntry
+	snapshot envoycache.Snapshot
+}
+
+// NewSnapshotHistory returns a history keeping `size` revisions per proxy, or none if it is 0.
+func NewSnapshotHistory(size int) *SnapshotHistory {
+	return &SnapshotHistory{
+		size:    size,
+		proxies: make(map[string]*proxyHistory),
+	}
+}
+
+// Enabled returns whether any revision is kept.
+func (h *SnapshotHistory) Enabled() bool {
+	return h.size > 0
+}
+
+// Record adds the snapshot set in the xDS cache for a proxy to its history, unless it is the same as its latest revision.
+// The resource hashes are those of the API snapshot the xDS snapshot was translated from, and must not be modified afterwards.
+func (h *SnapshotHistory) Record(key string, snap envoycache.Snapshot, resourceHashes ResourceHashes) {
+	if !h.Enabled() {
+		return
+	}
+	versions := snapshotVersions(snap)
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	history, ok := h.proxies[key]
+	if !ok {
+		history = &proxyHistory{}
+		h.proxies[key] = history
+	}
+
+	entry := &historyEntry{
+		SnapshotHistoryEntry: &SnapshotHistoryEntry{
+			Revision: 1,
+			Time:     time.Now(),
+			Versions: versions,
+		},
+		snapshot: snap,
+	}
+	if latest := history.latest(); latest != nil {
+		if sameVersions(latest.Versions, versions) {
+			return
+		}
+		entry.Revision = latest.Revision + 1
+		entry.TriggeredBy = diffResourceHashes(history.resourceHashes, resourceHashes)
+		entry.Changes = xds.DiffSnapshots(latest.snapshot, snap)
+	}
+	history.add(entry, h.size)
+	history.resourceHashes = resourceHashes
+}
+
+// Retain drops the history of the proxies whose keys are not given.
+func (h *SnapshotHistory) Retain(keys []string) {
+	retained := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		retained[key] = true
+	}
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	for key := range h.proxies {
+		if !retained[key] {
+			delete(h.proxies, key)
+		}
+	}
+}
+
+// Keys returns the sorted keys of the proxies with a history.
+func (h *SnapshotHistory) Keys() []string {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	keys := make([]string, 0, len(h.proxies))
+	for key := range h.proxies {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Entries returns the revisions kept for a proxy, from the oldest to the latest.
+func (h *SnapshotHistory) Entries(key string) ([]*SnapshotHistoryEntry, error) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	history, ok := h.proxies[key]
+	if !ok {
+		return nil, UnknownProxyHistoryError(key)
+	}
+	var entries []*SnapshotHistoryEntry
+	for _, entry := range history.ordered() {
+		entries = append(entries, entry.SnapshotHistoryEntry)
+	}
+	return entries, nil
+}
+
+// Diff compares the clusters, routes and listeners of two revisions of the snapshot of a proxy.
+// If `to` is not set, the latest revision is used. If `from` is not set, the revision preceding `to` is used,
+// revision 0 being an empty snapshot.
+func (h *SnapshotHistory) Diff(key string, from, to *uint64) (*SnapshotRevisionDiff, error) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	history, ok := h.proxies[key]
+	if !ok {
+		return nil, UnknownProxyHistoryError(key)
+	}
+	toRevision := history.latest().Revision
+	if to != nil {
+		toRevision = *to
+	}
+	toEntry := history.revision(toRevision)
+	if toEntry == nil {
+		return nil, UnknownRevisionError(key, toRevision)
+	}
+	fromRevision := toRevision - 1
+	if from != nil {
+		fromRevision = *from
+	}
+	var fromSnapshot envoycache.Snapshot
+	if fromRevision != 0 {
+		fromEntry := history.revision(fromRevision)
+		if fromEntry == nil {
+			return nil, UnknownRevisionError(key, fromRevision)
+		}
+		fromSnapshot = fromEntry.snapshot
+	}
+	return diffRevisions(key, fromRevision, fromSnapshot, toRevision, toEntry.snapshot), nil
+}
+
+func (p *proxyHistory) add(entry *historyEntry, size int) {
+	if len(p.entries) < size {
+		p.entries = append(p.entries, entry)
+		return
+	}
+	p.entries[p.next] = entry
+	p.next = (p.next + 1) % len(p.entries)
+}
+
+func (p *proxyHistory) latest() *historyEntry {
+	if len(p.entries) == 0 {
+		return nil
+	}
+	return p.entries[(p.next+len(p.entries)-1)%len(p.entries)]
+}
+
+func (p *proxyHistory) ordered() []*historyEntry {
+	ordered := make([]*historyEntry, 0, len(p.entries))
+	ordered = append(ordered, p.entries[p.next:]...)
+	return append(ordered, p.entries[:p.next]...)
+}
+
+func (p *proxyHistory) revision(revision uint64) *historyEntry {
+	for _, entry := range p.entries {
+		if entry.Revision == revision {
+			return entry
+		}
+	}
+	return nil
+}
+
+func snapshotVersions(snap envoycache.Snapshot) map[string]string {
+	versions := make(map[string]string, len(xdsResourceNames))
+	for typeURL, name := range xdsResourceNames {
+		versions[name] = snap.GetResources(typeURL).Version
+	}
+	return versions
+}
+
+func sameVersions(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, version := range a {
+		if b[name] != version {
+			return false
+		}
+	}
+	return true
+}
+
+func diffResourceHashes(from, to ResourceHashes) []ResourceChange {
+	if from == nil {
+		return nil
+	}
+	var changes []ResourceChange
+	addChange := func(id resourceId, change string) {
+		changes = append(changes, ResourceChange{Kind: id.kind, Namespace: id.namespace, Name: id.name, Change: change})
+	}
+	for id, hash := range to {
+		fromHash, ok := from[id]
+		switch {
+		case !ok:
+			addChange(id, ResourceAdded)
+		case fromHash != hash:
+			addChange(id, ResourceModified)
+		}
+	}
+	for id := range from {
+		if _, ok := to[id]; !ok {
+			addChange(id, ResourceRemoved)
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return changes
+}
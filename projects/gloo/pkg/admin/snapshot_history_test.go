@@ -0,0 +1,215 @@
+package admin_test
+
+import (
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/admin"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("SnapshotHistory", func() {
+
+	const key = "gloo-system~gateway-proxy"
+
+	var history *admin.SnapshotHistory
+
+	BeforeEach(func() {
+		history = admin.NewSnapshotHistory(3)
+	})
+
+	snapshot := func(version string, clusters ...*envoy_config_cluster_v3.Cluster) envoycache.Snapshot {
+		var resources []envoycache.Resource
+		for _, cluster := range clusters {
+			resources = append(resources, resource.NewEnvoyResource(cluster))
+		}
+		return xds.NewSnapshot(version, nil, resources, nil, nil)
+	}
+
+	upstreams := func(upstreams ...*v1.Upstream) admin.ResourceHashes {
+		return admin.HashResources(&v1snap.ApiSnapshot{Upstreams: upstreams})
+	}
+
+	upstream := func(name, sni string) *v1.Upstream {
+		return &v1.Upstream{
+			Metadata:  &core.Metadata{Name: name, Namespace: "gloo-system"},
+			SslConfig: &v1.UpstreamSslConfig{Sni: sni},
+		}
+	}
+
+	revisions := func() []uint64 {
+		entries, err := history.Entries(key)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		var revisions []uint64
+		for _, entry := range entries {
+			revisions = append(revisions, entry.Revision)
+		}
+		return revisions
+	}
+
+	It("keeps the latest revisions", func() {
+		for _, version := range []string{"1", "2", "3", "4", "5"} {
+			history.Record(key, snapshot(version), nil)
+		}
+		Expect(revisions()).To(Equal([]uint64{3, 4, 5}))
+
+		entries, err := history.Entries(key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries[2].Versions).To(HaveKeyWithValue("clusters", "5"))
+		Expect(entries[2].Time).To(BeTemporally("~", time.Now(), time.Second))
+	})
+
+	It("does not record a snapshot identical to the latest revision", func() {
+		history.Record(key, snapshot("1"), nil)
+		history.Record(key, snapshot("1"), nil)
+		Expect(revisions()).To(Equal([]uint64{1}))
+	})
+
+	It("does not record anything when disabled", func() {
+		history = admin.NewSnapshotHistory(0)
+		history.Record(key, snapshot("1"), nil)
+		Expect(history.Keys()).To(BeEmpty())
+	})
+
+	It("records the resource changes that triggered a revision", func() {
+		history.Record(key, snapshot("1", &envoy_config_cluster_v3.Cluster{Name: "a"}),
+			upstreams(upstream("a", "a.com"), upstream("b", "b.com")))
+		history.Record(key, snapshot("2", &envoy_config_cluster_v3.Cluster{Name: "b"}),
+			upstreams(upstream("b", "b.org"), upstream("c", "c.com")))
+
+		entries, err := history.Entries(key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries[0].TriggeredBy).To(BeEmpty())
+		Expect(entries[1].TriggeredBy).To(Equal([]admin.ResourceChange{
+			{Kind: "*v1.Upstream", Namespace: "gloo-system", Name: "a", Change: admin.ResourceRemoved},
+			{Kind: "*v1.Upstream", Namespace: "gloo-system", Name: "b", Change: admin.ResourceModified},
+			{Kind: "*v1.Upstream", Namespace: "gloo-system", Name: "c", Change: admin.ResourceAdded},
+		}))
+		Expect(entries[1].Changes).To(Equal(xds.SnapshotDiff{
+			resource.ClusterTypeV3: {Added: []string{"b"}, Removed: []string{"a"}},
+		}))
+	})
+
+	It("drops the history of removed proxies", func() {
+		history.Record(key, snapshot("1"), nil)
+		history.Record("other", snapshot("1"), nil)
+		Expect(history.Keys()).To(Equal([]string{key, "other"}))
+
+		history.Retain([]string{key})
+		Expect(history.Keys()).To(Equal([]string{key}))
+		_, err := history.Entries("other")
+		Expect(err).To(MatchError(admin.UnknownProxyHistoryError("other")))
+	})
+
+	Context("diff", func() {
+
+		uint64Ptr := func(value uint64) *uint64 {
+			return &value
+		}
+
+		BeforeEach(func() {
+			history.Record(key, snapshot("1",
+				&envoy_config_cluster_v3.Cluster{Name: "modified", ConnectTimeout: &duration.Duration{Seconds: 1}},
+				&envoy_config_cluster_v3.Cluster{Name: "removed"},
+			), nil)
+			history.Record(key, snapshot("2",
+				&envoy_config_cluster_v3.Cluster{
+					Name:           "modified",
+					ConnectTimeout: &duration.Duration{Seconds: 2},
+					LbPolicy:       envoy_config_cluster_v3.Cluster_RANDOM,
+				},
+				&envoy_config_cluster_v3.Cluster{Name: "added"},
+			), nil)
+		})
+
+		It("compares the latest revision to the previous one by default", func() {
+			diff, err := history.Diff(key, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diff.From).To(Equal(uint64(1)))
+			Expect(diff.To).To(Equal(uint64(2)))
+			Expect(diff.Resources).To(Equal(map[string][]*admin.ResourceDiff{
+				"clusters": {
+					{Name: "added", Change: admin.ResourceAdded},
+					{Name: "modified", Change: admin.ResourceModified, Fields: []*admin.FieldDiff{
+						{Path: "connectTimeout", From: "1s", To: "2s"},
+						{Path: "lbPolicy", From: nil, To: "RANDOM"},
+					}},
+					{Name: "removed", Change: admin.ResourceRemoved},
+				},
+			}))
+		})
+
+		It("compares to the empty snapshot from revision 0", func() {
+			diff, err := history.Diff(key, uint64Ptr(0), uint64Ptr(1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diff.Resources["clusters"]).To(Equal([]*admin.ResourceDiff{
+				{Name: "modified", Change: admin.ResourceAdded},
+				{Name: "removed", Change: admin.ResourceAdded},
+			}))
+		})
+
+		It("errors on unknown revisions", func() {
+			_, err := history.Diff(key, uint64Ptr(1), uint64Ptr(3))
+			Expect(err).To(MatchError(admin.UnknownRevisionError(key, 3)))
+		})
+	})
+
+	Context("resource diff", func() {
+
+		tlsContext := func(sni, privateKey string) *envoy_config_core_v3.TransportSocket {
+			typedConfig, err := ptypes.MarshalAny(&envoy_extensions_transport_sockets_tls_v3.UpstreamTlsContext{
+				Sni: sni,
+				CommonTlsContext: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext{
+					TlsCertificates: []*envoy_extensions_transport_sockets_tls_v3.TlsCertificate{{
+						PrivateKey: &envoy_config_core_v3.DataSource{
+							Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: privateKey},
+						},
+					}},
+				},
+			})
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return &envoy_config_core_v3.TransportSocket{
+				Name:       "envoy.transport_sockets.tls",
+				ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+			}
+		}
+
+		It("compares the messages packed in Any fields without their sensitive fields", func() {
+			diffs := admin.DiffResources(
+				&envoy_config_cluster_v3.Cluster{TransportSocket: tlsContext("a.com", "key1")},
+				&envoy_config_cluster_v3.Cluster{TransportSocket: tlsContext("b.com", "key2")},
+			)
+			Expect(diffs).To(Equal([]*admin.FieldDiff{
+				{Path: "transportSocket.typedConfig.sni", From: "a.com", To: "b.com"},
+			}))
+		})
+
+		It("compares lists element by element", func() {
+			diffs := admin.DiffResources(
+				&envoy_config_listener_v3.Listener{FilterChains: []*envoy_config_listener_v3.FilterChain{
+					{Name: "a"},
+				}},
+				&envoy_config_listener_v3.Listener{FilterChains: []*envoy_config_listener_v3.FilterChain{
+					{Name: "b"},
+					{Name: "c"},
+				}},
+			)
+			Expect(diffs).To(Equal([]*admin.FieldDiff{
+				{Path: "filterChains[0].name", From: "a", To: "b"},
+				{Path: "filterChains[1]", From: nil, To: map[string]interface{}{"name": "c"}},
+			}))
+		})
+	})
+})
@@ -0,0 +1,76 @@
+package admin
+
+import (
+	"sync"
+
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+// SanitizerActions describes how the xDS sanitizers changed the snapshot translated for a proxy.
+type SanitizerActions struct {
+	// Set if the sanitizers rejected the snapshot, in which case at most its endpoints and clusters were sent to envoy
+	Rejected string `json:"rejected,omitempty"`
+	// The resources the sanitizers added, removed or modified, by type URL
+	Changes xds.SnapshotDiff `json:"changes,omitempty"`
+}
+
+// TranslationState holds the outcome of the latest sync of the translator syncer, for debugging purposes.
+// It is safe for concurrent use.
+type TranslationState struct {
+	lock             sync.RWMutex
+	snapshot         *v1snap.ApiSnapshot
+	reports          reporter.ResourceReports
+	sanitizerActions map[string]*SanitizerActions
+	history          *SnapshotHistory
+}
+
+// NewTranslationState returns a state keeping `historySize` revisions of the xDS snapshot of each proxy.
+func NewTranslationState(historySize int) *TranslationState {
+	return &TranslationState{
+		history: NewSnapshotHistory(historySize),
+	}
+}
+
+// SetSnapshot records the snapshot being synced, along with the reports of its resources.
+// The reports must not be modified afterwards.
+func (t *TranslationState) SetSnapshot(snap *v1snap.ApiSnapshot, reports reporter.ResourceReports) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.snapshot = snap
+	t.reports = reports
+}
+
+// SetSanitizerActions records the actions of the sanitizers on the snapshot of each proxy, by xDS snapshot key.
+func (t *TranslationState) SetSanitizerActions(actions map[string]*SanitizerActions) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.sanitizerActions = actions
+}
+
+// Snapshot returns the latest snapshot synced, or nil if none was.
+func (t *TranslationState) Snapshot() *v1snap.ApiSnapshot {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.snapshot
+}
+
+// Reports returns the reports of the resources of the latest snapshot synced.
+func (t *TranslationState) Reports() reporter.ResourceReports {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.reports
+}
+
+// History returns the history of the xDS snapshots of the proxies.
+func (t *TranslationState) History() *SnapshotHistory {
+	return t.history
+}
+
+// SanitizerActions returns the actions of the sanitizers on the latest snapshot of each proxy.
+func (t *TranslationState) SanitizerActions() map[string]*SanitizerActions {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.sanitizerActions
+}
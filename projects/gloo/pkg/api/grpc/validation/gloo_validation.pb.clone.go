@@ -119,6 +119,19 @@ func (m *ModifiedResources) Clone() proto.Message {
 		}
 	}
 
+	if m.GetUpstreamGroups() != nil {
+		target.UpstreamGroups = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.UpstreamGroup, len(m.GetUpstreamGroups()))
+		for idx, v := range m.GetUpstreamGroups() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.UpstreamGroups[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.UpstreamGroup)
+			} else {
+				target.UpstreamGroups[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.UpstreamGroup)
+			}
+
+		}
+	}
+
 	return target
 }
 
@@ -156,6 +169,19 @@ func (m *DeletedResources) Clone() proto.Message {
 		}
 	}
 
+	if m.GetUpstreamGroupRefs() != nil {
+		target.UpstreamGroupRefs = make([]*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef, len(m.GetUpstreamGroupRefs()))
+		for idx, v := range m.GetUpstreamGroupRefs() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.UpstreamGroupRefs[idx] = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+			} else {
+				target.UpstreamGroupRefs[idx] = proto.Clone(v).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+			}
+
+		}
+	}
+
 	return target
 }
 
@@ -192,6 +218,19 @@ func (m *ValidationReport) Clone() proto.Message {
 		target.Proxy = proto.Clone(m.GetProxy()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.Proxy)
 	}
 
+	if m.GetUpstreamGroupReports() != nil {
+		target.UpstreamGroupReports = make([]*ResourceReport, len(m.GetUpstreamGroupReports()))
+		for idx, v := range m.GetUpstreamGroupReports() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.UpstreamGroupReports[idx] = h.Clone().(*ResourceReport)
+			} else {
+				target.UpstreamGroupReports[idx] = proto.Clone(v).(*ResourceReport)
+			}
+
+		}
+	}
+
 	return target
 }
 
@@ -411,6 +450,19 @@ func (m *VirtualHostReport) Clone() proto.Message {
 		}
 	}
 
+	if m.GetWarnings() != nil {
+		target.Warnings = make([]*VirtualHostReport_Warning, len(m.GetWarnings()))
+		for idx, v := range m.GetWarnings() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Warnings[idx] = h.Clone().(*VirtualHostReport_Warning)
+			} else {
+				target.Warnings[idx] = proto.Clone(v).(*VirtualHostReport_Warning)
+			}
+
+		}
+	}
+
 	return target
 }
 
@@ -620,6 +672,21 @@ func (m *VirtualHostReport_Error) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *VirtualHostReport_Warning) Clone() proto.Message {
+	var target *VirtualHostReport_Warning
+	if m == nil {
+		return target
+	}
+	target = &VirtualHostReport_Warning{}
+
+	target.Type = m.GetType()
+
+	target.Reason = m.GetReason()
+
+	return target
+}
+
 // Clone function
 func (m *RouteReport_Error) Clone() proto.Message {
 	var target *RouteReport_Error
@@ -177,6 +177,23 @@ func (m *ModifiedResources) Equal(that interface{}) bool {
 
 	}
 
+	if len(m.GetUpstreamGroups()) != len(target.GetUpstreamGroups()) {
+		return false
+	}
+	for idx, v := range m.GetUpstreamGroups() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUpstreamGroups()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetUpstreamGroups()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -235,6 +252,23 @@ func (m *DeletedResources) Equal(that interface{}) bool {
 
 	}
 
+	if len(m.GetUpstreamGroupRefs()) != len(target.GetUpstreamGroupRefs()) {
+		return false
+	}
+	for idx, v := range m.GetUpstreamGroupRefs() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUpstreamGroupRefs()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetUpstreamGroupRefs()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -296,6 +330,23 @@ func (m *ValidationReport) Equal(that interface{}) bool {
 		}
 	}
 
+	if len(m.GetUpstreamGroupReports()) != len(target.GetUpstreamGroupReports()) {
+		return false
+	}
+	for idx, v := range m.GetUpstreamGroupReports() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUpstreamGroupReports()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetUpstreamGroupReports()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -652,6 +703,23 @@ func (m *VirtualHostReport) Equal(that interface{}) bool {
 
 	}
 
+	if len(m.GetWarnings()) != len(target.GetWarnings()) {
+		return false
+	}
+	for idx, v := range m.GetWarnings() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetWarnings()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetWarnings()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -1012,6 +1080,38 @@ func (m *VirtualHostReport_Error) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *VirtualHostReport_Warning) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*VirtualHostReport_Warning)
+	if !ok {
+		that2, ok := that.(VirtualHostReport_Warning)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetType() != target.GetType() {
+		return false
+	}
+
+	if strings.Compare(m.GetReason(), target.GetReason()) != 0 {
+		return false
+	}
+
+	return true
+}
+
 // Equal function
 func (m *RouteReport_Error) Equal(that interface{}) bool {
 	if that == nil {
@@ -59,5 +59,34 @@ func (m *CircuitBreakerConfig) Clone() proto.Message {
 		target.MaxRetries = proto.Clone(m.GetMaxRetries()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
 	}
 
+	if h, ok := interface{}(m.GetRetryBudget()).(clone.Cloner); ok {
+		target.RetryBudget = h.Clone().(*CircuitBreakerConfig_RetryBudget)
+	} else {
+		target.RetryBudget = proto.Clone(m.GetRetryBudget()).(*CircuitBreakerConfig_RetryBudget)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *CircuitBreakerConfig_RetryBudget) Clone() proto.Message {
+	var target *CircuitBreakerConfig_RetryBudget
+	if m == nil {
+		return target
+	}
+	target = &CircuitBreakerConfig_RetryBudget{}
+
+	if h, ok := interface{}(m.GetBudgetPercent()).(clone.Cloner); ok {
+		target.BudgetPercent = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.BudgetPercent = proto.Clone(m.GetBudgetPercent()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
+	if h, ok := interface{}(m.GetMinRetryConcurrency()).(clone.Cloner); ok {
+		target.MinRetryConcurrency = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.MinRetryConcurrency = proto.Clone(m.GetMinRetryConcurrency()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
 	return target
 }
@@ -86,5 +86,59 @@ func (m *CircuitBreakerConfig) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetRetryBudget()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRetryBudget()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRetryBudget(), target.GetRetryBudget()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *CircuitBreakerConfig_RetryBudget) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*CircuitBreakerConfig_RetryBudget)
+	if !ok {
+		that2, ok := that.(CircuitBreakerConfig_RetryBudget)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetBudgetPercent()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetBudgetPercent()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetBudgetPercent(), target.GetBudgetPercent()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMinRetryConcurrency()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMinRetryConcurrency()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMinRetryConcurrency(), target.GetMinRetryConcurrency()) {
+			return false
+		}
+	}
+
 	return true
 }
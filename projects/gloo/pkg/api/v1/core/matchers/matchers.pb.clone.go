@@ -14,6 +14,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 )
 
 // ensure the imports are used
@@ -96,6 +98,37 @@ func (m *Matcher) Clone() proto.Message {
 			Regex: m.GetRegex(),
 		}
 
+	case *Matcher_Grpc:
+
+		if h, ok := interface{}(m.GetGrpc()).(clone.Cloner); ok {
+			target.PathSpecifier = &Matcher_Grpc{
+				Grpc: h.Clone().(*GrpcMatcher),
+			}
+		} else {
+			target.PathSpecifier = &Matcher_Grpc{
+				Grpc: proto.Clone(m.GetGrpc()).(*GrpcMatcher),
+			}
+		}
+
+	}
+
+	if m.GetSourcePrefixRanges() != nil {
+		target.SourcePrefixRanges = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange, len(m.GetSourcePrefixRanges()))
+		for idx, v := range m.GetSourcePrefixRanges() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.SourcePrefixRanges[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange)
+			} else {
+				target.SourcePrefixRanges[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange)
+			}
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetTls()).(clone.Cloner); ok {
+		target.Tls = h.Clone().(*TlsMatcher)
+	} else {
+		target.Tls = proto.Clone(m.GetTls()).(*TlsMatcher)
 	}
 
 	return target
@@ -136,3 +169,56 @@ func (m *QueryParameterMatcher) Clone() proto.Message {
 
 	return target
 }
+
+// Clone function
+func (m *GrpcMatcher) Clone() proto.Message {
+	var target *GrpcMatcher
+	if m == nil {
+		return target
+	}
+	target = &GrpcMatcher{}
+
+	target.Service = m.GetService()
+
+	target.Method = m.GetMethod()
+
+	return target
+}
+
+// Clone function
+func (m *TlsMatcher) Clone() proto.Message {
+	var target *TlsMatcher
+	if m == nil {
+		return target
+	}
+	target = &TlsMatcher{}
+
+	if h, ok := interface{}(m.GetSecure()).(clone.Cloner); ok {
+		target.Secure = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.Secure = proto.Clone(m.GetSecure()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
+	if h, ok := interface{}(m.GetClientCertificatePresented()).(clone.Cloner); ok {
+		target.ClientCertificatePresented = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.ClientCertificatePresented = proto.Clone(m.GetClientCertificatePresented()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
+	if h, ok := interface{}(m.GetClientCertificateValidated()).(clone.Cloner); ok {
+		target.ClientCertificateValidated = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.ClientCertificateValidated = proto.Clone(m.GetClientCertificateValidated()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
+	if m.GetSniDomains() != nil {
+		target.SniDomains = make([]string, len(m.GetSniDomains()))
+		for idx, v := range m.GetSniDomains() {
+
+			target.SniDomains[idx] = v
+
+		}
+	}
+
+	return target
+}
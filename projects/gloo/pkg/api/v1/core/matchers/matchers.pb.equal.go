@@ -130,6 +130,21 @@ func (m *Matcher) Equal(that interface{}) bool {
 			return false
 		}
 
+	case *Matcher_Grpc:
+		if _, ok := target.PathSpecifier.(*Matcher_Grpc); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetGrpc()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetGrpc()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetGrpc(), target.GetGrpc()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.PathSpecifier != target.PathSpecifier {
@@ -137,6 +152,33 @@ func (m *Matcher) Equal(that interface{}) bool {
 		}
 	}
 
+	if len(m.GetSourcePrefixRanges()) != len(target.GetSourcePrefixRanges()) {
+		return false
+	}
+	for idx, v := range m.GetSourcePrefixRanges() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetSourcePrefixRanges()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetSourcePrefixRanges()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetTls()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTls()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTls(), target.GetTls()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -215,3 +257,100 @@ func (m *QueryParameterMatcher) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *GrpcMatcher) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GrpcMatcher)
+	if !ok {
+		that2, ok := that.(GrpcMatcher)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetService(), target.GetService()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetMethod(), target.GetMethod()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TlsMatcher) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TlsMatcher)
+	if !ok {
+		that2, ok := that.(TlsMatcher)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetSecure()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSecure()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSecure(), target.GetSecure()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetClientCertificatePresented()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetClientCertificatePresented()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetClientCertificatePresented(), target.GetClientCertificatePresented()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetClientCertificateValidated()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetClientCertificateValidated()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetClientCertificateValidated(), target.GetClientCertificateValidated()) {
+			return false
+		}
+	}
+
+	if len(m.GetSniDomains()) != len(target.GetSniDomains()) {
+		return false
+	}
+	for idx, v := range m.GetSniDomains() {
+
+		if strings.Compare(v, target.GetSniDomains()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
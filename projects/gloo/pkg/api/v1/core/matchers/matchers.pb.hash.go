@@ -134,6 +134,72 @@ func (m *Matcher) Hash(hasher hash.Hash64) (uint64, error) {
 			return 0, err
 		}
 
+	case *Matcher_Grpc:
+
+		if h, ok := interface{}(m.GetGrpc()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Grpc")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetGrpc(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Grpc")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	for _, v := range m.GetSourcePrefixRanges() {
+
+		if h, ok := interface{}(v).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(v, nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetTls()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Tls")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetTls(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Tls")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
 	}
 
 	return hasher.Sum64(), nil
@@ -201,3 +267,111 @@ func (m *QueryParameterMatcher) Hash(hasher hash.Hash64) (uint64, error) {
 
 	return hasher.Sum64(), nil
 }
+
+// Hash function
+func (m *GrpcMatcher) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("matchers.core.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers.GrpcMatcher")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetService())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetMethod())); err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *TlsMatcher) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("matchers.core.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers.TlsMatcher")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetSecure()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Secure")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetSecure(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Secure")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetClientCertificatePresented()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("ClientCertificatePresented")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetClientCertificatePresented(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("ClientCertificatePresented")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetClientCertificateValidated()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("ClientCertificateValidated")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetClientCertificateValidated(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("ClientCertificateValidated")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	for _, v := range m.GetSniDomains() {
+
+		if _, err = hasher.Write([]byte(v)); err != nil {
+			return 0, err
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
@@ -66,6 +66,14 @@ func (m *Endpoint) Clone() proto.Message {
 		target.Metadata = proto.Clone(m.GetMetadata()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.Metadata)
 	}
 
+	if h, ok := interface{}(m.GetLocality()).(clone.Cloner); ok {
+		target.Locality = h.Clone().(*Locality)
+	} else {
+		target.Locality = proto.Clone(m.GetLocality()).(*Locality)
+	}
+
+	target.HealthStatus = m.GetHealthStatus()
+
 	return target
 }
 
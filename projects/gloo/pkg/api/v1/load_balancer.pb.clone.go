@@ -129,6 +129,18 @@ func (m *LoadBalancerConfig) Clone() proto.Message {
 			}
 		}
 
+	case *LoadBalancerConfig_ZoneAwareLbConfig_:
+
+		if h, ok := interface{}(m.GetZoneAwareLbConfig()).(clone.Cloner); ok {
+			target.LocalityConfig = &LoadBalancerConfig_ZoneAwareLbConfig_{
+				ZoneAwareLbConfig: h.Clone().(*LoadBalancerConfig_ZoneAwareLbConfig),
+			}
+		} else {
+			target.LocalityConfig = &LoadBalancerConfig_ZoneAwareLbConfig_{
+				ZoneAwareLbConfig: proto.Clone(m.GetZoneAwareLbConfig()).(*LoadBalancerConfig_ZoneAwareLbConfig),
+			}
+		}
+
 	}
 
 	return target
@@ -211,3 +223,28 @@ func (m *LoadBalancerConfig_Maglev) Clone() proto.Message {
 
 	return target
 }
+
+// Clone function
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) Clone() proto.Message {
+	var target *LoadBalancerConfig_ZoneAwareLbConfig
+	if m == nil {
+		return target
+	}
+	target = &LoadBalancerConfig_ZoneAwareLbConfig{}
+
+	if h, ok := interface{}(m.GetRoutingEnabled()).(clone.Cloner); ok {
+		target.RoutingEnabled = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.RoutingEnabled = proto.Clone(m.GetRoutingEnabled()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
+	if h, ok := interface{}(m.GetMinClusterSize()).(clone.Cloner); ok {
+		target.MinClusterSize = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt64Value)
+	} else {
+		target.MinClusterSize = proto.Clone(m.GetMinClusterSize()).(*github_com_golang_protobuf_ptypes_wrappers.UInt64Value)
+	}
+
+	target.FailTrafficOnPanic = m.GetFailTrafficOnPanic()
+
+	return target
+}
@@ -267,6 +267,8 @@ type HttpListenerOptions struct {
 	// the x-forwarded-for header and set it as the downstream address.
 	// It is worth noting that the x-forwarded-for header can be tampered with by clients
 	// and should therefore be sanitized by any preceding proxies / load balancers if this option is to be used.
+	// To derive the downstream address from the x-forwarded-for header in open source, trust a fixed number of
+	// hops with `httpConnectionManagerSettings.xffOriginalIpDetection` instead.
 	LeftmostXffAddress *wrappers.BoolValue `protobuf:"bytes,16,opt,name=leftmost_xff_address,json=leftmostXffAddress,proto3" json:"leftmost_xff_address,omitempty"`
 }
 
@@ -504,7 +506,7 @@ type VirtualHostOptions struct {
 	//	*VirtualHostOptions_Jwt
 	//	*VirtualHostOptions_JwtStaged
 	JwtConfig isVirtualHostOptions_JwtConfig `protobuf_oneof:"jwt_config"`
-	// Enterprise-only: Config for RBAC (currently only supports RBAC based on JWT claims)
+	// Config for RBAC (currently only supports RBAC based on JWT claims)
 	Rbac *rbac.ExtensionSettings `protobuf:"bytes,11,opt,name=rbac,proto3" json:"rbac,omitempty"`
 	// Enterprise-only: Authentication configuration
 	Extauth *v1.ExtAuthExtension `protobuf:"bytes,12,opt,name=extauth,proto3" json:"extauth,omitempty"`
@@ -757,7 +759,7 @@ type isVirtualHostOptions_JwtConfig interface {
 }
 
 type VirtualHostOptions_Jwt struct {
-	// Enterprise-only: Config for reading and verifying JWTs. Copy verifiable information from JWTs into other
+	// Config for reading and verifying JWTs. Copy verifiable information from JWTs into other
 	// headers to make routing decisions or combine with RBAC for fine-grained access control.
 	// This has been deprecated in favor of staged jwt. The same configuration can be achieved through staged jwt
 	// using AfterExtAuth.
@@ -767,7 +769,7 @@ type VirtualHostOptions_Jwt struct {
 }
 
 type VirtualHostOptions_JwtStaged struct {
-	// Enterprise-only: Config for reading and verifying JWTs. Copy verifiable information from JWTs into other
+	// Config for reading and verifying JWTs. Copy verifiable information from JWTs into other
 	// headers to make routing decisions or combine with RBAC for fine-grained access control.
 	// JWT configuration has stages "BeforeExtAuth" and "AfterExtAuth". BeforeExtAuth JWT
 	// validation runs before the external authentication service. This is useful when JWT
@@ -848,7 +850,7 @@ type RouteOptions struct {
 	//	*RouteOptions_Jwt
 	//	*RouteOptions_JwtStaged
 	JwtConfig isRouteOptions_JwtConfig `protobuf_oneof:"jwt_config"`
-	// Enterprise-only: Config for RBAC (currently only supports RBAC based on JWT claims)
+	// Config for RBAC (currently only supports RBAC based on JWT claims)
 	Rbac *rbac.ExtensionSettings `protobuf:"bytes,17,opt,name=rbac,proto3" json:"rbac,omitempty"`
 	// Enterprise-only: Authentication configuration
 	Extauth *v1.ExtAuthExtension `protobuf:"bytes,18,opt,name=extauth,proto3" json:"extauth,omitempty"`
@@ -1183,7 +1185,7 @@ type isRouteOptions_JwtConfig interface {
 }
 
 type RouteOptions_Jwt struct {
-	// Enterprise-only: Config for reading and verifying JWTs. Copy verifiable information from JWTs into other
+	// Config for reading and verifying JWTs. Copy verifiable information from JWTs into other
 	// headers to make routing decisions or combine with RBAC for fine-grained access control.
 	// This has been deprecated in favor of staged jwt. The same configuration can be achieved through staged jwt
 	// using AfterExtAuth.
@@ -1193,7 +1195,7 @@ type RouteOptions_Jwt struct {
 }
 
 type RouteOptions_JwtStaged struct {
-	// Enterprise-only: Config for reading and verifying JWTs. Copy verifiable information from JWTs into other
+	// Config for reading and verifying JWTs. Copy verifiable information from JWTs into other
 	// headers to make routing decisions or combine with RBAC for fine-grained access control.
 	// JWT configuration has stages "BeforeExtAuth" and "AfterExtAuth". BeforeExtAuth JWT
 	// validation runs before the external authentication service. This is useful when JWT
@@ -17,6 +17,8 @@ import (
 
 	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_protocol_upgrade "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_tracing "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tracing"
@@ -180,6 +182,12 @@ func (m *HttpConnectionManagerSettings) Clone() proto.Message {
 
 	}
 
+	if h, ok := interface{}(m.GetXffOriginalIpDetection()).(clone.Cloner); ok {
+		target.XffOriginalIpDetection = h.Clone().(*HttpConnectionManagerSettings_XffOriginalIpDetection)
+	} else {
+		target.XffOriginalIpDetection = proto.Clone(m.GetXffOriginalIpDetection()).(*HttpConnectionManagerSettings_XffOriginalIpDetection)
+	}
+
 	return target
 }
 
@@ -207,3 +215,29 @@ func (m *HttpConnectionManagerSettings_SetCurrentClientCertDetails) Clone() prot
 
 	return target
 }
+
+// Clone function
+func (m *HttpConnectionManagerSettings_XffOriginalIpDetection) Clone() proto.Message {
+	var target *HttpConnectionManagerSettings_XffOriginalIpDetection
+	if m == nil {
+		return target
+	}
+	target = &HttpConnectionManagerSettings_XffOriginalIpDetection{}
+
+	target.NumTrustedHops = m.GetNumTrustedHops()
+
+	if m.GetTrustedCidrs() != nil {
+		target.TrustedCidrs = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange, len(m.GetTrustedCidrs()))
+		for idx, v := range m.GetTrustedCidrs() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.TrustedCidrs[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange)
+			} else {
+				target.TrustedCidrs[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange)
+			}
+
+		}
+	}
+
+	return target
+}
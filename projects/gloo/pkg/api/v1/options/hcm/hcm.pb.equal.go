@@ -282,6 +282,16 @@ func (m *HttpConnectionManagerSettings) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetXffOriginalIpDetection()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetXffOriginalIpDetection()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetXffOriginalIpDetection(), target.GetXffOriginalIpDetection()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -334,3 +344,48 @@ func (m *HttpConnectionManagerSettings_SetCurrentClientCertDetails) Equal(that i
 
 	return true
 }
+
+// Equal function
+func (m *HttpConnectionManagerSettings_XffOriginalIpDetection) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*HttpConnectionManagerSettings_XffOriginalIpDetection)
+	if !ok {
+		that2, ok := that.(HttpConnectionManagerSettings_XffOriginalIpDetection)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetNumTrustedHops() != target.GetNumTrustedHops() {
+		return false
+	}
+
+	if len(m.GetTrustedCidrs()) != len(target.GetTrustedCidrs()) {
+		return false
+	}
+	for idx, v := range m.GetTrustedCidrs() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetTrustedCidrs()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetTrustedCidrs()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
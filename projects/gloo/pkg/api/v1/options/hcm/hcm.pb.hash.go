@@ -422,6 +422,26 @@ func (m *HttpConnectionManagerSettings) Hash(hasher hash.Hash64) (uint64, error)
 
 	}
 
+	if h, ok := interface{}(m.GetXffOriginalIpDetection()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("XffOriginalIpDetection")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetXffOriginalIpDetection(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("XffOriginalIpDetection")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -480,3 +500,48 @@ func (m *HttpConnectionManagerSettings_SetCurrentClientCertDetails) Hash(hasher
 
 	return hasher.Sum64(), nil
 }
+
+// Hash function
+func (m *HttpConnectionManagerSettings_XffOriginalIpDetection) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("hcm.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/hcm.HttpConnectionManagerSettings_XffOriginalIpDetection")); err != nil {
+		return 0, err
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetNumTrustedHops())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, v := range m.GetTrustedCidrs() {
+
+		if h, ok := interface{}(v).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(v, nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
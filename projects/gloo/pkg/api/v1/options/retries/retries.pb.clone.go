@@ -14,6 +14,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 )
 
 // ensure the imports are used
@@ -45,5 +47,79 @@ func (m *RetryPolicy) Clone() proto.Message {
 		target.PerTryTimeout = proto.Clone(m.GetPerTryTimeout()).(*github_com_golang_protobuf_ptypes_duration.Duration)
 	}
 
+	if h, ok := interface{}(m.GetRetryBackOff()).(clone.Cloner); ok {
+		target.RetryBackOff = h.Clone().(*RetryBackOff)
+	} else {
+		target.RetryBackOff = proto.Clone(m.GetRetryBackOff()).(*RetryBackOff)
+	}
+
+	if m.GetRetriableStatusCodes() != nil {
+		target.RetriableStatusCodes = make([]uint32, len(m.GetRetriableStatusCodes()))
+		for idx, v := range m.GetRetriableStatusCodes() {
+
+			target.RetriableStatusCodes[idx] = v
+
+		}
+	}
+
+	if m.GetRetriableRequestHeaders() != nil {
+		target.RetriableRequestHeaders = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher, len(m.GetRetriableRequestHeaders()))
+		for idx, v := range m.GetRetriableRequestHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.RetriableRequestHeaders[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			} else {
+				target.RetriableRequestHeaders[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			}
+
+		}
+	}
+
+	target.RetryPreviousHosts = m.GetRetryPreviousHosts()
+
+	target.HostSelectionRetryMaxAttempts = m.GetHostSelectionRetryMaxAttempts()
+
+	if h, ok := interface{}(m.GetPreviousPriorities()).(clone.Cloner); ok {
+		target.PreviousPriorities = h.Clone().(*PreviousPriorities)
+	} else {
+		target.PreviousPriorities = proto.Clone(m.GetPreviousPriorities()).(*PreviousPriorities)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *RetryBackOff) Clone() proto.Message {
+	var target *RetryBackOff
+	if m == nil {
+		return target
+	}
+	target = &RetryBackOff{}
+
+	if h, ok := interface{}(m.GetBaseInterval()).(clone.Cloner); ok {
+		target.BaseInterval = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.BaseInterval = proto.Clone(m.GetBaseInterval()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if h, ok := interface{}(m.GetMaxInterval()).(clone.Cloner); ok {
+		target.MaxInterval = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.MaxInterval = proto.Clone(m.GetMaxInterval()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *PreviousPriorities) Clone() proto.Message {
+	var target *PreviousPriorities
+	if m == nil {
+		return target
+	}
+	target = &PreviousPriorities{}
+
+	target.UpdateFrequency = m.GetUpdateFrequency()
+
 	return target
 }
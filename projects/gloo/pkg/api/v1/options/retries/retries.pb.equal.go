@@ -64,5 +64,133 @@ func (m *RetryPolicy) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetRetryBackOff()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRetryBackOff()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRetryBackOff(), target.GetRetryBackOff()) {
+			return false
+		}
+	}
+
+	if len(m.GetRetriableStatusCodes()) != len(target.GetRetriableStatusCodes()) {
+		return false
+	}
+	for idx, v := range m.GetRetriableStatusCodes() {
+
+		if v != target.GetRetriableStatusCodes()[idx] {
+			return false
+		}
+
+	}
+
+	if len(m.GetRetriableRequestHeaders()) != len(target.GetRetriableRequestHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetRetriableRequestHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRetriableRequestHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetRetriableRequestHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if m.GetRetryPreviousHosts() != target.GetRetryPreviousHosts() {
+		return false
+	}
+
+	if m.GetHostSelectionRetryMaxAttempts() != target.GetHostSelectionRetryMaxAttempts() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetPreviousPriorities()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPreviousPriorities()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPreviousPriorities(), target.GetPreviousPriorities()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *RetryBackOff) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*RetryBackOff)
+	if !ok {
+		that2, ok := that.(RetryBackOff)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetBaseInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetBaseInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetBaseInterval(), target.GetBaseInterval()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMaxInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxInterval(), target.GetMaxInterval()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *PreviousPriorities) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*PreviousPriorities)
+	if !ok {
+		that2, ok := that.(PreviousPriorities)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetUpdateFrequency() != target.GetUpdateFrequency() {
+		return false
+	}
+
 	return true
 }
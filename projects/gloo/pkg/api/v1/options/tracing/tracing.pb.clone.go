@@ -106,6 +106,18 @@ func (m *ListenerTracingSettings) Clone() proto.Message {
 			}
 		}
 
+	case *ListenerTracingSettings_OpenTelemetryConfig:
+
+		if h, ok := interface{}(m.GetOpenTelemetryConfig()).(clone.Cloner); ok {
+			target.ProviderConfig = &ListenerTracingSettings_OpenTelemetryConfig{
+				OpenTelemetryConfig: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_trace_v3.OpenTelemetryConfig),
+			}
+		} else {
+			target.ProviderConfig = &ListenerTracingSettings_OpenTelemetryConfig{
+				OpenTelemetryConfig: proto.Clone(m.GetOpenTelemetryConfig()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_trace_v3.OpenTelemetryConfig),
+			}
+		}
+
 	}
 
 	return target
@@ -14,6 +14,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	github_com_golang_protobuf_ptypes_any "github.com/golang/protobuf/ptypes/any"
+
+	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 // ensure the imports are used
@@ -59,6 +61,10 @@ func (m *WasmFilter) Clone() proto.Message {
 	}
 	target = &WasmFilter{}
 
+	target.ArtifactKey = m.GetArtifactKey()
+
+	target.Sha256 = m.GetSha256()
+
 	if h, ok := interface{}(m.GetConfig()).(clone.Cloner); ok {
 		target.Config = h.Clone().(*github_com_golang_protobuf_ptypes_any.Any)
 	} else {
@@ -91,6 +97,18 @@ func (m *WasmFilter) Clone() proto.Message {
 			FilePath: m.GetFilePath(),
 		}
 
+	case *WasmFilter_ArtifactRef:
+
+		if h, ok := interface{}(m.GetArtifactRef()).(clone.Cloner); ok {
+			target.Src = &WasmFilter_ArtifactRef{
+				ArtifactRef: h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		} else {
+			target.Src = &WasmFilter_ArtifactRef{
+				ArtifactRef: proto.Clone(m.GetArtifactRef()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef),
+			}
+		}
+
 	}
 
 	return target
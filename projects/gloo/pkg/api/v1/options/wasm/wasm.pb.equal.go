@@ -87,6 +87,14 @@ func (m *WasmFilter) Equal(that interface{}) bool {
 		return false
 	}
 
+	if strings.Compare(m.GetArtifactKey(), target.GetArtifactKey()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetSha256(), target.GetSha256()) != 0 {
+		return false
+	}
+
 	if h, ok := interface{}(m.GetConfig()).(equality.Equalizer); ok {
 		if !h.Equal(target.GetConfig()) {
 			return false
@@ -139,6 +147,21 @@ func (m *WasmFilter) Equal(that interface{}) bool {
 			return false
 		}
 
+	case *WasmFilter_ArtifactRef:
+		if _, ok := target.Src.(*WasmFilter_ArtifactRef); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetArtifactRef()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetArtifactRef()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetArtifactRef(), target.GetArtifactRef()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.Src != target.Src {
@@ -78,6 +78,14 @@ func (m *WasmFilter) Hash(hasher hash.Hash64) (uint64, error) {
 		return 0, err
 	}
 
+	if _, err = hasher.Write([]byte(m.GetArtifactKey())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetSha256())); err != nil {
+		return 0, err
+	}
+
 	if h, ok := interface{}(m.GetConfig()).(safe_hasher.SafeHasher); ok {
 		if _, err = hasher.Write([]byte("Config")); err != nil {
 			return 0, err
@@ -145,6 +153,28 @@ func (m *WasmFilter) Hash(hasher hash.Hash64) (uint64, error) {
 			return 0, err
 		}
 
+	case *WasmFilter_ArtifactRef:
+
+		if h, ok := interface{}(m.GetArtifactRef()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("ArtifactRef")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetArtifactRef(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("ArtifactRef")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	return hasher.Sum64(), nil
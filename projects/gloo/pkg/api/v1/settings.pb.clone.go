@@ -387,6 +387,48 @@ func (m *GlooOptions) Clone() proto.Message {
 		target.FailoverUpstreamDnsPollingInterval = proto.Clone(m.GetFailoverUpstreamDnsPollingInterval()).(*github_com_golang_protobuf_ptypes_duration.Duration)
 	}
 
+	if h, ok := interface{}(m.GetProxyTranslationConcurrency()).(clone.Cloner); ok {
+		target.ProxyTranslationConcurrency = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.ProxyTranslationConcurrency = proto.Clone(m.GetProxyTranslationConcurrency()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetPruneUnreferencedClusters()).(clone.Cloner); ok {
+		target.PruneUnreferencedClusters = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.PruneUnreferencedClusters = proto.Clone(m.GetPruneUnreferencedClusters()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
+	target.AdminApiBindAddr = m.GetAdminApiBindAddr()
+
+	if h, ok := interface{}(m.GetXdsSnapshotHistorySize()).(clone.Cloner); ok {
+		target.XdsSnapshotHistorySize = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.XdsSnapshotHistorySize = proto.Clone(m.GetXdsSnapshotHistorySize()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetDisableNackRollback()).(clone.Cloner); ok {
+		target.DisableNackRollback = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.DisableNackRollback = proto.Clone(m.GetDisableNackRollback()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
+	target.LastAckedSnapshotDir = m.GetLastAckedSnapshotDir()
+
+	if h, ok := interface{}(m.GetControlPlaneTracing()).(clone.Cloner); ok {
+		target.ControlPlaneTracing = h.Clone().(*GlooOptions_ControlPlaneTracing)
+	} else {
+		target.ControlPlaneTracing = proto.Clone(m.GetControlPlaneTracing()).(*GlooOptions_ControlPlaneTracing)
+	}
+
+	target.TlsSecretDelivery = m.GetTlsSecretDelivery()
+
+	if h, ok := interface{}(m.GetCertificateExpiryWarningWindow()).(clone.Cloner); ok {
+		target.CertificateExpiryWarningWindow = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.CertificateExpiryWarningWindow = proto.Clone(m.GetCertificateExpiryWarningWindow()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
 	return target
 }
 
@@ -660,6 +702,8 @@ func (m *Settings_KubernetesConfiguration) Clone() proto.Message {
 		target.RateLimits = proto.Clone(m.GetRateLimits()).(*Settings_KubernetesConfiguration_RateLimits)
 	}
 
+	target.EndpointsApi = m.GetEndpointsApi()
+
 	return target
 }
 
@@ -841,6 +885,34 @@ func (m *GlooOptions_InvalidConfigPolicy) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *GlooOptions_ControlPlaneTracing) Clone() proto.Message {
+	var target *GlooOptions_ControlPlaneTracing
+	if m == nil {
+		return target
+	}
+	target = &GlooOptions_ControlPlaneTracing{}
+
+	target.OtlpCollectorAddr = m.GetOtlpCollectorAddr()
+
+	if m.GetResourceAttributes() != nil {
+		target.ResourceAttributes = make(map[string]string, len(m.GetResourceAttributes()))
+		for k, v := range m.GetResourceAttributes() {
+
+			target.ResourceAttributes[k] = v
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetSamplingProbability()).(clone.Cloner); ok {
+		target.SamplingProbability = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.SamplingProbability = proto.Clone(m.GetSamplingProbability()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
+	return target
+}
+
 // Clone function
 func (m *GatewayOptions_ValidationOptions) Clone() proto.Message {
 	var target *GatewayOptions_ValidationOptions
@@ -887,5 +959,11 @@ func (m *GatewayOptions_ValidationOptions) Clone() proto.Message {
 		target.ValidationServerGrpcMaxSizeBytes = proto.Clone(m.GetValidationServerGrpcMaxSizeBytes()).(*github_com_golang_protobuf_ptypes_wrappers.Int32Value)
 	}
 
+	if h, ok := interface{}(m.GetFullEnvoyValidation()).(clone.Cloner); ok {
+		target.FullEnvoyValidation = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.FullEnvoyValidation = proto.Clone(m.GetFullEnvoyValidation()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
 	return target
 }
@@ -582,6 +582,78 @@ func (m *GlooOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetProxyTranslationConcurrency()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetProxyTranslationConcurrency()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetProxyTranslationConcurrency(), target.GetProxyTranslationConcurrency()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetPruneUnreferencedClusters()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPruneUnreferencedClusters()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPruneUnreferencedClusters(), target.GetPruneUnreferencedClusters()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetAdminApiBindAddr(), target.GetAdminApiBindAddr()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetXdsSnapshotHistorySize()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetXdsSnapshotHistorySize()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetXdsSnapshotHistorySize(), target.GetXdsSnapshotHistorySize()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetDisableNackRollback()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDisableNackRollback()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDisableNackRollback(), target.GetDisableNackRollback()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetLastAckedSnapshotDir(), target.GetLastAckedSnapshotDir()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetControlPlaneTracing()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetControlPlaneTracing()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetControlPlaneTracing(), target.GetControlPlaneTracing()) {
+			return false
+		}
+	}
+
+	if m.GetTlsSecretDelivery() != target.GetTlsSecretDelivery() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetCertificateExpiryWarningWindow()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCertificateExpiryWarningWindow()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCertificateExpiryWarningWindow(), target.GetCertificateExpiryWarningWindow()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -1132,6 +1204,10 @@ func (m *Settings_KubernetesConfiguration) Equal(that interface{}) bool {
 		}
 	}
 
+	if m.GetEndpointsApi() != target.GetEndpointsApi() {
+		return false
+	}
+
 	return true
 }
 
@@ -1460,6 +1536,55 @@ func (m *GlooOptions_InvalidConfigPolicy) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *GlooOptions_ControlPlaneTracing) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GlooOptions_ControlPlaneTracing)
+	if !ok {
+		that2, ok := that.(GlooOptions_ControlPlaneTracing)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetOtlpCollectorAddr(), target.GetOtlpCollectorAddr()) != 0 {
+		return false
+	}
+
+	if len(m.GetResourceAttributes()) != len(target.GetResourceAttributes()) {
+		return false
+	}
+	for k, v := range m.GetResourceAttributes() {
+
+		if strings.Compare(v, target.GetResourceAttributes()[k]) != 0 {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetSamplingProbability()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSamplingProbability()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSamplingProbability(), target.GetSamplingProbability()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *GatewayOptions_ValidationOptions) Equal(that interface{}) bool {
 	if that == nil {
@@ -1547,5 +1672,15 @@ func (m *GatewayOptions_ValidationOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetFullEnvoyValidation()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetFullEnvoyValidation()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetFullEnvoyValidation(), target.GetFullEnvoyValidation()) {
+			return false
+		}
+	}
+
 	return true
 }
@@ -878,6 +878,139 @@ func (m *GlooOptions) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetProxyTranslationConcurrency()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("ProxyTranslationConcurrency")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetProxyTranslationConcurrency(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("ProxyTranslationConcurrency")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetPruneUnreferencedClusters()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("PruneUnreferencedClusters")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetPruneUnreferencedClusters(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("PruneUnreferencedClusters")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if _, err = hasher.Write([]byte(m.GetAdminApiBindAddr())); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetXdsSnapshotHistorySize()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("XdsSnapshotHistorySize")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetXdsSnapshotHistorySize(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("XdsSnapshotHistorySize")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetDisableNackRollback()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("DisableNackRollback")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetDisableNackRollback(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("DisableNackRollback")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if _, err = hasher.Write([]byte(m.GetLastAckedSnapshotDir())); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetControlPlaneTracing()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("ControlPlaneTracing")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetControlPlaneTracing(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("ControlPlaneTracing")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetTlsSecretDelivery())
+	if err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetCertificateExpiryWarningWindow()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("CertificateExpiryWarningWindow")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetCertificateExpiryWarningWindow(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("CertificateExpiryWarningWindow")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -1440,6 +1573,11 @@ func (m *Settings_KubernetesConfiguration) Hash(hasher hash.Hash64) (uint64, err
 		}
 	}
 
+	err = binary.Write(hasher, binary.LittleEndian, m.GetEndpointsApi())
+	if err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -1781,6 +1919,69 @@ func (m *GlooOptions_InvalidConfigPolicy) Hash(hasher hash.Hash64) (uint64, erro
 	return hasher.Sum64(), nil
 }
 
+// Hash function
+func (m *GlooOptions_ControlPlaneTracing) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.GlooOptions_ControlPlaneTracing")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetOtlpCollectorAddr())); err != nil {
+		return 0, err
+	}
+
+	{
+		var result uint64
+		innerHash := fnv.New64()
+		for k, v := range m.GetResourceAttributes() {
+			innerHash.Reset()
+
+			if _, err = innerHash.Write([]byte(v)); err != nil {
+				return 0, err
+			}
+
+			if _, err = innerHash.Write([]byte(k)); err != nil {
+				return 0, err
+			}
+
+			result = result ^ innerHash.Sum64()
+		}
+		err = binary.Write(hasher, binary.LittleEndian, result)
+		if err != nil {
+			return 0, err
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetSamplingProbability()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("SamplingProbability")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetSamplingProbability(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("SamplingProbability")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
 // Hash function
 func (m *GatewayOptions_ValidationOptions) Hash(hasher hash.Hash64) (uint64, error) {
 	if m == nil {
@@ -1911,5 +2112,25 @@ func (m *GatewayOptions_ValidationOptions) Hash(hasher hash.Hash64) (uint64, err
 		}
 	}
 
+	if h, ok := interface{}(m.GetFullEnvoyValidation()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("FullEnvoyValidation")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetFullEnvoyValidation(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("FullEnvoyValidation")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
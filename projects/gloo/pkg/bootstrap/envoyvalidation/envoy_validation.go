@@ -0,0 +1,148 @@
+package envoyvalidation
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+
+	envoy_config_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	v34 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_filters_network_http_connection_manager_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+const defaultEnvoyPath = "/usr/local/bin/envoy"
+
+func getEnvoyPath() string {
+	ep := os.Getenv("ENVOY_BINARY_PATH")
+	if len(ep) == 0 {
+		ep = defaultEnvoyPath
+	}
+	return ep
+}
+
+// RejectedError is the error returned when envoy rejects a bootstrap, as opposed to the errors preventing envoy from
+// validating it.
+type RejectedError struct {
+	// The output of envoy
+	Output string
+	Err    error
+}
+
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("envoy validation mode output: %v, error: %v", e.Output, e.Err)
+}
+
+// IsRejected returns whether the error is envoy rejecting a bootstrap.
+func IsRejected(err error) bool {
+	var rejected *RejectedError
+	return errors.As(err, &rejected)
+}
+
+// ValidateBootstrap validates the bootstrap by running the local envoy binary in validate mode.
+// The error is a *RejectedError if envoy rejects the bootstrap.
+// The bootstrap is passed to envoy through a temporary file, as bootstraps built from a whole snapshot may not fit in
+// the command line.
+func ValidateBootstrap(ctx context.Context, bootstrap *envoy_config_bootstrap_v3.Bootstrap) error {
+	buf := &bytes.Buffer{}
+	marshaler := &jsonpb.Marshaler{
+		OrigName: true,
+	}
+	if err := marshaler.Marshal(buf, bootstrap); err != nil {
+		return err
+	}
+	configFile, err := os.CreateTemp("", "envoy-validation-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(configFile.Name())
+	_, err = configFile.Write(buf.Bytes())
+	if closeErr := configFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	envoyPath := getEnvoyPath()
+	validateCmd := exec.Command(envoyPath, "--mode", "validate", "--config-path", configFile.Name(), "-l", "critical", "--log-format", "%v")
+	if output, err := validateCmd.CombinedOutput(); err != nil {
+		if os.IsNotExist(err) {
+			// log a warning and return nil; will allow users to continue to run Gloo locally without
+			// relying on the Gloo container with Envoy already published to the expected directory
+			contextutils.LoggerFrom(ctx).Warnf("Unable to validate envoy configuration using envoy at %v; "+
+				"skipping additional validation of Gloo config.", envoyPath)
+			return nil
+		}
+		if _, ok := err.(*exec.ExitError); ok {
+			return &RejectedError{Output: string(output), Err: err}
+		}
+		return eris.Wrapf(err, "running envoy at %v", envoyPath)
+	}
+	return nil
+}
+
+// BuildVirtualHostBootstrap returns a bootstrap with a placeholder listener serving the virtual hosts.
+// The clusters the routes of the virtual hosts reference are not required to exist.
+func BuildVirtualHostBootstrap(vhosts []*envoy_config_route_v3.VirtualHost) (*envoy_config_bootstrap_v3.Bootstrap, error) {
+	rc := &envoy_config_route_v3.RouteConfiguration{
+		VirtualHosts:     vhosts,
+		ValidateClusters: &wrappers.BoolValue{Value: false},
+	}
+
+	hcm := &envoy_extensions_filters_network_http_connection_manager_v3.HttpConnectionManager{
+		StatPrefix:     "placeholder",
+		RouteSpecifier: &envoy_extensions_filters_network_http_connection_manager_v3.HttpConnectionManager_RouteConfig{RouteConfig: rc},
+	}
+
+	hcmAny, err := utils.MessageToAny(hcm)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_bootstrap_v3.Bootstrap{
+		Node: validationNode(),
+		StaticResources: &envoy_config_bootstrap_v3.Bootstrap_StaticResources{
+			Listeners: []*v34.Listener{
+				{
+					Name: "placeholder_listener",
+					Address: &v3.Address{
+						Address: &v3.Address_SocketAddress{SocketAddress: &v3.SocketAddress{
+							Address:       "0.0.0.0",
+							PortSpecifier: &v3.SocketAddress_PortValue{PortValue: 8081},
+						}},
+					},
+					FilterChains: []*v34.FilterChain{
+						{
+							Name: "placeholder_filter_chain",
+							Filters: []*v34.Filter{
+								{
+									ConfigType: &v34.Filter_TypedConfig{
+										TypedConfig: hcmAny,
+									},
+									Name: wellknown.HTTPConnectionManager,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func validationNode() *v3.Node {
+	return &v3.Node{
+		Id:      "imspecial",
+		Cluster: "doesntmatter",
+	}
+}
@@ -0,0 +1,164 @@
+package envoyvalidation
+
+import (
+	envoy_config_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+// BuildStaticBootstrap returns a bootstrap serving the xDS resources translated for a proxy as static resources,
+// so that envoy can validate them without a control plane:
+//   - the route configurations are inlined in the http connection managers referencing them over RDS,
+//   - EDS clusters become static clusters without endpoints,
+//   - the secrets served by gloo over SDS are added as static secrets.
+//
+// The resources are copied, so are not modified.
+func BuildStaticBootstrap(
+	clusters []*envoy_config_cluster_v3.Cluster,
+	listeners []*envoy_config_listener_v3.Listener,
+	routeConfigs []*envoy_config_route_v3.RouteConfiguration,
+	secrets []*envoyauth.Secret,
+) (*envoy_config_bootstrap_v3.Bootstrap, error) {
+	routeConfigsByName := make(map[string]*envoy_config_route_v3.RouteConfiguration, len(routeConfigs))
+	for _, routeConfig := range routeConfigs {
+		routeConfigsByName[routeConfig.GetName()] = routeConfig
+	}
+
+	staticResources := &envoy_config_bootstrap_v3.Bootstrap_StaticResources{}
+	for _, cluster := range clusters {
+		cluster = proto.Clone(cluster).(*envoy_config_cluster_v3.Cluster)
+		if cluster.GetType() == envoy_config_cluster_v3.Cluster_EDS {
+			cluster.ClusterDiscoveryType = &envoy_config_cluster_v3.Cluster_Type{Type: envoy_config_cluster_v3.Cluster_STATIC}
+			cluster.EdsClusterConfig = nil
+			cluster.LoadAssignment = &envoy_config_endpoint_v3.ClusterLoadAssignment{ClusterName: cluster.GetName()}
+		}
+		if err := staticTransportSocket(cluster.GetTransportSocket()); err != nil {
+			return nil, err
+		}
+		for _, match := range cluster.GetTransportSocketMatches() {
+			if err := staticTransportSocket(match.GetTransportSocket()); err != nil {
+				return nil, err
+			}
+		}
+		staticResources.Clusters = append(staticResources.GetClusters(), cluster)
+	}
+	for _, listener := range listeners {
+		// empty listeners are not sent to envoy
+		if len(listener.GetFilterChains()) < 1 {
+			continue
+		}
+		listener = proto.Clone(listener).(*envoy_config_listener_v3.Listener)
+		filterChains := listener.GetFilterChains()
+		if listener.GetDefaultFilterChain() != nil {
+			filterChains = append(filterChains, listener.GetDefaultFilterChain())
+		}
+		for _, filterChain := range filterChains {
+			if err := staticTransportSocket(filterChain.GetTransportSocket()); err != nil {
+				return nil, err
+			}
+			for _, filter := range filterChain.GetFilters() {
+				if err := inlineRouteConfig(filter, routeConfigsByName); err != nil {
+					return nil, err
+				}
+			}
+		}
+		staticResources.Listeners = append(staticResources.GetListeners(), listener)
+	}
+	for _, secret := range secrets {
+		staticResources.Secrets = append(staticResources.GetSecrets(), proto.Clone(secret).(*envoyauth.Secret))
+	}
+
+	return &envoy_config_bootstrap_v3.Bootstrap{
+		Node:            validationNode(),
+		StaticResources: staticResources,
+	}, nil
+}
+
+// inlineRouteConfig replaces the RDS configuration of an http connection manager with the route configuration it
+// references. Like route configurations served over RDS, the inlined route configuration does not require the
+// clusters its routes reference to exist.
+func inlineRouteConfig(
+	filter *envoy_config_listener_v3.Filter,
+	routeConfigsByName map[string]*envoy_config_route_v3.RouteConfiguration,
+) error {
+	if filter.GetName() != wellknown.HTTPConnectionManager || filter.GetTypedConfig() == nil {
+		return nil
+	}
+	hcm := &envoyhcm.HttpConnectionManager{}
+	if err := ptypes.UnmarshalAny(filter.GetTypedConfig(), hcm); err != nil {
+		return err
+	}
+	if hcm.GetRds() == nil {
+		return nil
+	}
+	routeConfigName := hcm.GetRds().GetRouteConfigName()
+	routeConfig := &envoy_config_route_v3.RouteConfiguration{Name: routeConfigName}
+	// route configurations without virtual hosts are not sent to envoy
+	if rc, ok := routeConfigsByName[routeConfigName]; ok {
+		routeConfig = proto.Clone(rc).(*envoy_config_route_v3.RouteConfiguration)
+	}
+	routeConfig.ValidateClusters = &wrappers.BoolValue{Value: false}
+	hcm.RouteSpecifier = &envoyhcm.HttpConnectionManager_RouteConfig{RouteConfig: routeConfig}
+
+	hcmAny, err := utils.MessageToAny(hcm)
+	if err != nil {
+		return err
+	}
+	filter.ConfigType = &envoy_config_listener_v3.Filter_TypedConfig{TypedConfig: hcmAny}
+	return nil
+}
+
+// staticTransportSocket makes a TLS transport socket reference the static secrets in place of the secrets gloo
+// serves over SDS. Secrets served by other SDS servers are left as is.
+func staticTransportSocket(transportSocket *envoy_config_core_v3.TransportSocket) error {
+	if transportSocket.GetTypedConfig() == nil {
+		return nil
+	}
+	msg, err := utils.AnyToMessage(transportSocket.GetTypedConfig())
+	if err != nil {
+		// not a TLS transport socket
+		return nil
+	}
+	var common *envoyauth.CommonTlsContext
+	switch tlsContext := msg.(type) {
+	case *envoyauth.UpstreamTlsContext:
+		common = tlsContext.GetCommonTlsContext()
+	case *envoyauth.DownstreamTlsContext:
+		common = tlsContext.GetCommonTlsContext()
+	default:
+		return nil
+	}
+
+	sdsConfigs := append([]*envoyauth.SdsSecretConfig{
+		common.GetValidationContextSdsSecretConfig(),
+		common.GetCombinedValidationContext().GetValidationContextSdsSecretConfig(),
+	}, common.GetTlsCertificateSdsSecretConfigs()...)
+	modified := false
+	for _, sdsConfig := range sdsConfigs {
+		if utils.IsGlooSds(sdsConfig) {
+			// secrets without a config source are looked up in the static secrets
+			sdsConfig.SdsConfig = nil
+			modified = true
+		}
+	}
+	if !modified {
+		return nil
+	}
+
+	typedConfig, err := utils.MessageToAny(msg)
+	if err != nil {
+		return err
+	}
+	transportSocket.ConfigType = &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig}
+	return nil
+}
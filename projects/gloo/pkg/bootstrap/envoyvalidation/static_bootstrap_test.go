@@ -0,0 +1,123 @@
+package envoyvalidation_test
+
+import (
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/envoyvalidation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	. "github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("BuildStaticBootstrap", func() {
+
+	var (
+		routeConfig *envoy_config_route_v3.RouteConfiguration
+		cluster     *envoy_config_cluster_v3.Cluster
+		listener    *envoy_config_listener_v3.Listener
+		secret      *envoyauth.Secret
+	)
+
+	glooSds := func(name string) *envoyauth.SdsSecretConfig {
+		return &envoyauth.SdsSecretConfig{
+			Name: name,
+			SdsConfig: &envoy_config_core_v3.ConfigSource{
+				ResourceApiVersion:    envoy_config_core_v3.ApiVersion_V3,
+				ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_Ads{},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		routeConfig = &envoy_config_route_v3.RouteConfiguration{
+			Name: "listener-routes",
+			VirtualHosts: []*envoy_config_route_v3.VirtualHost{{
+				Name:    "vhost",
+				Domains: []string{"*"},
+			}},
+		}
+		cluster = &envoy_config_cluster_v3.Cluster{
+			Name:                 "eds-cluster",
+			ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_Type{Type: envoy_config_cluster_v3.Cluster_EDS},
+			EdsClusterConfig:     &envoy_config_cluster_v3.Cluster_EdsClusterConfig{ServiceName: "eds-cluster"},
+			TransportSocket: &envoy_config_core_v3.TransportSocket{
+				Name: wellknown.TransportSocketTls,
+				ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{
+					TypedConfig: utils.MustMessageToAny(&envoyauth.UpstreamTlsContext{
+						CommonTlsContext: &envoyauth.CommonTlsContext{
+							TlsCertificateSdsSecretConfigs: []*envoyauth.SdsSecretConfig{glooSds("cert")},
+						},
+					}),
+				},
+			},
+		}
+		listener = &envoy_config_listener_v3.Listener{
+			Name: "listener",
+			FilterChains: []*envoy_config_listener_v3.FilterChain{{
+				Filters: []*envoy_config_listener_v3.Filter{{
+					Name: wellknown.HTTPConnectionManager,
+					ConfigType: &envoy_config_listener_v3.Filter_TypedConfig{
+						TypedConfig: utils.MustMessageToAny(&envoyhcm.HttpConnectionManager{
+							StatPrefix: "http",
+							RouteSpecifier: &envoyhcm.HttpConnectionManager_Rds{
+								Rds: &envoyhcm.Rds{RouteConfigName: "listener-routes"},
+							},
+						}),
+					},
+				}},
+			}},
+		}
+		secret = &envoyauth.Secret{Name: "cert"}
+	})
+
+	build := func() *envoy_config_listener_v3.Listener {
+		bootstrap, err := BuildStaticBootstrap(
+			[]*envoy_config_cluster_v3.Cluster{cluster},
+			[]*envoy_config_listener_v3.Listener{listener},
+			[]*envoy_config_route_v3.RouteConfiguration{routeConfig},
+			[]*envoyauth.Secret{secret},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bootstrap.GetStaticResources().GetSecrets()).To(ConsistOf(MatchProto(secret)))
+		Expect(bootstrap.GetStaticResources().GetClusters()).To(HaveLen(1))
+		Expect(bootstrap.GetStaticResources().GetListeners()).To(HaveLen(1))
+		return bootstrap.GetStaticResources().GetListeners()[0]
+	}
+
+	It("inlines the route configurations referenced over RDS", func() {
+		staticListener := build()
+
+		hcm := utils.MustAnyToMessage(staticListener.GetFilterChains()[0].GetFilters()[0].GetTypedConfig()).(*envoyhcm.HttpConnectionManager)
+		expectedRouteConfig := &envoy_config_route_v3.RouteConfiguration{
+			Name:             routeConfig.GetName(),
+			VirtualHosts:     routeConfig.GetVirtualHosts(),
+			ValidateClusters: &wrappers.BoolValue{Value: false},
+		}
+		Expect(hcm.GetRouteConfig()).To(MatchProto(expectedRouteConfig))
+		// the listener of the snapshot is not modified
+		Expect(listener.GetFilterChains()[0].GetFilters()[0].GetTypedConfig()).NotTo(MatchProto(staticListener.GetFilterChains()[0].GetFilters()[0].GetTypedConfig()))
+	})
+
+	It("turns EDS clusters into static clusters referencing the static secrets", func() {
+		bootstrap, err := BuildStaticBootstrap([]*envoy_config_cluster_v3.Cluster{cluster}, nil, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		staticCluster := bootstrap.GetStaticResources().GetClusters()[0]
+		Expect(staticCluster.GetType()).To(Equal(envoy_config_cluster_v3.Cluster_STATIC))
+		Expect(staticCluster.GetEdsClusterConfig()).To(BeNil())
+		Expect(staticCluster.GetLoadAssignment()).To(MatchProto(&envoy_config_endpoint_v3.ClusterLoadAssignment{ClusterName: "eds-cluster"}))
+
+		tlsContext := utils.MustAnyToMessage(staticCluster.GetTransportSocket().GetTypedConfig()).(*envoyauth.UpstreamTlsContext)
+		Expect(tlsContext.GetCommonTlsContext().GetTlsCertificateSdsSecretConfigs()).To(ConsistOf(MatchProto(&envoyauth.SdsSecretConfig{Name: "cert"})))
+		// the cluster of the snapshot is not modified
+		Expect(cluster.GetType()).To(Equal(envoy_config_cluster_v3.Cluster_EDS))
+	})
+})
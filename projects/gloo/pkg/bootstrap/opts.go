@@ -7,7 +7,9 @@ import (
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/validation"
 
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
@@ -58,6 +60,10 @@ type ControlPlane struct {
 	*GrpcService
 	SnapshotCache cache.SnapshotCache
 	XDSServer     server.Server
+	// records the envoy nodes connected to the xDS server
+	NodeTracker *xds.NodeTracker
+	// serves the modules of the wasm filters to envoy, alongside the REST xDS server
+	WasmModules *wasm.ModuleStore
 }
 
 type ValidationServer struct {
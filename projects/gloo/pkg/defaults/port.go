@@ -11,6 +11,7 @@ var GlooAdminPort uint32 = 9091
 var GlooRestXdsPort = 9976
 var GlooXdsPort = 9977
 var GlooValidationPort = 9988
+var GlooAdminApiPort = 10010
 var GlooMtlsModeRestXdsPort = 9998
 var GlooMtlsModeXdsPort = 9999
 var DefaultRefreshRate = time.Minute
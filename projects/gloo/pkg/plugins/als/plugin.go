@@ -1,20 +1,28 @@
 package als
 
 import (
+	"sort"
+
 	envoyal "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyalfile "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
 	envoygrpc "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	envoyalotel "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoy_req_without_query "github.com/envoyproxy/go-control-plane/envoy/extensions/formatter/req_without_query/v3"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/rotisserie/eris"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	translatorutil "github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	otlpcommon "go.opentelemetry.io/proto/otlp/common/v1"
 )
 
 var (
@@ -25,6 +33,17 @@ var (
 const (
 	ExtensionName = "als"
 	ClusterName   = "access_log_cluster"
+
+	OpenTelemetryAccessLog = "envoy.access_loggers.open_telemetry"
+)
+
+var (
+	UnknownAccessLogFilterError = func(filter *als.AccessLogFilter) error {
+		return eris.Errorf("access log filter %v does not specify a filter", filter)
+	}
+	CollectorUpstreamNotFoundError = func(ref *core.ResourceRef) error {
+		return eris.Errorf("no upstream found for the OpenTelemetry collector upstream ref %v", ref)
+	}
 )
 
 type plugin struct{}
@@ -52,7 +71,7 @@ func (p *plugin) ProcessHcmNetworkFilter(params plugins.Params, parentListener *
 	}
 
 	var err error
-	out.AccessLog, err = ProcessAccessLogPlugins(alsSettings, out.GetAccessLog())
+	out.AccessLog, err = ProcessAccessLogPlugins(params, alsSettings, out.GetAccessLog())
 	return err
 }
 
@@ -61,36 +80,152 @@ func (p *plugin) ProcessHcmNetworkFilter(params plugins.Params, parentListener *
 // fine grained configuration of the HCM across multiple plugins. However, the TCP proxy is still configured
 // by the TCP plugin only. To keep our access logging translation in a single place, we expose this function
 // and the Tcp plugin calls out to it.
-func ProcessAccessLogPlugins(service *als.AccessLoggingService, logCfg []*envoyal.AccessLog) ([]*envoyal.AccessLog, error) {
+func ProcessAccessLogPlugins(params plugins.Params, service *als.AccessLoggingService, logCfg []*envoyal.AccessLog) ([]*envoyal.AccessLog, error) {
 	results := make([]*envoyal.AccessLog, 0, len(service.GetAccessLog()))
 	for _, al := range service.GetAccessLog() {
+		var newAlsCfg envoyal.AccessLog
 		switch cfgType := al.GetOutputDestination().(type) {
 		case *als.AccessLog_FileSink:
 			var cfg envoyalfile.FileAccessLog
 			if err := copyFileSettings(&cfg, cfgType); err != nil {
 				return nil, err
 			}
-			newAlsCfg, err := translatorutil.NewAccessLogWithConfig(wellknown.FileAccessLog, &cfg)
+			var err error
+			newAlsCfg, err = translatorutil.NewAccessLogWithConfig(wellknown.FileAccessLog, &cfg)
 			if err != nil {
 				return nil, err
 			}
-			results = append(results, &newAlsCfg)
 		case *als.AccessLog_GrpcService:
 			var cfg envoygrpc.HttpGrpcAccessLogConfig
 			if err := copyGrpcSettings(&cfg, cfgType); err != nil {
 				return nil, err
 			}
-			newAlsCfg, err := translatorutil.NewAccessLogWithConfig(wellknown.HTTPGRPCAccessLog, &cfg)
+			var err error
+			newAlsCfg, err = translatorutil.NewAccessLogWithConfig(wellknown.HTTPGRPCAccessLog, &cfg)
 			if err != nil {
 				return nil, err
 			}
-			results = append(results, &newAlsCfg)
+		case *als.AccessLog_OpenTelemetryService:
+			var cfg envoyalotel.OpenTelemetryAccessLogConfig
+			if err := copyOpenTelemetrySettings(params, &cfg, cfgType); err != nil {
+				return nil, err
+			}
+			var err error
+			newAlsCfg, err = translatorutil.NewAccessLogWithConfig(OpenTelemetryAccessLog, &cfg)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			continue
 		}
+
+		if al.GetFilter() != nil {
+			filter, err := translateFilter(params, al.GetFilter())
+			if err != nil {
+				return nil, err
+			}
+			newAlsCfg.Filter = filter
+			if err := newAlsCfg.Validate(); err != nil {
+				return nil, err
+			}
+		}
+		results = append(results, &newAlsCfg)
 	}
 	logCfg = append(logCfg, results...)
 	return logCfg, nil
 }
 
+func translateFilter(params plugins.Params, in *als.AccessLogFilter) (*envoyal.AccessLogFilter, error) {
+	out := &envoyal.AccessLogFilter{}
+	switch filter := in.GetFilterSpecifier().(type) {
+	case *als.AccessLogFilter_StatusCodeFilter:
+		out.FilterSpecifier = &envoyal.AccessLogFilter_StatusCodeFilter{
+			StatusCodeFilter: &envoyal.StatusCodeFilter{
+				Comparison: translateComparison(filter.StatusCodeFilter.GetComparison()),
+			},
+		}
+	case *als.AccessLogFilter_DurationFilter:
+		out.FilterSpecifier = &envoyal.AccessLogFilter_DurationFilter{
+			DurationFilter: &envoyal.DurationFilter{
+				Comparison: translateComparison(filter.DurationFilter.GetComparison()),
+			},
+		}
+	case *als.AccessLogFilter_NotHealthCheckFilter:
+		out.FilterSpecifier = &envoyal.AccessLogFilter_NotHealthCheckFilter{
+			NotHealthCheckFilter: &envoyal.NotHealthCheckFilter{},
+		}
+	case *als.AccessLogFilter_RuntimeFilter:
+		runtimeFilter := &envoyal.RuntimeFilter{
+			RuntimeKey:               filter.RuntimeFilter.GetRuntimeKey(),
+			UseIndependentRandomness: filter.RuntimeFilter.GetUseIndependentRandomness(),
+		}
+		if percentSampled := filter.RuntimeFilter.GetPercentSampled(); percentSampled != nil {
+			runtimeFilter.PercentSampled = &envoytype.FractionalPercent{
+				Numerator:   percentSampled.GetNumerator(),
+				Denominator: envoytype.FractionalPercent_DenominatorType(percentSampled.GetDenominator()),
+			}
+		}
+		out.FilterSpecifier = &envoyal.AccessLogFilter_RuntimeFilter{
+			RuntimeFilter: runtimeFilter,
+		}
+	case *als.AccessLogFilter_AndFilter:
+		filters, err := translateFilters(params, filter.AndFilter.GetFilters())
+		if err != nil {
+			return nil, err
+		}
+		out.FilterSpecifier = &envoyal.AccessLogFilter_AndFilter{
+			AndFilter: &envoyal.AndFilter{Filters: filters},
+		}
+	case *als.AccessLogFilter_OrFilter:
+		filters, err := translateFilters(params, filter.OrFilter.GetFilters())
+		if err != nil {
+			return nil, err
+		}
+		out.FilterSpecifier = &envoyal.AccessLogFilter_OrFilter{
+			OrFilter: &envoyal.OrFilter{Filters: filters},
+		}
+	case *als.AccessLogFilter_HeaderFilter:
+		headerFilter := &envoyal.HeaderFilter{}
+		if header := filter.HeaderFilter.GetHeader(); header != nil {
+			headerFilter.Header = pluginutils.EnvoyHeaderMatchers(params.Ctx, []*matchers.HeaderMatcher{header})[0]
+		}
+		out.FilterSpecifier = &envoyal.AccessLogFilter_HeaderFilter{
+			HeaderFilter: headerFilter,
+		}
+	default:
+		return nil, UnknownAccessLogFilterError(in)
+	}
+	return out, nil
+}
+
+func translateFilters(params plugins.Params, in []*als.AccessLogFilter) ([]*envoyal.AccessLogFilter, error) {
+	out := make([]*envoyal.AccessLogFilter, 0, len(in))
+	for _, filter := range in {
+		envoyFilter, err := translateFilter(params, filter)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, envoyFilter)
+	}
+	return out, nil
+}
+
+func translateComparison(in *als.ComparisonFilter) *envoyal.ComparisonFilter {
+	if in == nil {
+		return nil
+	}
+	out := &envoyal.ComparisonFilter{
+		Op: envoyal.ComparisonFilter_Op(in.GetOp()),
+	}
+	if value := in.GetValue(); value != nil {
+		out.Value = &envoycore.RuntimeUInt32{
+			DefaultValue: value.GetDefaultValue(),
+			RuntimeKey:   value.GetRuntimeKey(),
+		}
+	}
+	return out
+}
+
 func copyGrpcSettings(cfg *envoygrpc.HttpGrpcAccessLogConfig, alsSettings *als.AccessLog_GrpcService) error {
 	if alsSettings.GrpcService == nil {
 		return eris.New("grpc service object cannot be nil")
@@ -114,6 +249,48 @@ func copyGrpcSettings(cfg *envoygrpc.HttpGrpcAccessLogConfig, alsSettings *als.A
 	return cfg.Validate()
 }
 
+func copyOpenTelemetrySettings(params plugins.Params, cfg *envoyalotel.OpenTelemetryAccessLogConfig, alsSettings *als.AccessLog_OpenTelemetryService) error {
+	upstreamRef := alsSettings.OpenTelemetryService.GetCollectorUpstreamRef()
+	if upstreamRef == nil {
+		return eris.New("OpenTelemetry collector upstream ref cannot be nil")
+	}
+	if _, err := params.Snapshot.Upstreams.Find(upstreamRef.GetNamespace(), upstreamRef.GetName()); err != nil {
+		return CollectorUpstreamNotFoundError(upstreamRef)
+	}
+
+	cfg.CommonConfig = &envoygrpc.CommonGrpcAccessLogConfig{
+		LogName: alsSettings.OpenTelemetryService.GetLogName(),
+		GrpcService: &envoycore.GrpcService{
+			TargetSpecifier: &envoycore.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &envoycore.GrpcService_EnvoyGrpc{
+					ClusterName: translatorutil.UpstreamToClusterName(upstreamRef),
+				},
+			},
+		},
+		TransportApiVersion: envoycore.ApiVersion_V3,
+	}
+	if body := alsSettings.OpenTelemetryService.GetBody(); body != "" {
+		cfg.Body = &otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_StringValue{StringValue: body}}
+	}
+
+	attributes := alsSettings.OpenTelemetryService.GetAttributes()
+	if len(attributes) > 0 {
+		keys := make([]string, 0, len(attributes))
+		for key := range attributes {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		cfg.Attributes = &otlpcommon.KeyValueList{}
+		for _, key := range keys {
+			cfg.Attributes.Values = append(cfg.Attributes.Values, &otlpcommon.KeyValue{
+				Key:   key,
+				Value: &otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_StringValue{StringValue: attributes[key]}},
+			})
+		}
+	}
+	return cfg.Validate()
+}
+
 func copyFileSettings(cfg *envoyalfile.FileAccessLog, alsSettings *als.AccessLog_FileSink) error {
 	cfg.Path = alsSettings.FileSink.GetPath()
 
@@ -1,13 +1,22 @@
 package als_test
 
 import (
+	envoyal "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoyalfile "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
+	envoyalotel "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
 	envoy_extensions_filters_network_http_connection_manager_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	envoycore_sk "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	envoytype_sk "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	gloomatchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
@@ -64,7 +73,7 @@ var _ = Describe("Plugin", func() {
 			})
 
 			It("works", func() {
-				accessLogConfigs, err := ProcessAccessLogPlugins(alsSettings, nil)
+				accessLogConfigs, err := ProcessAccessLogPlugins(plugins.Params{}, alsSettings, nil)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(accessLogConfigs).To(HaveLen(1))
@@ -85,6 +94,246 @@ var _ = Describe("Plugin", func() {
 
 		})
 
+		Context("opentelemetry", func() {
+
+			var (
+				params plugins.Params
+				usRef  *core.ResourceRef
+			)
+
+			BeforeEach(func() {
+				usRef = &core.ResourceRef{
+					Name:      "otel-collector",
+					Namespace: "default",
+				}
+				params = plugins.Params{
+					Snapshot: &v1snap.ApiSnapshot{
+						Upstreams: v1.UpstreamList{{Metadata: &core.Metadata{Name: usRef.GetName(), Namespace: usRef.GetNamespace()}}},
+					},
+				}
+				alsSettings = &als.AccessLoggingService{
+					AccessLog: []*als.AccessLog{
+						{
+							OutputDestination: &als.AccessLog_OpenTelemetryService{
+								OpenTelemetryService: &als.OpenTelemetryService{
+									LogName:              "test",
+									CollectorUpstreamRef: usRef,
+									Body:                 "%REQ(:METHOD)% %RESPONSE_CODE%",
+									Attributes: map[string]string{
+										"upstream": "%UPSTREAM_HOST%",
+										"path":     "%REQ(:PATH)%",
+									},
+								},
+							},
+						},
+					},
+				}
+			})
+
+			It("works", func() {
+				accessLogConfigs, err := ProcessAccessLogPlugins(params, alsSettings, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(accessLogConfigs).To(HaveLen(1))
+				alConfig := accessLogConfigs[0]
+
+				Expect(alConfig.Name).To(Equal(OpenTelemetryAccessLog))
+				var otelCfg envoyalotel.OpenTelemetryAccessLogConfig
+				err = translatorutil.ParseTypedConfig(alConfig, &otelCfg)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(otelCfg.GetCommonConfig().GetLogName()).To(Equal("test"))
+				Expect(otelCfg.GetCommonConfig().GetGrpcService().GetEnvoyGrpc().GetClusterName()).To(Equal(translatorutil.UpstreamToClusterName(usRef)))
+				Expect(otelCfg.GetBody().GetStringValue()).To(Equal("%REQ(:METHOD)% %RESPONSE_CODE%"))
+				// the attributes are sorted by key
+				Expect(otelCfg.GetAttributes().GetValues()).To(HaveLen(2))
+				Expect(otelCfg.GetAttributes().GetValues()[0].GetKey()).To(Equal("path"))
+				Expect(otelCfg.GetAttributes().GetValues()[0].GetValue().GetStringValue()).To(Equal("%REQ(:PATH)%"))
+				Expect(otelCfg.GetAttributes().GetValues()[1].GetKey()).To(Equal("upstream"))
+			})
+
+			It("errors when the collector upstream does not exist", func() {
+				params.Snapshot.Upstreams = nil
+				_, err := ProcessAccessLogPlugins(params, alsSettings, nil)
+				Expect(err).To(MatchError(CollectorUpstreamNotFoundError(usRef).Error()))
+			})
+		})
+
+		Context("filters", func() {
+
+			BeforeEach(func() {
+				alsSettings = &als.AccessLoggingService{
+					AccessLog: []*als.AccessLog{
+						{
+							OutputDestination: &als.AccessLog_FileSink{
+								FileSink: &als.FileSink{
+									Path: "/dev/stdout",
+								},
+							},
+						},
+					},
+				}
+			})
+
+			statusCodeFilter := func(op als.ComparisonFilter_Op, code uint32) *als.AccessLogFilter {
+				return &als.AccessLogFilter{
+					FilterSpecifier: &als.AccessLogFilter_StatusCodeFilter{
+						StatusCodeFilter: &als.StatusCodeFilter{
+							Comparison: &als.ComparisonFilter{
+								Op:    op,
+								Value: &envoycore_sk.RuntimeUInt32{DefaultValue: code, RuntimeKey: "access_log.status_code"},
+							},
+						},
+					},
+				}
+			}
+
+			It("translates and/or combinations of filters", func() {
+				alsSettings.AccessLog[0].Filter = &als.AccessLogFilter{
+					FilterSpecifier: &als.AccessLogFilter_AndFilter{
+						AndFilter: &als.AndFilter{
+							Filters: []*als.AccessLogFilter{
+								{
+									FilterSpecifier: &als.AccessLogFilter_NotHealthCheckFilter{
+										NotHealthCheckFilter: &als.NotHealthCheckFilter{},
+									},
+								},
+								{
+									FilterSpecifier: &als.AccessLogFilter_OrFilter{
+										OrFilter: &als.OrFilter{
+											Filters: []*als.AccessLogFilter{
+												statusCodeFilter(als.ComparisonFilter_GE, 500),
+												{
+													FilterSpecifier: &als.AccessLogFilter_HeaderFilter{
+														HeaderFilter: &als.HeaderFilter{
+															Header: &gloomatchers.HeaderMatcher{Name: "x-debug"},
+														},
+													},
+												},
+												{
+													FilterSpecifier: &als.AccessLogFilter_RuntimeFilter{
+														RuntimeFilter: &als.RuntimeFilter{
+															RuntimeKey: "access_log.sampling",
+															PercentSampled: &envoytype_sk.FractionalPercent{
+																Numerator:   10,
+																Denominator: envoytype_sk.FractionalPercent_TEN_THOUSAND,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				accessLogConfigs, err := ProcessAccessLogPlugins(plugins.Params{}, alsSettings, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(accessLogConfigs).To(HaveLen(1))
+				Expect(accessLogConfigs[0].GetFilter()).To(matchers.MatchProto(&envoyal.AccessLogFilter{
+					FilterSpecifier: &envoyal.AccessLogFilter_AndFilter{
+						AndFilter: &envoyal.AndFilter{
+							Filters: []*envoyal.AccessLogFilter{
+								{
+									FilterSpecifier: &envoyal.AccessLogFilter_NotHealthCheckFilter{
+										NotHealthCheckFilter: &envoyal.NotHealthCheckFilter{},
+									},
+								},
+								{
+									FilterSpecifier: &envoyal.AccessLogFilter_OrFilter{
+										OrFilter: &envoyal.OrFilter{
+											Filters: []*envoyal.AccessLogFilter{
+												{
+													FilterSpecifier: &envoyal.AccessLogFilter_StatusCodeFilter{
+														StatusCodeFilter: &envoyal.StatusCodeFilter{
+															Comparison: &envoyal.ComparisonFilter{
+																Op:    envoyal.ComparisonFilter_GE,
+																Value: &envoycore.RuntimeUInt32{DefaultValue: 500, RuntimeKey: "access_log.status_code"},
+															},
+														},
+													},
+												},
+												{
+													FilterSpecifier: &envoyal.AccessLogFilter_HeaderFilter{
+														HeaderFilter: &envoyal.HeaderFilter{
+															Header: &envoyroute.HeaderMatcher{
+																Name:                 "x-debug",
+																HeaderMatchSpecifier: &envoyroute.HeaderMatcher_PresentMatch{PresentMatch: true},
+															},
+														},
+													},
+												},
+												{
+													FilterSpecifier: &envoyal.AccessLogFilter_RuntimeFilter{
+														RuntimeFilter: &envoyal.RuntimeFilter{
+															RuntimeKey: "access_log.sampling",
+															PercentSampled: &envoytype.FractionalPercent{
+																Numerator:   10,
+																Denominator: envoytype.FractionalPercent_TEN_THOUSAND,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}))
+			})
+
+			It("translates duration filters", func() {
+				alsSettings.AccessLog[0].Filter = &als.AccessLogFilter{
+					FilterSpecifier: &als.AccessLogFilter_DurationFilter{
+						DurationFilter: &als.DurationFilter{
+							Comparison: &als.ComparisonFilter{
+								Op:    als.ComparisonFilter_GE,
+								Value: &envoycore_sk.RuntimeUInt32{DefaultValue: 1000, RuntimeKey: "access_log.duration"},
+							},
+						},
+					},
+				}
+
+				accessLogConfigs, err := ProcessAccessLogPlugins(plugins.Params{}, alsSettings, nil)
+				Expect(err).NotTo(HaveOccurred())
+				comparison := accessLogConfigs[0].GetFilter().GetDurationFilter().GetComparison()
+				Expect(comparison.GetOp()).To(Equal(envoyal.ComparisonFilter_GE))
+				Expect(comparison.GetValue().GetDefaultValue()).To(BeEquivalentTo(1000))
+			})
+
+			It("errors on an and filter with a single filter", func() {
+				alsSettings.AccessLog[0].Filter = &als.AccessLogFilter{
+					FilterSpecifier: &als.AccessLogFilter_AndFilter{
+						AndFilter: &als.AndFilter{
+							Filters: []*als.AccessLogFilter{statusCodeFilter(als.ComparisonFilter_GE, 400)},
+						},
+					},
+				}
+
+				_, err := ProcessAccessLogPlugins(plugins.Params{}, alsSettings, nil)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("errors on a comparison without a runtime key", func() {
+				alsSettings.AccessLog[0].Filter = statusCodeFilter(als.ComparisonFilter_EQ, 200)
+				alsSettings.AccessLog[0].Filter.GetStatusCodeFilter().GetComparison().GetValue().RuntimeKey = ""
+
+				_, err := ProcessAccessLogPlugins(plugins.Params{}, alsSettings, nil)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("errors on an empty filter", func() {
+				alsSettings.AccessLog[0].Filter = &als.AccessLogFilter{}
+
+				_, err := ProcessAccessLogPlugins(plugins.Params{}, alsSettings, nil)
+				Expect(err).To(MatchError(ContainSubstring("does not specify a filter")))
+			})
+		})
+
 		Context("file", func() {
 
 			var (
@@ -125,7 +374,7 @@ var _ = Describe("Plugin", func() {
 				})
 
 				It("works", func() {
-					accessLogConfigs, err := ProcessAccessLogPlugins(alsSettings, nil)
+					accessLogConfigs, err := ProcessAccessLogPlugins(plugins.Params{}, alsSettings, nil)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(accessLogConfigs).To(HaveLen(1))
@@ -160,7 +409,7 @@ var _ = Describe("Plugin", func() {
 				})
 
 				It("works", func() {
-					accessLogConfigs, err := ProcessAccessLogPlugins(alsSettings, nil)
+					accessLogConfigs, err := ProcessAccessLogPlugins(plugins.Params{}, alsSettings, nil)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(accessLogConfigs).To(HaveLen(1))
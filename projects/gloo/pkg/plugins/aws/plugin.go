@@ -22,7 +22,6 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
-	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/contextutils"
@@ -45,8 +44,7 @@ const (
 var pluginStage = plugins.DuringStage(plugins.OutAuthStage)
 
 type plugin struct {
-	recordedUpstreams map[string]*aws.UpstreamSpec
-	ctx               context.Context
+	ctx context.Context
 	// earlyTransformsAdded is intended to point to the RequireEarlyTransformation property
 	// in the transformation plugin, which controls whether early-stage transforms will be processed
 	// see AWS plugin instantiation at the following link as an example:
@@ -68,7 +66,6 @@ func (p *plugin) Name() string {
 
 func (p *plugin) Init(params plugins.InitParams) error {
 	p.ctx = params.Ctx
-	p.recordedUpstreams = make(map[string]*aws.UpstreamSpec)
 	p.settings = params.Settings.GetGloo().GetAwsOptions()
 	p.upstreamOptions = params.Settings.GetUpstreamOptions()
 	return nil
@@ -84,9 +81,6 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		// not ours
 		return nil
 	}
-	// even if it failed, route should still be valid
-	p.recordedUpstreams[translator.UpstreamToClusterName(in.GetMetadata().Ref())] = upstreamSpec.Aws
-
 	lambdaHostname := getLambdaHostname(upstreamSpec.Aws)
 
 	// configure Envoy cluster routing info
@@ -185,8 +179,9 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 				contextutils.LoggerFrom(p.ctx).Error(err)
 				return nil, err
 			}
-			lambdaSpec, ok := p.recordedUpstreams[translator.UpstreamToClusterName(upstreamRef)]
-			if !ok {
+			upstream, _ := params.Snapshot.Upstreams.Find(upstreamRef.GetNamespace(), upstreamRef.GetName())
+			lambdaSpec := upstream.GetAws()
+			if lambdaSpec == nil {
 				err := errors.Errorf("%v is not an AWS upstream", *upstreamRef)
 				contextutils.LoggerFrom(p.ctx).Error(err)
 				return nil, err
@@ -317,8 +312,8 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 	)
 }
 
-func (p *plugin) HttpFilters(_ plugins.Params, _ *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
-	if len(p.recordedUpstreams) == 0 {
+func (p *plugin) HttpFilters(params plugins.Params, _ *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if !hasAwsUpstream(params.Snapshot.Upstreams) {
 		// no upstreams no filter
 		return nil, nil
 	}
@@ -346,3 +341,12 @@ func (p *plugin) HttpFilters(_ plugins.Params, _ *v1.HttpListener) ([]plugins.St
 
 	return filters, nil
 }
+
+func hasAwsUpstream(upstreams v1.UpstreamList) bool {
+	for _, us := range upstreams {
+		if us.GetAws() != nil {
+			return true
+		}
+	}
+	return false
+}
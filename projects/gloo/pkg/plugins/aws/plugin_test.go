@@ -107,6 +107,7 @@ var _ = Describe("Plugin", func() {
 
 		initParams = plugins.InitParams{}
 		params.Snapshot = &v1snap.ApiSnapshot{
+			Upstreams: v1.UpstreamList{upstream},
 			Secrets: v1.SecretList{{
 				Metadata: &core.Metadata{
 					Name:      "secretref",
@@ -355,6 +356,7 @@ var _ = Describe("Plugin", func() {
 		})
 
 		It("should not produce filters when no upstreams are present", func() {
+			params.Snapshot.Upstreams = nil
 			filters, err := awsPlugin.(plugins.HttpFilterPlugin).HttpFilters(params, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(filters).To(BeEmpty())
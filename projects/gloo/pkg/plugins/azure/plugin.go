@@ -17,7 +17,6 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
-	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/contextutils"
@@ -36,10 +35,8 @@ const (
 )
 
 type plugin struct {
-	settings          *v1.Settings
-	recordedUpstreams map[string]*azure.UpstreamSpec
-	apiKeys           map[string]string
-	ctx               context.Context
+	settings *v1.Settings
+	ctx      context.Context
 }
 
 func NewPlugin() plugins.Plugin {
@@ -53,7 +50,6 @@ func (p *plugin) Name() string {
 func (p *plugin) Init(params plugins.InitParams) error {
 	p.settings = params.Settings
 	p.ctx = params.Ctx
-	p.recordedUpstreams = make(map[string]*azure.UpstreamSpec)
 	return nil
 }
 
@@ -64,7 +60,6 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		return nil
 	}
 	azureUpstream := upstreamSpec.Azure
-	p.recordedUpstreams[translator.UpstreamToClusterName(in.GetMetadata().Ref())] = azureUpstream
 
 	// configure Envoy cluster routing info
 	out.ClusterDiscoveryType = &envoy_config_cluster_v3.Cluster_Type{
@@ -91,15 +86,9 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	}
 
 	if azureUpstream.GetSecretRef().GetName() != "" {
-		secrets, err := params.Snapshot.Secrets.Find(azureUpstream.GetSecretRef().Strings())
-		if err != nil {
-			return errors.Wrapf(err, "azure secrets for ref %v not found", azureUpstream.GetSecretRef())
+		if _, err := getApiKeys(params.Snapshot.Secrets, azureUpstream); err != nil {
+			return err
 		}
-		azureSecrets, ok := secrets.GetKind().(*v1.Secret_Azure)
-		if !ok {
-			return errors.Errorf("secret %v is not an Azure secret", secrets.GetMetadata().Ref())
-		}
-		p.apiKeys = azureSecrets.Azure.GetApiKeys()
 	}
 
 	return nil
@@ -122,17 +111,22 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 				contextutils.LoggerFrom(p.ctx).Error(err)
 				return nil, err
 			}
-			upstreamSpec, ok := p.recordedUpstreams[translator.UpstreamToClusterName(upstreamRef)]
-			if !ok {
+			upstream, _ := params.Snapshot.Upstreams.Find(upstreamRef.GetNamespace(), upstreamRef.GetName())
+			upstreamSpec := upstream.GetAzure()
+			if upstreamSpec == nil {
 				// TODO(yuval-k): panic in debug
 				return nil, errors.Errorf("%v is not an Azure upstream", *upstreamRef)
 			}
+			apiKeys, err := getApiKeys(params.Snapshot.Secrets, upstreamSpec)
+			if err != nil {
+				return nil, err
+			}
 
 			// get function
 			functionName := azureDestinationSpec.Azure.GetFunctionName()
 			for _, functionSpec := range upstreamSpec.GetFunctions() {
 				if functionSpec.GetFunctionName() == functionName {
-					path, err := getPath(functionSpec, p.apiKeys)
+					path, err := getPath(functionSpec, apiKeys)
 					if err != nil {
 						return nil, err
 					}
@@ -167,6 +161,21 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 	)
 }
 
+func getApiKeys(secrets v1.SecretList, upstreamSpec *azure.UpstreamSpec) (map[string]string, error) {
+	if upstreamSpec.GetSecretRef().GetName() == "" {
+		return nil, nil
+	}
+	secret, err := secrets.Find(upstreamSpec.GetSecretRef().Strings())
+	if err != nil {
+		return nil, errors.Wrapf(err, "azure secrets for ref %v not found", upstreamSpec.GetSecretRef())
+	}
+	azureSecrets, ok := secret.GetKind().(*v1.Secret_Azure)
+	if !ok {
+		return nil, errors.Errorf("secret %v is not an Azure secret", secret.GetMetadata().Ref())
+	}
+	return azureSecrets.Azure.GetApiKeys(), nil
+}
+
 func getPath(functionSpec *azure.UpstreamSpec_FunctionSpec, apiKeys map[string]string) (string, error) {
 	functionName := functionSpec.GetFunctionName()
 
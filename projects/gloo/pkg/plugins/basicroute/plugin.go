@@ -1,7 +1,13 @@
 package basicroute
 
 import (
+	"context"
+	"math"
+	"strings"
+
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_previous_hosts_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
+	envoy_previous_priorities_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/priority/previous_priorities/v3"
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/solo-io/gloo/pkg/utils/regexutils"
@@ -10,7 +16,9 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/upgradeconfig"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/errors"
 )
 
@@ -21,6 +29,22 @@ var (
 
 const (
 	ExtensionName = "basic_route"
+
+	PreviousHostsPredicateName  = "envoy.retry_host_predicates.previous_hosts"
+	PreviousPrioritiesName      = "envoy.retry_priorities.previous_priorities"
+	RetriableStatusCodesRetryOn = "retriable-status-codes"
+)
+
+var (
+	InvalidRetryBackOffError = func(reason string) error {
+		return errors.Errorf("invalid retryBackOff: %v", reason)
+	}
+	InvalidRetriableStatusCodeError = func(code uint32) error {
+		return errors.Errorf("invalid retriable status code %v, must be between 100 and 599", code)
+	}
+	InvalidPreviousPrioritiesUpdateFrequencyError = func(updateFrequency uint32) error {
+		return errors.Errorf("invalid previousPriorities update frequency %v, must be greater than 0 and at most %v", updateFrequency, math.MaxInt32)
+	}
 )
 
 // Handles a RoutePlugin APIs which map directly to basic Envoy config
@@ -46,7 +70,7 @@ func (p *plugin) ProcessVirtualHost(
 	if in.GetOptions() == nil {
 		return nil
 	}
-	return applyRetriesVhost(in, out)
+	return applyRetriesVhost(params, in, out)
 }
 
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
@@ -62,8 +86,13 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 	if err := applyTimeout(in, out); err != nil {
 		return err
 	}
-	if err := applyRetries(in, out); err != nil {
-		return err
+	// configuration warnings on the retry policy are returned once the other options are applied
+	var warning error
+	if err := applyRetries(params, in, out); err != nil {
+		if !plugins.IsConfigurationWarning(err) {
+			return err
+		}
+		warning = err
 	}
 	if err := applyHostRewrite(in, out); err != nil {
 		return err
@@ -72,7 +101,7 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 		return err
 	}
 
-	return nil
+	return warning
 }
 
 func applyPrefixRewrite(in *v1.Route, out *envoy_config_route_v3.Route) error {
@@ -124,7 +153,7 @@ func applyTimeout(in *v1.Route, out *envoy_config_route_v3.Route) error {
 	return nil
 }
 
-func applyRetries(in *v1.Route, out *envoy_config_route_v3.Route) error {
+func applyRetries(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
 	policy := in.GetOptions().GetRetries()
 	if policy == nil {
 		return nil
@@ -138,8 +167,11 @@ func applyRetries(in *v1.Route, out *envoy_config_route_v3.Route) error {
 			"had nil route", in.GetAction())
 	}
 
-	routeAction.Route.RetryPolicy = convertPolicy(policy)
-	return nil
+	retryPolicy, err := convertPolicy(params.Ctx, policy)
+	if retryPolicy != nil {
+		routeAction.Route.RetryPolicy = retryPolicy
+	}
+	return err
 }
 
 func applyHostRewrite(in *v1.Route, out *envoy_config_route_v3.Route) error {
@@ -204,14 +236,19 @@ func applyUpgrades(in *v1.Route, out *envoy_config_route_v3.Route) error {
 	return upgradeconfig.ValidateRouteUpgradeConfigs(routeAction.Route.GetUpgradeConfigs())
 }
 
-func applyRetriesVhost(in *v1.VirtualHost, out *envoy_config_route_v3.VirtualHost) error {
-	out.RetryPolicy = convertPolicy(in.GetOptions().GetRetries())
-	return nil
+func applyRetriesVhost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoy_config_route_v3.VirtualHost) error {
+	retryPolicy, err := convertPolicy(params.Ctx, in.GetOptions().GetRetries())
+	if retryPolicy != nil {
+		out.RetryPolicy = retryPolicy
+	}
+	return err
 }
 
-func convertPolicy(policy *retries.RetryPolicy) *envoy_config_route_v3.RetryPolicy {
+// convertPolicy returns an error and no policy if the policy is invalid, or the policy along with a
+// configuration warning if it is valid but some of its options will not take effect.
+func convertPolicy(ctx context.Context, policy *retries.RetryPolicy) (*envoy_config_route_v3.RetryPolicy, error) {
 	if policy == nil {
-		return nil
+		return nil, nil
 	}
 
 	numRetries := policy.GetNumRetries()
@@ -219,11 +256,80 @@ func convertPolicy(policy *retries.RetryPolicy) *envoy_config_route_v3.RetryPoli
 		numRetries = 1
 	}
 
-	return &envoy_config_route_v3.RetryPolicy{
-		RetryOn:       policy.GetRetryOn(),
-		NumRetries:    &wrappers.UInt32Value{Value: numRetries},
-		PerTryTimeout: policy.GetPerTryTimeout(),
+	out := &envoy_config_route_v3.RetryPolicy{
+		RetryOn:                       policy.GetRetryOn(),
+		NumRetries:                    &wrappers.UInt32Value{Value: numRetries},
+		PerTryTimeout:                 policy.GetPerTryTimeout(),
+		RetriableStatusCodes:          policy.GetRetriableStatusCodes(),
+		RetriableRequestHeaders:       pluginutils.EnvoyHeaderMatchers(ctx, policy.GetRetriableRequestHeaders()),
+		HostSelectionRetryMaxAttempts: int64(policy.GetHostSelectionRetryMaxAttempts()),
+	}
+
+	if backOff := policy.GetRetryBackOff(); backOff != nil {
+		baseInterval, maxInterval := backOff.GetBaseInterval(), backOff.GetMaxInterval()
+		if baseInterval == nil || baseInterval.AsDuration() <= 0 {
+			return nil, InvalidRetryBackOffError("baseInterval must be greater than 0")
+		}
+		if maxInterval != nil && maxInterval.AsDuration() < baseInterval.AsDuration() {
+			return nil, InvalidRetryBackOffError("maxInterval must be greater than or equal to baseInterval")
+		}
+		out.RetryBackOff = &envoy_config_route_v3.RetryPolicy_RetryBackOff{
+			BaseInterval: baseInterval,
+			MaxInterval:  maxInterval,
+		}
+	}
+
+	for _, code := range policy.GetRetriableStatusCodes() {
+		if code < 100 || code > 599 {
+			return nil, InvalidRetriableStatusCodeError(code)
+		}
+	}
+
+	if policy.GetRetryPreviousHosts() {
+		out.RetryHostPredicate = []*envoy_config_route_v3.RetryPolicy_RetryHostPredicate{{
+			Name: PreviousHostsPredicateName,
+			ConfigType: &envoy_config_route_v3.RetryPolicy_RetryHostPredicate_TypedConfig{
+				TypedConfig: utils.MustMessageToAny(&envoy_previous_hosts_v3.PreviousHostsPredicate{}),
+			},
+		}}
+	}
+
+	if previousPriorities := policy.GetPreviousPriorities(); previousPriorities != nil {
+		updateFrequency := previousPriorities.GetUpdateFrequency()
+		if updateFrequency == 0 || updateFrequency > math.MaxInt32 {
+			return nil, InvalidPreviousPrioritiesUpdateFrequencyError(updateFrequency)
+		}
+		out.RetryPriority = &envoy_config_route_v3.RetryPolicy_RetryPriority{
+			Name: PreviousPrioritiesName,
+			ConfigType: &envoy_config_route_v3.RetryPolicy_RetryPriority_TypedConfig{
+				TypedConfig: utils.MustMessageToAny(&envoy_previous_priorities_v3.PreviousPrioritiesConfig{
+					UpdateFrequency: int32(updateFrequency),
+				}),
+			},
+		}
+	}
+
+	var warnings []string
+	if len(policy.GetRetriableStatusCodes()) > 0 && !retriesOn(policy.GetRetryOn(), RetriableStatusCodesRetryOn) {
+		warnings = append(warnings, "retriableStatusCodes are ignored, as retryOn does not contain "+RetriableStatusCodesRetryOn)
+	}
+	if policy.GetHostSelectionRetryMaxAttempts() > 0 && !policy.GetRetryPreviousHosts() {
+		warnings = append(warnings, "hostSelectionRetryMaxAttempts is ignored, as retryPreviousHosts is not set")
+	}
+	if len(warnings) > 0 {
+		return out, plugins.NewConfigurationWarning("retry policy: %v", strings.Join(warnings, "; "))
+	}
+	return out, nil
+}
+
+// retryOn is a comma-separated list of retry conditions
+func retriesOn(retryOn, condition string) bool {
+	for _, c := range strings.Split(retryOn, ",") {
+		if strings.TrimSpace(c) == condition {
+			return true
+		}
 	}
+	return false
 }
 
 func convertRegexMatchAndSubstitute(params plugins.RouteParams, in *v32.RegexMatchAndSubstitute) *envoy_type_matcher_v3.RegexMatchAndSubstitute {
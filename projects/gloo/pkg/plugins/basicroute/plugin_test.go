@@ -10,15 +10,20 @@ import (
 	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
 
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_previous_hosts_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
+	envoy_previous_priorities_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/priority/previous_priorities/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/basicroute"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+	. "github.com/solo-io/solo-kit/test/matchers"
 )
 
 var _ = Describe("prefix rewrite", func() {
@@ -307,6 +312,142 @@ var _ = Describe("retries", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(out.RetryPolicy).To(Equal(expectedRetryPolicy))
 	})
+
+	Context("with backoff, retriable status codes and predicates", func() {
+
+		var (
+			plugin      plugins.RoutePlugin
+			routeAction *envoy_config_route_v3.RouteAction
+			out         *envoy_config_route_v3.Route
+		)
+
+		BeforeEach(func() {
+			plugin = NewPlugin()
+			routeAction = &envoy_config_route_v3.RouteAction{}
+			out = &envoy_config_route_v3.Route{
+				Action: &envoy_config_route_v3.Route_Route{
+					Route: routeAction,
+				},
+			}
+		})
+
+		processRoute := func() error {
+			return plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+				Options: &v1.RouteOptions{
+					Retries: retryPolicy,
+				},
+			}, out)
+		}
+
+		It("works", func() {
+			base, max := prototime.DurationToProto(time.Second), prototime.DurationToProto(time.Minute)
+			retryPolicy.RetryOn = "5xx,retriable-status-codes"
+			retryPolicy.RetryBackOff = &retries.RetryBackOff{
+				BaseInterval: base,
+				MaxInterval:  max,
+			}
+			retryPolicy.RetriableStatusCodes = []uint32{409, 429}
+			retryPolicy.RetriableRequestHeaders = []*matchers.HeaderMatcher{
+				{Name: ":method", Value: "GET"},
+				{Name: "x-idempotent"},
+			}
+			retryPolicy.RetryPreviousHosts = true
+			retryPolicy.HostSelectionRetryMaxAttempts = 3
+			retryPolicy.PreviousPriorities = &retries.PreviousPriorities{UpdateFrequency: 2}
+
+			err := processRoute()
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedRetryPolicy.RetryOn = "5xx,retriable-status-codes"
+			expectedRetryPolicy.RetryBackOff = &envoy_config_route_v3.RetryPolicy_RetryBackOff{
+				BaseInterval: base,
+				MaxInterval:  max,
+			}
+			expectedRetryPolicy.RetriableStatusCodes = []uint32{409, 429}
+			expectedRetryPolicy.RetriableRequestHeaders = []*envoy_config_route_v3.HeaderMatcher{
+				{
+					Name:                 ":method",
+					HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: "GET"},
+				},
+				{
+					Name:                 "x-idempotent",
+					HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_PresentMatch{PresentMatch: true},
+				},
+			}
+			expectedRetryPolicy.RetryHostPredicate = []*envoy_config_route_v3.RetryPolicy_RetryHostPredicate{{
+				Name: PreviousHostsPredicateName,
+				ConfigType: &envoy_config_route_v3.RetryPolicy_RetryHostPredicate_TypedConfig{
+					TypedConfig: utils.MustMessageToAny(&envoy_previous_hosts_v3.PreviousHostsPredicate{}),
+				},
+			}}
+			expectedRetryPolicy.HostSelectionRetryMaxAttempts = 3
+			expectedRetryPolicy.RetryPriority = &envoy_config_route_v3.RetryPolicy_RetryPriority{
+				Name: PreviousPrioritiesName,
+				ConfigType: &envoy_config_route_v3.RetryPolicy_RetryPriority_TypedConfig{
+					TypedConfig: utils.MustMessageToAny(&envoy_previous_priorities_v3.PreviousPrioritiesConfig{UpdateFrequency: 2}),
+				},
+			}
+			Expect(routeAction.RetryPolicy).To(MatchProto(expectedRetryPolicy))
+		})
+
+		It("errors when the backoff base interval is missing", func() {
+			retryPolicy.RetryBackOff = &retries.RetryBackOff{MaxInterval: prototime.DurationToProto(time.Minute)}
+			err := processRoute()
+			Expect(err).To(MatchError(ContainSubstring("baseInterval must be greater than 0")))
+			Expect(plugins.IsConfigurationWarning(err)).To(BeFalse())
+			Expect(routeAction.RetryPolicy).To(BeNil())
+		})
+
+		It("errors when the backoff max interval is less than the base interval", func() {
+			retryPolicy.RetryBackOff = &retries.RetryBackOff{
+				BaseInterval: prototime.DurationToProto(time.Minute),
+				MaxInterval:  prototime.DurationToProto(time.Second),
+			}
+			err := processRoute()
+			Expect(err).To(MatchError(ContainSubstring("maxInterval must be greater than or equal to baseInterval")))
+		})
+
+		It("errors on invalid status codes", func() {
+			retryPolicy.RetryOn = "retriable-status-codes"
+			retryPolicy.RetriableStatusCodes = []uint32{503, 600}
+			err := processRoute()
+			Expect(err).To(MatchError(InvalidRetriableStatusCodeError(600).Error()))
+		})
+
+		It("errors on a zero previous priorities update frequency", func() {
+			retryPolicy.PreviousPriorities = &retries.PreviousPriorities{}
+			err := processRoute()
+			Expect(err).To(MatchError(InvalidPreviousPrioritiesUpdateFrequencyError(0).Error()))
+		})
+
+		It("warns when status codes would not be retried, and still applies the policy", func() {
+			retryPolicy.RetryOn = "5xx"
+			retryPolicy.RetriableStatusCodes = []uint32{409}
+			err := processRoute()
+			Expect(plugins.IsConfigurationWarning(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("retriableStatusCodes are ignored")))
+			Expect(routeAction.RetryPolicy.GetRetriableStatusCodes()).To(Equal([]uint32{409}))
+		})
+
+		It("warns when host selection attempts are set without retrying previous hosts", func() {
+			retryPolicy.HostSelectionRetryMaxAttempts = 3
+			err := processRoute()
+			Expect(plugins.IsConfigurationWarning(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("hostSelectionRetryMaxAttempts is ignored")))
+		})
+
+		It("applies the other route options when warning", func() {
+			retryPolicy.HostSelectionRetryMaxAttempts = 3
+			err := plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+				Options: &v1.RouteOptions{
+					Retries:         retryPolicy,
+					HostRewriteType: &v1.RouteOptions_HostRewrite{HostRewrite: "foo"},
+				},
+			}, out)
+			Expect(plugins.IsConfigurationWarning(err)).To(BeTrue())
+			Expect(routeAction.GetHostRewriteLiteral()).To(Equal("foo"))
+		})
+	})
 })
 
 var _ = Describe("host rewrite", func() {
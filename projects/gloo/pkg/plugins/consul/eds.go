@@ -349,6 +349,17 @@ func buildEndpoint(namespace, address, ipAddress string, service *consulapi.Cata
 		Port:        uint32(service.ServicePort),
 		Hostname:    hostname,
 		HealthCheck: healthCheckConfig,
+		// consul datacenters usually map to regions, so each datacenter is a locality
+		Locality: buildLocality(service.Datacenter),
+	}
+}
+
+func buildLocality(dataCenter string) *v1.Locality {
+	if dataCenter == "" {
+		return nil
+	}
+	return &v1.Locality{
+		Region: dataCenter,
 	}
 }
 
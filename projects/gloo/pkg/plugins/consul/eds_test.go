@@ -657,6 +657,7 @@ var _ = Describe("Consul EDS", func() {
 				Upstreams: []*core.ResourceRef{upstream.Metadata.Ref()},
 				Address:   "127.0.0.1",
 				Port:      1234,
+				Locality:  &v1.Locality{Region: "dc-1"},
 			}))
 		})
 
@@ -699,6 +700,7 @@ var _ = Describe("Consul EDS", func() {
 				Port:        1234,
 				Hostname:    "hostname.foo.com",
 				HealthCheck: &v1.HealthCheckConfig{Hostname: "hostname.foo.com"},
+				Locality:    &v1.Locality{Region: "dc-1"},
 			}))
 		})
 
@@ -742,6 +744,7 @@ var _ = Describe("Consul EDS", func() {
 				Port:        1234,
 				Hostname:    "my.address.io",
 				HealthCheck: &v1.HealthCheckConfig{Hostname: "my.address.io"},
+				Locality:    &v1.Locality{Region: "dc-1"},
 			}))
 
 			failErr := eris.New("fail")
@@ -769,6 +772,7 @@ var _ = Describe("Consul EDS", func() {
 				Port:        1234,
 				Hostname:    "my.address.io",
 				HealthCheck: &v1.HealthCheckConfig{Hostname: "my.address.io"},
+				Locality:    &v1.Locality{Region: "dc-1"},
 			}))
 		})
 
@@ -827,6 +831,13 @@ func createExpectedEndpoint(name, usname, hostname, ipAddress, version, ns strin
 		HealthCheck: healthCheckConfig,
 	}
 
+	// the locality of the endpoint is the datacenter of its consul service
+	for key, value := range labels {
+		if strings.HasPrefix(key, ConsulDataCenterKeyPrefix) && value == ConsulEndpointMetadataMatchTrue {
+			ep.Locality = &v1.Locality{Region: strings.TrimPrefix(key, ConsulDataCenterKeyPrefix)}
+		}
+	}
+
 	for _, svc := range strings.Split(usname, ",") {
 		ep.Upstreams = append(ep.Upstreams, &core.ResourceRef{
 			Name:      "consul-svc:" + svc,
@@ -20,14 +20,10 @@ const (
 
 	AdvancedHttpExtensionName          = "advanced_http"
 	DlpExtensionName                   = "dlp"
-	FailoverExtensionName              = "failover"
-	JwtExtensionName                   = "jwt"
 	LeftmostXffAddressExtensionName    = "leftmost_xff_address"
 	ProxyLatencyExtensionName          = "proxy_latency"
-	RbacExtensionName                  = "rbac"
 	SanitizeClusterHeaderExtensionName = "sanitize_cluster_header"
 	WafExtensionName                   = "waf"
-	WasmExtensionName                  = "wasm"
 )
 
 // The EnterpriseWarning plugin is responsible for identifying Enterprise config that a non-Enterprise
@@ -63,14 +59,6 @@ func (p *plugin) ProcessVirtualHost(
 ) error {
 	var enterpriseExtensions []string
 
-	if isJwtConfiguredOnVirtualHost(in) {
-		enterpriseExtensions = append(enterpriseExtensions, JwtExtensionName)
-	}
-
-	if isRbacConfiguredOnVirtualHost(in) {
-		enterpriseExtensions = append(enterpriseExtensions, RbacExtensionName)
-	}
-
 	if isWafConfiguredOnVirtualHost(in) {
 		enterpriseExtensions = append(enterpriseExtensions, WafExtensionName)
 	}
@@ -81,14 +69,6 @@ func (p *plugin) ProcessVirtualHost(
 func (p *plugin) ProcessRoute(_ plugins.RouteParams, in *v1.Route, _ *envoy_config_route_v3.Route) error {
 	var enterpriseExtensions []string
 
-	if isJwtConfiguredOnRoute(in) {
-		enterpriseExtensions = append(enterpriseExtensions, JwtExtensionName)
-	}
-
-	if isRbacConfiguredOnRoute(in) {
-		enterpriseExtensions = append(enterpriseExtensions, RbacExtensionName)
-	}
-
 	if isWafConfiguredOnRoute(in) {
 		enterpriseExtensions = append(enterpriseExtensions, WafExtensionName)
 	}
@@ -103,10 +83,6 @@ func (p *plugin) ProcessUpstream(_ plugins.Params, in *v1.Upstream, _ *envoy_con
 		enterpriseExtensions = append(enterpriseExtensions, AdvancedHttpExtensionName)
 	}
 
-	if isFailoverConfiguredOnUpstream(in) {
-		enterpriseExtensions = append(enterpriseExtensions, FailoverExtensionName)
-	}
-
 	return GetErrorForEnterpriseOnlyExtensions(enterpriseExtensions)
 }
 
@@ -133,10 +109,6 @@ func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plu
 		enterpriseExtensions = append(enterpriseExtensions, WafExtensionName)
 	}
 
-	if isWasmConfiguredOnListener(listener) {
-		enterpriseExtensions = append(enterpriseExtensions, WasmExtensionName)
-	}
-
 	return nil, GetErrorForEnterpriseOnlyExtensions(enterpriseExtensions)
 }
 
@@ -169,24 +141,6 @@ func isDlpConfiguredOnListener(in *v1.HttpListener) bool {
 	return in.GetOptions().GetDlp() != nil
 }
 
-//
-// failover
-//
-func isFailoverConfiguredOnUpstream(in *v1.Upstream) bool {
-	return in.GetFailover() != nil
-}
-
-//
-// jwt
-//
-func isJwtConfiguredOnVirtualHost(in *v1.VirtualHost) bool {
-	return in.GetOptions().GetJwtConfig() != nil
-}
-
-func isJwtConfiguredOnRoute(in *v1.Route) bool {
-	return in.GetOptions().GetJwtConfig() != nil
-}
-
 //
 // leftmost_xff_address
 //
@@ -201,17 +155,6 @@ func isProxyLatencyConfiguredOnListener(in *v1.HttpListener) bool {
 	return in.GetOptions().GetProxyLatency() != nil
 }
 
-//
-// rbac
-//
-func isRbacConfiguredOnVirtualHost(in *v1.VirtualHost) bool {
-	return in.GetOptions().GetRbac() != nil
-}
-
-func isRbacConfiguredOnRoute(in *v1.Route) bool {
-	return in.GetOptions().GetRbac() != nil
-}
-
 //
 // sanitize_cluster_header
 //
@@ -233,10 +176,3 @@ func isWafConfiguredOnRoute(in *v1.Route) bool {
 func isWafConfiguredOnListener(in *v1.HttpListener) bool {
 	return in.GetOptions().GetWaf() != nil
 }
-
-//
-// wasm
-//
-func isWasmConfiguredOnListener(in *v1.HttpListener) bool {
-	return in.GetOptions().GetWasm() != nil
-}
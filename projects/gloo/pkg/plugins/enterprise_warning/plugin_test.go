@@ -9,12 +9,9 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/proxylatency"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/dlp"
-	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
-	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/advanced_http"
 	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
-	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/enterprise_warning"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -112,63 +109,6 @@ var _ = Describe("enterprise_warning plugin", func() {
 
 	})
 
-	Context("failover", func() {
-
-		It("should not process upstream if failover config is nil", func() {
-			p := NewPlugin()
-			err := p.ProcessUpstream(plugins.Params{}, &v1.Upstream{}, nil)
-			Expect(err).NotTo(HaveOccurred())
-		})
-
-		It("will err if failover is configured on process upstream", func() {
-			p := NewPlugin()
-			err := p.ProcessUpstream(plugins.Params{}, &v1.Upstream{Failover: &v1.Failover{}}, nil)
-			ExpectEnterpriseOnlyErr(err)
-		})
-
-	})
-
-	Context("jwt", func() {
-
-		It("should not add filter if jwt config is nil", func() {
-			p := NewPlugin()
-			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{}, &envoy_config_route.VirtualHost{})
-			Expect(err).NotTo(HaveOccurred())
-		})
-
-		It("will err if jwt is configured", func() {
-			p := NewPlugin()
-			virtualHost := &v1.VirtualHost{
-				Name:    "virt1",
-				Domains: []string{"*"},
-				Options: &v1.VirtualHostOptions{
-					JwtConfig: &v1.VirtualHostOptions_JwtStaged{
-						JwtStaged: &jwt.JwtStagedVhostExtension{},
-					},
-				},
-			}
-
-			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, virtualHost, &envoy_config_route.VirtualHost{})
-			ExpectEnterpriseOnlyErr(err)
-		})
-
-		It("will err if jwt is configured", func() {
-			p := NewPlugin()
-			route := &v1.Route{
-				Name: "route1",
-				Options: &v1.RouteOptions{
-					JwtConfig: &v1.RouteOptions_JwtStaged{
-						JwtStaged: &jwt.JwtStagedRouteExtension{},
-					},
-				},
-			}
-
-			err := p.ProcessRoute(plugins.RouteParams{}, route, &envoy_config_route.Route{})
-			ExpectEnterpriseOnlyErr(err)
-		})
-
-	})
-
 	Context("leftmost_xff_address", func() {
 
 		It("should not add filter if leftmost xff header config is nil", func() {
@@ -217,43 +157,6 @@ var _ = Describe("enterprise_warning plugin", func() {
 
 	})
 
-	Context("rbac", func() {
-
-		It("should not add filter if rbac config is nil", func() {
-			p := NewPlugin()
-			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{}, &envoy_config_route.VirtualHost{})
-			Expect(err).NotTo(HaveOccurred())
-		})
-
-		It("will err if rbac is configured on vhost", func() {
-			p := NewPlugin()
-			virtualHost := &v1.VirtualHost{
-				Name:    "virt1",
-				Domains: []string{"*"},
-				Options: &v1.VirtualHostOptions{
-					Rbac: &rbac.ExtensionSettings{},
-				},
-			}
-
-			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, virtualHost, &envoy_config_route.VirtualHost{})
-			ExpectEnterpriseOnlyErr(err)
-		})
-
-		It("will err if rbac is configured on route", func() {
-			p := NewPlugin()
-			virtualHost := &v1.Route{
-				Name: "route1",
-				Options: &v1.RouteOptions{
-					Rbac: &rbac.ExtensionSettings{},
-				},
-			}
-
-			err := p.ProcessRoute(plugins.RouteParams{}, virtualHost, &envoy_config_route.Route{})
-			ExpectEnterpriseOnlyErr(err)
-		})
-
-	})
-
 	Context("sanitize_cluster_header", func() {
 
 		It("should not add filter if sanitize cluster header config is nil", func() {
@@ -328,42 +231,4 @@ var _ = Describe("enterprise_warning plugin", func() {
 
 	})
 
-	Context("wasm", func() {
-
-		var (
-			p plugins.HttpFilterPlugin
-		)
-
-		BeforeEach(func() {
-			p = NewPlugin()
-		})
-
-		It("should not add filter if wasm config is nil", func() {
-			f, err := p.HttpFilters(plugins.Params{}, nil)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(f).To(BeNil())
-		})
-
-		It("will err if wasm is configured", func() {
-			image := "hello"
-			hl := &v1.HttpListener{
-				Options: &v1.HttpListenerOptions{
-					Wasm: &wasm.PluginSource{
-						Filters: []*wasm.WasmFilter{
-							{
-								Src: &wasm.WasmFilter_Image{
-									Image: image,
-								},
-							},
-						},
-					},
-				},
-			}
-
-			f, err := p.HttpFilters(plugins.Params{}, hl)
-			ExpectEnterpriseOnlyErr(err)
-			Expect(f).To(BeNil())
-		})
-	})
-
 })
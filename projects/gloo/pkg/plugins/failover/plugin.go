@@ -0,0 +1,218 @@
+package failover
+
+import (
+	"fmt"
+	"net"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	pbgostruct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/loadbalancer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var (
+	_ plugins.Plugin         = new(plugin)
+	_ plugins.UpstreamPlugin = new(plugin)
+	_ plugins.EndpointPlugin = new(plugin)
+)
+
+const ExtensionName = "failover"
+
+var (
+	NoHealthChecksWarning = func(upstream string) *plugins.ConfigurationWarning {
+		return plugins.NewConfigurationWarning("upstream %v uses failover without health checks or outlier detection, "+
+			"so its failover endpoints are never used", upstream)
+	}
+
+	EdsHostnameError = func(address string) error {
+		return eris.Errorf("failover endpoint %v must be an IP address, as the upstream uses EDS", address)
+	}
+)
+
+// The failover plugin adds the prioritized localities of an upstream's failover config to its load assignment, at
+// lower priorities than its own endpoints: the localities of the first entry at priority 1, and so on.
+// The load assignment is the one of the cluster if inlined, and the one sent over EDS otherwise.
+type plugin struct {
+	sslConfigTranslator utils.SslConfigTranslator
+}
+
+func NewPlugin(sslConfigTranslator utils.SslConfigTranslator) *plugin {
+	return &plugin{sslConfigTranslator: sslConfigTranslator}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) error {
+	return nil
+}
+
+// The endpoints with an ssl config are matched by a transport socket match of the cluster
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	failover := in.GetFailover()
+	if failover == nil {
+		return nil
+	}
+
+	for priority, prioritizedLocality := range failover.GetPrioritizedLocalities() {
+		for _, localityEndpoints := range prioritizedLocality.GetLocalityEndpoints() {
+			for _, endpoint := range localityEndpoints.GetLbEndpoints() {
+				sslConfig := endpoint.GetUpstreamSslConfig()
+				if sslConfig == nil {
+					continue
+				}
+				tlsContext, err := p.sslConfigTranslator.ResolveUpstreamSslConfig(params.Snapshot.Secrets, sslConfig)
+				if err != nil {
+					return eris.Wrapf(err, "failover endpoint %v", endpoint.GetAddress())
+				}
+				out.TransportSocketMatches = append(out.GetTransportSocketMatches(), &envoy_config_cluster_v3.Cluster_TransportSocketMatch{
+					Name:  matchName(priority+1, endpoint),
+					Match: metadataMatch(priority+1, endpoint),
+					TransportSocket: &envoy_config_core_v3.TransportSocket{
+						Name:       wellknown.TransportSocketTls,
+						ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: utils.MustMessageToAny(tlsContext)},
+					},
+				})
+			}
+		}
+	}
+
+	if out.GetLoadAssignment() != nil {
+		appendFailoverEndpoints(in, out.GetLoadAssignment())
+	}
+
+	// envoy only fails over to lower priorities once it knows the endpoints of higher priorities are unhealthy
+	if len(in.GetHealthChecks()) == 0 && in.GetOutlierDetection() == nil {
+		return NoHealthChecksWarning(in.GetMetadata().Ref().Key())
+	}
+	return nil
+}
+
+func (p *plugin) ProcessEndpoints(params plugins.Params, in *v1.Upstream, out *envoy_config_endpoint_v3.ClusterLoadAssignment) error {
+	failover := in.GetFailover()
+	if failover == nil {
+		return nil
+	}
+
+	// envoy does not resolve the addresses of the endpoints it receives over EDS
+	for _, prioritizedLocality := range failover.GetPrioritizedLocalities() {
+		for _, localityEndpoints := range prioritizedLocality.GetLocalityEndpoints() {
+			for _, endpoint := range localityEndpoints.GetLbEndpoints() {
+				if net.ParseIP(endpoint.GetAddress()) == nil {
+					return EdsHostnameError(endpoint.GetAddress())
+				}
+			}
+		}
+	}
+	appendFailoverEndpoints(in, out)
+	return nil
+}
+
+// The localities are weighted like the ones of the upstream, as the load balancer plugin already ran
+func appendFailoverEndpoints(in *v1.Upstream, out *envoy_config_endpoint_v3.ClusterLoadAssignment) {
+	var localities []*envoy_config_endpoint_v3.LocalityLbEndpoints
+	for priority, prioritizedLocality := range in.GetFailover().GetPrioritizedLocalities() {
+		for _, localityEndpoints := range prioritizedLocality.GetLocalityEndpoints() {
+			localities = append(localities, translateLocalityEndpoints(uint32(priority+1), localityEndpoints))
+		}
+	}
+	loadbalancer.WeightLocalities(in, localities)
+	out.Endpoints = append(out.GetEndpoints(), localities...)
+}
+
+func translateLocalityEndpoints(priority uint32, in *v1.LocalityLbEndpoints) *envoy_config_endpoint_v3.LocalityLbEndpoints {
+	out := &envoy_config_endpoint_v3.LocalityLbEndpoints{
+		Priority:            priority,
+		LoadBalancingWeight: in.GetLoadBalancingWeight(),
+	}
+	if locality := in.GetLocality(); locality != nil {
+		out.Locality = &envoy_config_core_v3.Locality{
+			Region:  locality.GetRegion(),
+			Zone:    locality.GetZone(),
+			SubZone: locality.GetSubZone(),
+		}
+	}
+	for _, endpoint := range in.GetLbEndpoints() {
+		out.LbEndpoints = append(out.GetLbEndpoints(), translateEndpoint(int(priority), endpoint))
+	}
+	return out
+}
+
+func translateEndpoint(priority int, in *v1.LbEndpoint) *envoy_config_endpoint_v3.LbEndpoint {
+	var healthCheckConfig *envoy_config_endpoint_v3.Endpoint_HealthCheckConfig
+	if hc := in.GetHealthCheckConfig(); hc != nil {
+		healthCheckConfig = &envoy_config_endpoint_v3.Endpoint_HealthCheckConfig{
+			PortValue: hc.GetPortValue(),
+			Hostname:  hc.GetHostname(),
+		}
+	}
+	return &envoy_config_endpoint_v3.LbEndpoint{
+		Metadata:            endpointMetadata(priority, in),
+		LoadBalancingWeight: in.GetLoadBalancingWeight(),
+		HostIdentifier: &envoy_config_endpoint_v3.LbEndpoint_Endpoint{
+			Endpoint: &envoy_config_endpoint_v3.Endpoint{
+				Address: &envoy_config_core_v3.Address{
+					Address: &envoy_config_core_v3.Address_SocketAddress{
+						SocketAddress: &envoy_config_core_v3.SocketAddress{
+							Protocol: envoy_config_core_v3.SocketAddress_TCP,
+							Address:  in.GetAddress(),
+							PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{
+								PortValue: in.GetPort(),
+							},
+						},
+					},
+				},
+				HealthCheckConfig: healthCheckConfig,
+			},
+		},
+	}
+}
+
+// The metadata matches the endpoint with its transport socket match, and holds the path and method the
+// advanced http health checker uses, like the one of static upstreams
+func endpointMetadata(priority int, in *v1.LbEndpoint) *envoy_config_core_v3.Metadata {
+	filterMetadata := map[string]*pbgostruct.Struct{}
+	if in.GetUpstreamSslConfig() != nil {
+		filterMetadata[static.TransportSocketMatchKey] = metadataMatch(priority, in)
+	}
+
+	healthCheckFields := map[string]*pbgostruct.Value{}
+	if path := in.GetHealthCheckConfig().GetPath(); path != "" {
+		healthCheckFields[static.PathFieldName] = &pbgostruct.Value{Kind: &pbgostruct.Value_StringValue{StringValue: path}}
+	}
+	if method := in.GetHealthCheckConfig().GetMethod(); method != "" {
+		healthCheckFields[static.MethodFieldName] = &pbgostruct.Value{Kind: &pbgostruct.Value_StringValue{StringValue: method}}
+	}
+	if len(healthCheckFields) > 0 {
+		filterMetadata[static.AdvancedHttpCheckerName] = &pbgostruct.Struct{Fields: healthCheckFields}
+	}
+
+	if len(filterMetadata) == 0 {
+		return nil
+	}
+	return &envoy_config_core_v3.Metadata{FilterMetadata: filterMetadata}
+}
+
+func matchName(priority int, in *v1.LbEndpoint) string {
+	return fmt.Sprintf("failover_%d;%s:%d", priority, in.GetAddress(), in.GetPort())
+}
+
+func metadataMatch(priority int, in *v1.LbEndpoint) *pbgostruct.Struct {
+	return &pbgostruct.Struct{
+		Fields: map[string]*pbgostruct.Value{
+			matchName(priority, in): {
+				Kind: &pbgostruct.Value_BoolValue{
+					BoolValue: true,
+				},
+			},
+		},
+	}
+}
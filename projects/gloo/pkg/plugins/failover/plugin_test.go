@@ -0,0 +1,143 @@
+package failover_test
+
+import (
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/failover"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/loadbalancer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	skcore "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("failover plugin", func() {
+
+	var (
+		params   plugins.Params
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		params = plugins.Params{Snapshot: &v1snap.ApiSnapshot{}}
+		upstream = &v1.Upstream{
+			Metadata:     &skcore.Metadata{Name: "upstream", Namespace: "gloo-system"},
+			HealthChecks: []*core.HealthCheck{{}},
+			Failover: &v1.Failover{
+				PrioritizedLocalities: []*v1.Failover_PrioritizedLocality{
+					{
+						LocalityEndpoints: []*v1.LocalityLbEndpoints{{
+							Locality:            &v1.Locality{Region: "us-east-1", Zone: "us-east-1a"},
+							LoadBalancingWeight: &wrappers.UInt32Value{Value: 2},
+							LbEndpoints: []*v1.LbEndpoint{{
+								Address: "10.0.0.1",
+								Port:    8080,
+								HealthCheckConfig: &v1.LbEndpoint_HealthCheckConfig{
+									PortValue: 8081,
+									Path:      "/health",
+								},
+							}},
+						}},
+					},
+					{
+						LocalityEndpoints: []*v1.LocalityLbEndpoints{{
+							Locality: &v1.Locality{Region: "us-west-1"},
+							LbEndpoints: []*v1.LbEndpoint{{
+								Address:           "10.0.1.1",
+								Port:              443,
+								UpstreamSslConfig: &v1.UpstreamSslConfig{Sni: "failover.example.com"},
+							}},
+						}},
+					},
+				},
+			},
+		}
+	})
+
+	It("adds the prioritized localities at lower priorities than the endpoints of the upstream", func() {
+		loadAssignment := &envoy_config_endpoint_v3.ClusterLoadAssignment{
+			Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{}},
+		}
+		err := NewPlugin(utils.NewSslConfigTranslator()).ProcessEndpoints(params, upstream, loadAssignment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loadAssignment.GetEndpoints()).To(HaveLen(3))
+
+		east := loadAssignment.GetEndpoints()[1]
+		Expect(east.GetPriority()).To(Equal(uint32(1)))
+		Expect(east.GetLocality().GetRegion()).To(Equal("us-east-1"))
+		Expect(east.GetLocality().GetZone()).To(Equal("us-east-1a"))
+		Expect(east.GetLoadBalancingWeight().GetValue()).To(Equal(uint32(2)))
+		endpoint := east.GetLbEndpoints()[0]
+		Expect(endpoint.GetEndpoint().GetAddress().GetSocketAddress().GetAddress()).To(Equal("10.0.0.1"))
+		Expect(endpoint.GetEndpoint().GetAddress().GetSocketAddress().GetPortValue()).To(Equal(uint32(8080)))
+		Expect(endpoint.GetEndpoint().GetHealthCheckConfig().GetPortValue()).To(Equal(uint32(8081)))
+		healthCheckMetadata := endpoint.GetMetadata().GetFilterMetadata()[static.AdvancedHttpCheckerName]
+		Expect(healthCheckMetadata.GetFields()[static.PathFieldName].GetStringValue()).To(Equal("/health"))
+
+		west := loadAssignment.GetEndpoints()[2]
+		Expect(west.GetPriority()).To(Equal(uint32(2)))
+		Expect(west.GetLbEndpoints()[0].GetMetadata().GetFilterMetadata()).To(HaveKey(static.TransportSocketMatchKey))
+	})
+
+	It("weights the prioritized localities when the upstream uses locality weighted load balancing", func() {
+		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			LocalityConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig{LocalityWeightedLbConfig: &empty.Empty{}},
+		}
+		loadAssignment := &envoy_config_endpoint_v3.ClusterLoadAssignment{
+			Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{
+				LbEndpoints: []*envoy_config_endpoint_v3.LbEndpoint{{}, {}},
+			}},
+		}
+		// in the order of the plugin registry
+		for _, plugin := range []plugins.EndpointPlugin{loadbalancer.NewPlugin(), NewPlugin(utils.NewSslConfigTranslator())} {
+			Expect(plugin.ProcessEndpoints(params, upstream, loadAssignment)).NotTo(HaveOccurred())
+		}
+
+		Expect(loadAssignment.GetEndpoints()).To(HaveLen(3))
+		Expect(loadAssignment.GetEndpoints()[0].GetLoadBalancingWeight().GetValue()).To(Equal(uint32(2)))
+		Expect(loadAssignment.GetEndpoints()[1].GetLoadBalancingWeight().GetValue()).To(Equal(uint32(2)))
+		Expect(loadAssignment.GetEndpoints()[2].GetLoadBalancingWeight().GetValue()).To(Equal(uint32(1)))
+	})
+
+	It("rejects failover hostnames of EDS upstreams", func() {
+		upstream.GetFailover().GetPrioritizedLocalities()[0].GetLocalityEndpoints()[0].GetLbEndpoints()[0].Address = "failover.example.com"
+		err := NewPlugin(utils.NewSslConfigTranslator()).ProcessEndpoints(params, upstream, &envoy_config_endpoint_v3.ClusterLoadAssignment{})
+		Expect(err).To(MatchError(EdsHostnameError("failover.example.com")))
+	})
+
+	It("matches the endpoints with an ssl config with transport sockets of the cluster", func() {
+		cluster := &envoy_config_cluster_v3.Cluster{
+			LoadAssignment: &envoy_config_endpoint_v3.ClusterLoadAssignment{
+				Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{}},
+			},
+		}
+		err := NewPlugin(utils.NewSslConfigTranslator()).ProcessUpstream(params, upstream, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.GetLoadAssignment().GetEndpoints()).To(HaveLen(3))
+
+		Expect(cluster.GetTransportSocketMatches()).To(HaveLen(1))
+		match := cluster.GetTransportSocketMatches()[0]
+		endpointMetadata := cluster.GetLoadAssignment().GetEndpoints()[2].GetLbEndpoints()[0].GetMetadata()
+		Expect(match.GetMatch()).To(Equal(endpointMetadata.GetFilterMetadata()[static.TransportSocketMatchKey]))
+		var tlsContext envoyauth.UpstreamTlsContext
+		Expect(ptypes.UnmarshalAny(match.GetTransportSocket().GetTypedConfig(), &tlsContext)).NotTo(HaveOccurred())
+		Expect(tlsContext.GetSni()).To(Equal("failover.example.com"))
+	})
+
+	It("warns about upstreams without health checks or outlier detection", func() {
+		upstream.HealthChecks = nil
+		err := NewPlugin(utils.NewSslConfigTranslator()).ProcessUpstream(params, upstream, &envoy_config_cluster_v3.Cluster{})
+		Expect(err).To(HaveOccurred())
+		Expect(plugins.IsConfigurationWarning(err)).To(BeTrue())
+		Expect(err).To(MatchError(NoHealthChecksWarning("gloo-system.upstream")))
+	})
+})
@@ -24,7 +24,6 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
 	transformutils "github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/transformation"
-	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/contextutils"
@@ -46,9 +45,6 @@ const (
 var pluginStage = plugins.BeforeStage(plugins.OutAuthStage)
 
 type plugin struct {
-	recordedUpstreams map[string]*v1.Upstream
-	upstreamServices  []ServicesAndDescriptor
-
 	ctx context.Context
 }
 
@@ -58,9 +54,7 @@ type ServicesAndDescriptor struct {
 }
 
 func NewPlugin() *plugin {
-	return &plugin{
-		recordedUpstreams: make(map[string]*v1.Upstream),
-	}
+	return &plugin{}
 }
 
 func (p *plugin) Name() string {
@@ -73,16 +67,7 @@ func (p *plugin) Init(params plugins.InitParams) error {
 }
 
 func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
-	upstreamType, ok := in.GetUpstreamType().(v1.ServiceSpecGetter)
-	if !ok {
-		return nil
-	}
-
-	if upstreamType.GetServiceSpec() == nil {
-		return nil
-	}
-
-	grpcWrapper, ok := upstreamType.GetServiceSpec().GetPluginType().(*glooplugins.ServiceSpec_Grpc)
+	grpcWrapper, ok := getGrpcServiceSpec(in)
 	if !ok {
 		return nil
 	}
@@ -96,9 +81,34 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		// no services, this just marks the upstream as a grpc one.
 		return nil
 	}
+	if _, err := getDescriptors(in, grpcSpec); err != nil {
+		return err
+	}
+	contextutils.LoggerFrom(p.ctx).Debugf("in.Metadata.Namespace: %s, in.Metadata.Name: %s", in.GetMetadata().GetNamespace(), in.GetMetadata().GetName())
+
+	return nil
+}
+
+func getGrpcServiceSpec(in *v1.Upstream) (*glooplugins.ServiceSpec_Grpc, bool) {
+	upstreamType, ok := in.GetUpstreamType().(v1.ServiceSpecGetter)
+	if !ok {
+		return nil, false
+	}
+
+	if upstreamType.GetServiceSpec() == nil {
+		return nil, false
+	}
+
+	grpcWrapper, ok := upstreamType.GetServiceSpec().GetPluginType().(*glooplugins.ServiceSpec_Grpc)
+	return grpcWrapper, ok
+}
+
+// getDescriptors returns the proto descriptors of the grpc services on the upstream,
+// annotated with the http rules used to transcode requests to them
+func getDescriptors(in *v1.Upstream, grpcSpec *grpcapi.ServiceSpec) (*descriptor.FileDescriptorSet, error) {
 	descriptors, err := convertProto(grpcSpec.GetDescriptors())
 	if err != nil {
-		return errors.Wrapf(err, "parsing grpc spec as a proto descriptor set")
+		return nil, errors.Wrapf(err, "parsing grpc spec as a proto descriptor set")
 	}
 
 	for _, svc := range grpcSpec.GetGrpcServices() {
@@ -106,20 +116,39 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		// find the relevant service
 		err := addHttpRulesToProto(in, svc, descriptors)
 		if err != nil {
-			return errors.Wrapf(err, "failed to generate http rules for service %s in proto descriptors", svc.GetServiceName())
+			return nil, errors.Wrapf(err, "failed to generate http rules for service %s in proto descriptors", svc.GetServiceName())
 		}
 	}
 
 	addWellKnownProtos(descriptors)
 
-	p.recordedUpstreams[translator.UpstreamToClusterName(in.GetMetadata().Ref())] = in
-	p.upstreamServices = append(p.upstreamServices, ServicesAndDescriptor{
-		Descriptors: descriptors,
-		Spec:        grpcSpec,
-	})
-	contextutils.LoggerFrom(p.ctx).Debugf("in.Metadata.Namespace: %s, in.Metadata.Name: %s", in.GetMetadata().GetNamespace(), in.GetMetadata().GetName())
+	return descriptors, nil
+}
 
-	return nil
+// getUpstreamServices returns the grpc services and descriptors of all the valid grpc upstreams.
+// Upstreams whose descriptors cannot be processed are skipped, as the error is reported on the
+// upstream when its cluster is translated.
+func getUpstreamServices(upstreams v1.UpstreamList) []ServicesAndDescriptor {
+	var upstreamServices []ServicesAndDescriptor
+	for _, in := range upstreams {
+		grpcWrapper, ok := getGrpcServiceSpec(in)
+		if !ok {
+			continue
+		}
+		grpcSpec := grpcWrapper.Grpc
+		if grpcSpec == nil || len(grpcSpec.GetGrpcServices()) == 0 {
+			continue
+		}
+		descriptors, err := getDescriptors(in, grpcSpec)
+		if err != nil {
+			continue
+		}
+		upstreamServices = append(upstreamServices, ServicesAndDescriptor{
+			Descriptors: descriptors,
+			Spec:        grpcSpec,
+		})
+	}
+	return upstreamServices
 }
 
 func genFullServiceName(packageName, serviceName string) string {
@@ -180,8 +209,8 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 				return nil, err
 			}
 
-			upstream := p.recordedUpstreams[translator.UpstreamToClusterName(upstreamRef)]
-			if upstream == nil {
+			upstream, _ := params.Snapshot.Upstreams.Find(upstreamRef.GetNamespace(), upstreamRef.GetName())
+			if grpcWrapper, ok := getGrpcServiceSpec(upstream); !ok || len(grpcWrapper.Grpc.GetGrpcServices()) == 0 {
 				return nil, errors.New("upstream was not recorded for grpc route")
 			}
 
@@ -293,12 +322,13 @@ func httpPath(upstream *v1.Upstream, serviceName, methodName string) string {
 
 func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
 
-	if len(p.upstreamServices) == 0 {
+	upstreamServices := getUpstreamServices(params.Snapshot.Upstreams)
+	if len(upstreamServices) == 0 {
 		return nil, nil
 	}
 
 	var filters []plugins.StagedHttpFilter
-	for _, serviceAndDescriptor := range p.upstreamServices {
+	for _, serviceAndDescriptor := range upstreamServices {
 		descriptorBytes, err := proto.Marshal(serviceAndDescriptor.Descriptors)
 
 		if err != nil {
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	envoy_transform "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	pluginsv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	v1grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
@@ -132,7 +133,15 @@ var _ = Describe("Plugin", func() {
 			err := p.ProcessUpstream(params, upstream, out)
 			Expect(err).NotTo(HaveOccurred())
 
-			var routeParams plugins.RouteParams
+			routeParams := plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{
+					Params: plugins.Params{
+						Snapshot: &v1snap.ApiSnapshot{
+							Upstreams: v1.UpstreamList{upstream},
+						},
+					},
+				},
+			}
 			err = p.ProcessRoute(routeParams, routeIn, routeOut)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -169,7 +178,15 @@ var _ = Describe("Plugin", func() {
 			err := p.ProcessUpstream(params, upstream, out)
 			Expect(err).NotTo(HaveOccurred())
 
-			var routeParams plugins.RouteParams
+			routeParams := plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{
+					Params: plugins.Params{
+						Snapshot: &v1snap.ApiSnapshot{
+							Upstreams: v1.UpstreamList{upstream},
+						},
+					},
+				},
+			}
 			err = p.ProcessRoute(routeParams, routeIn, routeOut)
 			Expect(err).NotTo(HaveOccurred())
 
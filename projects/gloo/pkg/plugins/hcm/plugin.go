@@ -2,8 +2,12 @@ package hcm
 
 import (
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoy_extensions_http_header_formatters_preserve_case_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/header_formatters/preserve_case/v3"
+	envoy_extensions_http_original_ip_detection_xff_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/original_ip_detection/xff/v3"
 	errors "github.com/rotisserie/eris"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/hcm"
@@ -17,11 +21,25 @@ import (
 var (
 	_ plugins.Plugin                      = new(plugin)
 	_ plugins.HttpConnectionManagerPlugin = new(plugin)
+	_ plugins.HttpFilterPlugin            = new(plugin)
 )
 
 const (
-	ExtensionName      = "hcm"
-	PreserveCasePlugin = "envoy.http.stateful_header_formatters.preserve_case"
+	ExtensionName          = "hcm"
+	PreserveCasePlugin     = "envoy.http.stateful_header_formatters.preserve_case"
+	XffOriginalIpDetection = "envoy.http.original_ip_detection.xff"
+
+	// TrustedXffFilterName is the name of the rbac filter enforcing the trusted cidrs of the xff original IP detection.
+	// The per-route configs of the rbac plugin apply to it as well, so they enforce its policy too.
+	TrustedXffFilterName = "io.solo.filters.http.trusted_xff"
+	TrustedXffPolicyName = "trusted-xff"
+)
+
+// the spoofed client address must be rejected before any other filter acts on it
+var trustedXffFilterStage = plugins.BeforeStage(plugins.FaultStage)
+
+var (
+	XffOriginalIpDetectionConflictError = errors.New("xffOriginalIpDetection cannot be combined with useRemoteAddress or xffNumTrustedHops")
 )
 
 type plugin struct{}
@@ -60,6 +78,19 @@ func (p *plugin) ProcessHcmNetworkFilter(params plugins.Params, _ *v1.Listener,
 	out.MergeSlashes = in.GetMergeSlashes()
 	out.NormalizePath = in.GetNormalizePath()
 
+	if xffDetection := in.GetXffOriginalIpDetection(); xffDetection != nil {
+		// envoy rejects listeners mixing the original IP detection extensions with the legacy xff settings
+		if in.GetUseRemoteAddress().GetValue() || in.GetXffNumTrustedHops() != 0 {
+			return XffOriginalIpDetectionConflictError
+		}
+		out.OriginalIpDetectionExtensions = []*envoycore.TypedExtensionConfig{{
+			Name: XffOriginalIpDetection,
+			TypedConfig: utils.MustMessageToAny(&envoy_extensions_http_original_ip_detection_xff_v3.XffConfig{
+				XffNumTrustedHops: xffDetection.GetNumTrustedHops(),
+			}),
+		}}
+	}
+
 	if in.GetAcceptHttp_10() {
 		out.HttpProtocolOptions = &envoycore.Http1ProtocolOptions{
 			AcceptHttp_10:         true,
@@ -174,3 +205,64 @@ func (p *plugin) ProcessHcmNetworkFilter(params plugins.Params, _ *v1.Listener,
 	return nil
 
 }
+
+// HttpFilters only restricts which peers may set the x-forwarded-for header. The client address is detected by the
+// http connection manager before any filter runs, so a request carrying the header from an untrusted peer cannot
+// fall back to the peer address and is rejected instead.
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	policy := TrustedXffPolicy(listener)
+	if policy == nil {
+		return nil, nil
+	}
+
+	filterConfig := &envoyrbac.RBAC{
+		Rules: &envoy_config_rbac_v3.RBAC{
+			Action:   envoy_config_rbac_v3.RBAC_ALLOW,
+			Policies: map[string]*envoy_config_rbac_v3.Policy{TrustedXffPolicyName: policy},
+		},
+	}
+	trustedXffFilter, err := plugins.NewStagedFilterWithConfig(TrustedXffFilterName, filterConfig, trustedXffFilterStage)
+	if err != nil {
+		return nil, errors.Wrap(err, "generating trusted xff filter config")
+	}
+	return []plugins.StagedHttpFilter{trustedXffFilter}, nil
+}
+
+// TrustedXffPolicy returns the rbac policy allowing the requests without an x-forwarded-for header, and the ones
+// from the trusted cidrs of the listener, or nil if the listener trusts every peer.
+func TrustedXffPolicy(listener *v1.HttpListener) *envoy_config_rbac_v3.Policy {
+	trustedCidrs := listener.GetOptions().GetHttpConnectionManagerSettings().GetXffOriginalIpDetection().GetTrustedCidrs()
+	if len(trustedCidrs) == 0 {
+		return nil
+	}
+
+	// the principals of a policy are ORed: requests without the header, or from a trusted peer, are allowed
+	principals := []*envoy_config_rbac_v3.Principal{{
+		Identifier: &envoy_config_rbac_v3.Principal_NotId{
+			NotId: &envoy_config_rbac_v3.Principal{
+				Identifier: &envoy_config_rbac_v3.Principal_Header{
+					Header: &envoy_config_route_v3.HeaderMatcher{
+						Name:                 "x-forwarded-for",
+						HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_PresentMatch{PresentMatch: true},
+					},
+				},
+			},
+		},
+	}}
+	for _, cidr := range trustedCidrs {
+		principals = append(principals, &envoy_config_rbac_v3.Principal{
+			Identifier: &envoy_config_rbac_v3.Principal_DirectRemoteIp{
+				DirectRemoteIp: &envoycore.CidrRange{
+					AddressPrefix: cidr.GetAddressPrefix(),
+					PrefixLen:     cidr.GetPrefixLen(),
+				},
+			},
+		})
+	}
+	return &envoy_config_rbac_v3.Policy{
+		Permissions: []*envoy_config_rbac_v3.Permission{{
+			Rule: &envoy_config_rbac_v3.Permission_Any{Any: true},
+		}},
+		Principals: principals,
+	}
+}
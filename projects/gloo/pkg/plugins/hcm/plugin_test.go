@@ -6,14 +6,21 @@ import (
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_extensions_http_original_ip_detection_xff_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/original_ip_detection/xff/v3"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/hcm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/hcm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/utils/prototime"
 	. "github.com/solo-io/solo-kit/test/matchers"
 )
@@ -234,4 +241,80 @@ var _ = Describe("Plugin", func() {
 
 	})
 
+	Context("xff original ip detection", func() {
+
+		var cfg *envoyhttp.HttpConnectionManager
+
+		BeforeEach(func() {
+			cfg = &envoyhttp.HttpConnectionManager{}
+			settings.XffOriginalIpDetection = &hcm.HttpConnectionManagerSettings_XffOriginalIpDetection{
+				NumTrustedHops: 2,
+				TrustedCidrs: []*v3.CidrRange{
+					{AddressPrefix: "10.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 8}},
+				},
+			}
+		})
+
+		httpFilters := func() []plugins.StagedHttpFilter {
+			filters, err := NewPlugin().HttpFilters(pluginParams, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					HttpConnectionManagerSettings: settings,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return filters
+		}
+
+		It("configures the xff original ip detection extension", func() {
+			err := processHcmNetworkFilter(cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cfg.GetOriginalIpDetectionExtensions()).To(HaveLen(1))
+			Expect(cfg.GetOriginalIpDetectionExtensions()[0].GetName()).To(Equal(XffOriginalIpDetection))
+			Expect(cfg.GetOriginalIpDetectionExtensions()[0].GetTypedConfig()).To(MatchProto(
+				utils.MustMessageToAny(&envoy_extensions_http_original_ip_detection_xff_v3.XffConfig{XffNumTrustedHops: 2}),
+			))
+		})
+
+		It("should error when combined with use_remote_address", func() {
+			settings.UseRemoteAddress = &wrappers.BoolValue{Value: true}
+
+			err := processHcmNetworkFilter(cfg)
+			Expect(err).To(MatchError(XffOriginalIpDetectionConflictError))
+		})
+
+		It("should error when combined with xff_num_trusted_hops", func() {
+			settings.XffNumTrustedHops = 1
+
+			err := processHcmNetworkFilter(cfg)
+			Expect(err).To(MatchError(XffOriginalIpDetectionConflictError))
+		})
+
+		It("only allows the trusted cidrs to set the x-forwarded-for header", func() {
+			filters := httpFilters()
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].HttpFilter.GetName()).To(Equal(TrustedXffFilterName))
+			Expect(filters[0].Stage).To(Equal(plugins.BeforeStage(plugins.FaultStage)))
+
+			var filterConfig envoyrbac.RBAC
+			Expect(ptypes.UnmarshalAny(filters[0].HttpFilter.GetTypedConfig(), &filterConfig)).NotTo(HaveOccurred())
+
+			policy := filterConfig.GetRules().GetPolicies()[TrustedXffPolicyName]
+			Expect(filterConfig.GetRules().GetAction()).To(Equal(envoy_config_rbac_v3.RBAC_ALLOW))
+			Expect(policy.GetPrincipals()).To(HaveLen(2))
+			Expect(policy.GetPrincipals()[0].GetNotId().GetHeader().GetName()).To(Equal("x-forwarded-for"))
+			Expect(policy.GetPrincipals()[1].GetDirectRemoteIp()).To(MatchProto(&envoy_config_core_v3.CidrRange{
+				AddressPrefix: "10.0.0.0",
+				PrefixLen:     &wrappers.UInt32Value{Value: 8},
+			}))
+		})
+
+		It("does not add a filter without trusted cidrs", func() {
+			settings.XffOriginalIpDetection.TrustedCidrs = nil
+
+			Expect(httpFilters()).To(BeEmpty())
+		})
+
+	})
+
 })
@@ -0,0 +1,186 @@
+package iptagging
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyheadertometadata "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_to_metadata/v3"
+	envoyiptagging "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ip_tagging/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var (
+	_ plugins.Plugin           = new(plugin)
+	_ plugins.HttpFilterPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "ip_tagging"
+	FilterName    = wellknown.IPTagging
+
+	// the ip tagging filter lists the tags of a request in this header, separated by commas
+	IpTagsHeader = "x-envoy-ip-tags"
+
+	// removes the ip tags header sent by the client, so that it cannot match the source prefix ranges of the routes
+	SanitizeFilterName = "envoy.filters.http.header_to_metadata"
+	// the metadata the ip tags header sent by the client is moved to
+	SanitizeMetadataNamespace = "io.solo.iptagging"
+	SanitizeMetadataKey       = "client_ip_tags"
+
+	// every request is tagged, so that the ip tagging filter always clears the route cache.
+	// Otherwise a route selected while the ip tags header sent by the client was present could be reused.
+	AnyIpTag = "any"
+)
+
+var (
+	// the filter clears the route cache after tagging a request, but the filters before it would act on a stale route
+	pluginStage = plugins.BeforeStage(plugins.FaultStage)
+	// the ip tags header sent by the client must be removed before the ip tagging filter appends to it
+	sanitizeStage = plugins.RelativeToStage(plugins.FaultStage, -2)
+)
+
+var (
+	InvalidSourcePrefixRangeError = func(cidr *v3.CidrRange, err error) error {
+		return eris.Wrapf(err, "invalid source prefix range %s/%d", cidr.GetAddressPrefix(), cidr.GetPrefixLen().GetValue())
+	}
+)
+
+// The ip tagging plugin tags the requests with the source prefix ranges of the route matchers of the listener that
+// their client address falls within, so that the routes can match the tags with a header matcher.
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) error {
+	return nil
+}
+
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	ranges := map[string]*net.IPNet{}
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		for _, route := range virtualHost.GetRoutes() {
+			for _, matcher := range route.GetMatchers() {
+				for _, cidr := range matcher.GetSourcePrefixRanges() {
+					ipNet, err := parseCidr(cidr)
+					if err != nil {
+						// invalid ranges are reported on their routes
+						continue
+					}
+					ranges[ipNet.String()] = ipNet
+				}
+			}
+		}
+	}
+	if len(ranges) == 0 {
+		return nil, nil
+	}
+
+	tags := make([]string, 0, len(ranges))
+	for tag := range ranges {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	filterConfig := &envoyiptagging.IPTagging{
+		RequestType: envoyiptagging.IPTagging_BOTH,
+	}
+	for _, tag := range tags {
+		prefixLen, _ := ranges[tag].Mask.Size()
+		filterConfig.IpTags = append(filterConfig.GetIpTags(), &envoyiptagging.IPTagging_IPTag{
+			IpTagName: tag,
+			IpList: []*envoy_config_core_v3.CidrRange{{
+				AddressPrefix: ranges[tag].IP.String(),
+				PrefixLen:     &wrappers.UInt32Value{Value: uint32(prefixLen)},
+			}},
+		})
+	}
+
+	filterConfig.IpTags = append(filterConfig.GetIpTags(), &envoyiptagging.IPTagging_IPTag{
+		IpTagName: AnyIpTag,
+		IpList: []*envoy_config_core_v3.CidrRange{
+			{AddressPrefix: "0.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 0}},
+			{AddressPrefix: "::", PrefixLen: &wrappers.UInt32Value{Value: 0}},
+		},
+	})
+
+	sanitizeFilter, err := plugins.NewStagedFilterWithConfig(SanitizeFilterName, sanitizeFilterConfig(), sanitizeStage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+	ipTaggingFilter, err := plugins.NewStagedFilterWithConfig(FilterName, filterConfig, pluginStage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{sanitizeFilter, ipTaggingFilter}, nil
+}
+
+// sanitizeFilterConfig moves the ip tags header sent by the client to the dynamic metadata of the request.
+// Envoy only removes the header from the requests of external clients when the listener uses their remote address.
+func sanitizeFilterConfig() *envoyheadertometadata.Config {
+	return &envoyheadertometadata.Config{
+		RequestRules: []*envoyheadertometadata.Config_Rule{{
+			Header: IpTagsHeader,
+			OnHeaderPresent: &envoyheadertometadata.Config_KeyValuePair{
+				MetadataNamespace: SanitizeMetadataNamespace,
+				Key:               SanitizeMetadataKey,
+				Type:              envoyheadertometadata.Config_STRING,
+			},
+			Remove: true,
+		}},
+	}
+}
+
+// TagName returns the tag of the requests whose client address falls within the range.
+// Equivalent ranges share their tag, e.g. 10.1.0.0/8 and 10.0.0.0/8 are both tagged 10.0.0.0/8.
+func TagName(cidr *v3.CidrRange) (string, error) {
+	ipNet, err := parseCidr(cidr)
+	if err != nil {
+		return "", err
+	}
+	return ipNet.String(), nil
+}
+
+// SourceIpHeaderMatcher returns the header matcher of the requests whose client address falls within any of the ranges.
+func SourceIpHeaderMatcher(ctx context.Context, ranges []*v3.CidrRange) (*envoy_config_route_v3.HeaderMatcher, error) {
+	var tags []string
+	for _, cidr := range ranges {
+		tag, err := TagName(cidr)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, regexp.QuoteMeta(tag))
+	}
+	return &envoy_config_route_v3.HeaderMatcher{
+		Name: IpTagsHeader,
+		HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
+			SafeRegexMatch: regexutils.NewRegex(ctx, fmt.Sprintf("(.*,)?(%s)(,.*)?", strings.Join(tags, "|"))),
+		},
+	}, nil
+}
+
+func parseCidr(cidr *v3.CidrRange) (*net.IPNet, error) {
+	ipNet, err := utils.ParseCidrRange(cidr)
+	if err != nil {
+		return nil, InvalidSourcePrefixRangeError(cidr, err)
+	}
+	return ipNet, nil
+}
@@ -0,0 +1,108 @@
+package iptagging_test
+
+import (
+	"context"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyheadertometadata "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_to_metadata/v3"
+	envoyiptagging "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ip_tagging/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/iptagging"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	. "github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("IpTagging", func() {
+
+	cidr := func(prefix string, len uint32) *v3.CidrRange {
+		return &v3.CidrRange{AddressPrefix: prefix, PrefixLen: &wrappers.UInt32Value{Value: len}}
+	}
+
+	listenerWithRanges := func(ranges ...[]*v3.CidrRange) *v1.HttpListener {
+		route := &v1.Route{}
+		for _, r := range ranges {
+			route.Matchers = append(route.Matchers, &matchers.Matcher{
+				PathSpecifier:      &matchers.Matcher_Prefix{Prefix: "/"},
+				SourcePrefixRanges: r,
+			})
+		}
+		return &v1.HttpListener{
+			VirtualHosts: []*v1.VirtualHost{{
+				Routes: []*v1.Route{route},
+			}},
+		}
+	}
+
+	It("should not add the filter when no matcher has source prefix ranges", func() {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, listenerWithRanges(nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("should tag the unique source prefix ranges of the listener", func() {
+		listener := listenerWithRanges(
+			[]*v3.CidrRange{cidr("10.1.0.0", 8), cidr("192.168.1.1", 32)},
+			[]*v3.CidrRange{cidr("10.0.0.0", 8), cidr("invalid", 8)},
+		)
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(2))
+		Expect(filters[1].Stage).To(Equal(plugins.BeforeStage(plugins.FaultStage)))
+		Expect(filters[1].HttpFilter.GetName()).To(Equal(FilterName))
+
+		var cfg envoyiptagging.IPTagging
+		Expect(translator.ParseTypedConfig(filters[1].HttpFilter, &cfg)).To(Succeed())
+		Expect(&cfg).To(MatchProto(&envoyiptagging.IPTagging{
+			RequestType: envoyiptagging.IPTagging_BOTH,
+			IpTags: []*envoyiptagging.IPTagging_IPTag{
+				{
+					IpTagName: "10.0.0.0/8",
+					IpList:    []*envoy_config_core_v3.CidrRange{{AddressPrefix: "10.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 8}}},
+				},
+				{
+					IpTagName: "192.168.1.1/32",
+					IpList:    []*envoy_config_core_v3.CidrRange{{AddressPrefix: "192.168.1.1", PrefixLen: &wrappers.UInt32Value{Value: 32}}},
+				},
+				{
+					IpTagName: AnyIpTag,
+					IpList: []*envoy_config_core_v3.CidrRange{
+						{AddressPrefix: "0.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 0}},
+						{AddressPrefix: "::", PrefixLen: &wrappers.UInt32Value{Value: 0}},
+					},
+				},
+			},
+		}))
+	})
+
+	It("should remove the ip tags header sent by the client before tagging the request", func() {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, listenerWithRanges([]*v3.CidrRange{cidr("10.0.0.0", 8)}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(2))
+		Expect(filters[0].HttpFilter.GetName()).To(Equal(SanitizeFilterName))
+		Expect(plugins.FilterStageComparison(filters[0].Stage, filters[1].Stage)).To(Equal(-1))
+
+		var cfg envoyheadertometadata.Config
+		Expect(translator.ParseTypedConfig(filters[0].HttpFilter, &cfg)).To(Succeed())
+		Expect(cfg.GetRequestRules()).To(HaveLen(1))
+		Expect(cfg.GetRequestRules()[0].GetHeader()).To(Equal(IpTagsHeader))
+		Expect(cfg.GetRequestRules()[0].GetRemove()).To(BeTrue())
+	})
+
+	It("should match the tags of the ranges in the ip tags header", func() {
+		headerMatcher, err := SourceIpHeaderMatcher(context.Background(), []*v3.CidrRange{cidr("10.1.0.0", 8), cidr("::1", 128)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(headerMatcher.GetName()).To(Equal(IpTagsHeader))
+		Expect(headerMatcher.GetSafeRegexMatch().GetRegex()).To(Equal(`(.*,)?(10\.0\.0\.0/8|::1/128)(,.*)?`))
+	})
+
+	It("should error on invalid ranges", func() {
+		_, err := SourceIpHeaderMatcher(context.Background(), []*v3.CidrRange{cidr("10.0.0.0", 33)})
+		Expect(err).To(MatchError(ContainSubstring("invalid source prefix range 10.0.0.0/33")))
+	})
+})
@@ -0,0 +1,78 @@
+package jwt
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+)
+
+// The jwt_authn filter of envoy writes the payloads of the JWTs it verifies to the dynamic metadata, by provider.
+// The lua filter following it copies the claims of these payloads to the headers configured for the provider.
+const claimsToHeadersFunction = `
+function envoy_on_request(request_handle)
+  local payloads = request_handle:streamInfo():dynamicMetadata():get("` + PayloadMetadataNamespace + `")
+  if payloads == nil then
+    return
+  end
+  for provider, claims in pairs(claims_to_headers) do
+    local payload = payloads[provider]
+    if payload ~= nil then
+      for _, claim in ipairs(claims) do
+        local value = payload[claim.claim]
+        if value ~= nil and type(value) ~= "table" then
+          if claim.append then
+            request_handle:headers():add(claim.header, tostring(value))
+          else
+            request_handle:headers():replace(claim.header, tostring(value))
+          end
+        end
+      end
+    end
+  end
+end
+`
+
+func claimsToHeadersScript(claimsToHeaders map[string][]*jwt.ClaimToHeader) string {
+	var providers []string
+	for provider := range claimsToHeaders {
+		providers = append(providers, provider)
+	}
+	// sorted for the config to be stable across translations
+	sort.Strings(providers)
+
+	var script strings.Builder
+	script.WriteString("local claims_to_headers = {\n")
+	for _, provider := range providers {
+		fmt.Fprintf(&script, "  [%v] = {\n", luaString(provider))
+		for _, claim := range claimsToHeaders[provider] {
+			fmt.Fprintf(&script, "    {claim = %v, header = %v, append = %v},\n",
+				luaString(claim.GetClaim()), luaString(claim.GetHeader()), claim.GetAppend())
+		}
+		script.WriteString("  },\n")
+	}
+	script.WriteString("}\n")
+	script.WriteString(claimsToHeadersFunction)
+	return script.String()
+}
+
+// luaString quotes a string as a lua string literal, escaping the bytes outside of printable ASCII
+func luaString(s string) string {
+	var quoted strings.Builder
+	quoted.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			quoted.WriteByte('\\')
+			quoted.WriteByte(c)
+		case c < ' ' || c > '~':
+			fmt.Fprintf(&quoted, "\\%03d", c)
+		default:
+			quoted.WriteByte(c)
+		}
+	}
+	quoted.WriteByte('"')
+	return quoted.String()
+}
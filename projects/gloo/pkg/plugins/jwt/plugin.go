@@ -0,0 +1,271 @@
+package jwt
+
+import (
+	"sort"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyjwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	envoylua "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.HttpFilterPlugin  = new(plugin)
+	_ plugins.VirtualHostPlugin = new(plugin)
+	_ plugins.RoutePlugin       = new(plugin)
+)
+
+const (
+	ExtensionName = "jwt"
+
+	// The jwt_authn filters verifying the JWTs before and after extauth.
+	// Both read their per-route config from the well-known name of the filter.
+	FilterName              = "envoy.filters.http.jwt_authn"
+	AfterExtAuthFilterName  = "envoy.filters.http.jwt_authn.after_ext_auth"
+	BeforeExtAuthFilterName = FilterName
+
+	// The lua filters copying the claims of the JWTs verified by the jwt_authn filters to headers
+	BeforeExtAuthClaimsFilterName = "envoy.filters.http.lua.jwt_claims_before_ext_auth"
+	AfterExtAuthClaimsFilterName  = "envoy.filters.http.lua.jwt_claims_after_ext_auth"
+
+	// The namespace of the dynamic metadata the payloads of the verified JWTs are written to, by provider
+	PayloadMetadataNamespace = FilterName
+)
+
+var (
+	NoProvidersError = func(virtualHost, stage string) error {
+		return eris.Errorf("jwt config of virtual host %v %v has no providers", virtualHost, stage)
+	}
+)
+
+// stage is one of the positions of the jwt_authn filter relative to extauth
+type stage struct {
+	name             string
+	filterName       string
+	filterStage      plugins.FilterStage
+	claimsFilterName string
+	claimsStage      plugins.FilterStage
+	virtualHost      func(in *v1.VirtualHost) *jwt.VhostExtension
+	route            func(in *v1.Route) *jwt.RouteExtension
+}
+
+var stages = []stage{
+	{
+		name:             "before_ext_auth",
+		filterName:       BeforeExtAuthFilterName,
+		filterStage:      plugins.RelativeToStage(plugins.AuthNStage, -2),
+		claimsFilterName: BeforeExtAuthClaimsFilterName,
+		claimsStage:      plugins.BeforeStage(plugins.AuthNStage),
+		virtualHost: func(in *v1.VirtualHost) *jwt.VhostExtension {
+			return in.GetOptions().GetJwtStaged().GetBeforeExtAuth()
+		},
+		route: func(in *v1.Route) *jwt.RouteExtension {
+			return in.GetOptions().GetJwtStaged().GetBeforeExtAuth()
+		},
+	},
+	{
+		name:             "after_ext_auth",
+		filterName:       AfterExtAuthFilterName,
+		filterStage:      plugins.AfterStage(plugins.AuthNStage),
+		claimsFilterName: AfterExtAuthClaimsFilterName,
+		claimsStage:      plugins.RelativeToStage(plugins.AuthNStage, 2),
+		// the deprecated jwt config is the same as the after_ext_auth staged config
+		virtualHost: func(in *v1.VirtualHost) *jwt.VhostExtension {
+			if cfg := in.GetOptions().GetJwt(); cfg != nil {
+				return cfg
+			}
+			return in.GetOptions().GetJwtStaged().GetAfterExtAuth()
+		},
+		route: func(in *v1.Route) *jwt.RouteExtension {
+			if cfg := in.GetOptions().GetJwt(); cfg != nil {
+				return cfg
+			}
+			return in.GetOptions().GetJwtStaged().GetAfterExtAuth()
+		},
+	},
+}
+
+// The jwt plugin translates the jwt config of virtual hosts into jwt_authn filters, placed before or after extauth.
+// The providers of all the virtual hosts of a listener are configured on its filters, and each virtual host
+// references the requirement on its providers by name.
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	var filters []plugins.StagedHttpFilter
+	for _, s := range stages {
+		stageFilters, err := s.httpFilters(params, listener.GetVirtualHosts())
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, stageFilters...)
+	}
+	return filters, nil
+}
+
+func (p *plugin) ProcessVirtualHost(
+	params plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	if !isJwtConfigured(in) {
+		return nil
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, &envoyjwt.PerRouteConfig{
+		RequirementSpecifier: &envoyjwt.PerRouteConfig_RequirementName{
+			RequirementName: requirementName(in.GetName(), ""),
+		},
+	})
+}
+
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	if !isJwtConfigured(params.VirtualHost) {
+		return nil
+	}
+
+	// the requirement of the virtual host is set on every route, so that the routes disabling jwt
+	// on a single stage can reference a variant of it
+	var disabled []string
+	for _, s := range stages {
+		if s.route(in).GetDisable() {
+			disabled = append(disabled, s.name)
+		}
+	}
+	perRouteConfig := &envoyjwt.PerRouteConfig{}
+	switch len(disabled) {
+	case 0:
+		perRouteConfig.RequirementSpecifier = &envoyjwt.PerRouteConfig_RequirementName{
+			RequirementName: requirementName(params.VirtualHost.GetName(), ""),
+		}
+	case 1:
+		perRouteConfig.RequirementSpecifier = &envoyjwt.PerRouteConfig_RequirementName{
+			RequirementName: requirementName(params.VirtualHost.GetName(), disabled[0]),
+		}
+	default:
+		perRouteConfig.RequirementSpecifier = &envoyjwt.PerRouteConfig_Disabled{Disabled: true}
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, perRouteConfig)
+}
+
+func (s stage) httpFilters(params plugins.Params, virtualHosts []*v1.VirtualHost) ([]plugins.StagedHttpFilter, error) {
+	filterConfig := &envoyjwt.JwtAuthentication{
+		Providers:      map[string]*envoyjwt.JwtProvider{},
+		RequirementMap: map[string]*envoyjwt.JwtRequirement{},
+	}
+	claimsToHeaders := map[string][]*jwt.ClaimToHeader{}
+	configured := false
+	for _, virtualHost := range virtualHosts {
+		if !isJwtConfigured(virtualHost) {
+			continue
+		}
+
+		// the virtual hosts with jwt config on the other stage only reference this filter's requirements
+		requirement := &envoyjwt.JwtRequirement{}
+		if cfg := s.virtualHost(virtualHost); cfg != nil {
+			configured = true
+			if len(cfg.GetProviders()) == 0 {
+				return nil, NoProvidersError(virtualHost.GetName(), s.name)
+			}
+			var providerNames []string
+			for name, provider := range cfg.GetProviders() {
+				envoyName := ProviderName(virtualHost.GetName(), s.name, name)
+				envoyProvider, err := translateProvider(params, envoyName, provider)
+				if err != nil {
+					return nil, eris.Wrapf(err, "jwt provider %v of virtual host %v", name, virtualHost.GetName())
+				}
+				filterConfig.GetProviders()[envoyName] = envoyProvider
+				providerNames = append(providerNames, envoyName)
+				if len(provider.GetClaimsToHeaders()) > 0 {
+					claimsToHeaders[envoyName] = provider.GetClaimsToHeaders()
+				}
+			}
+			requirement = translateRequirement(providerNames, cfg.GetAllowMissingOrFailedJwt())
+		}
+
+		filterConfig.GetRequirementMap()[requirementName(virtualHost.GetName(), "")] = requirement
+		for _, other := range stages {
+			if other.name == s.name {
+				filterConfig.GetRequirementMap()[requirementName(virtualHost.GetName(), other.name)] = &envoyjwt.JwtRequirement{}
+			} else {
+				filterConfig.GetRequirementMap()[requirementName(virtualHost.GetName(), other.name)] = requirement
+			}
+		}
+	}
+	if !configured {
+		return nil, nil
+	}
+
+	filter, err := plugins.NewStagedFilterWithConfig(s.filterName, filterConfig, s.filterStage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+	filters := []plugins.StagedHttpFilter{filter}
+
+	if len(claimsToHeaders) > 0 {
+		claimsFilter, err := plugins.NewStagedFilterWithConfig(s.claimsFilterName, &envoylua.Lua{
+			InlineCode: claimsToHeadersScript(claimsToHeaders),
+		}, s.claimsStage)
+		if err != nil {
+			return nil, eris.Wrap(err, "generating filter config")
+		}
+		filters = append(filters, claimsFilter)
+	}
+	return filters, nil
+}
+
+func isJwtConfigured(in *v1.VirtualHost) bool {
+	for _, s := range stages {
+		if s.virtualHost(in) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ProviderName is the name of the provider of a virtual host in the config of the jwt_authn filter of a stage.
+// It is also the key the payloads of the JWTs it verifies are written to in the dynamic metadata.
+func ProviderName(virtualHost, stage, provider string) string {
+	return virtualHost + "_" + stage + "_" + provider
+}
+
+// The name of the requirement on the providers of a virtual host, for the routes disabling jwt on the given stage if any
+func requirementName(virtualHost, disabledStage string) string {
+	if disabledStage == "" {
+		return virtualHost
+	}
+	return virtualHost + "/disable_" + disabledStage
+}
+
+// PayloadKeys returns the keys the payloads of the JWTs verified by the given provider of a virtual host are written
+// to in the dynamic metadata, on every stage the provider is configured on. All the providers of the virtual host
+// are returned if none is given.
+func PayloadKeys(virtualHost *v1.VirtualHost, provider string) []string {
+	var keys []string
+	for _, s := range stages {
+		var names []string
+		for name := range s.virtualHost(virtualHost).GetProviders() {
+			if provider == "" || name == provider {
+				names = append(names, ProviderName(virtualHost.GetName(), s.name, name))
+			}
+		}
+		sort.Strings(names)
+		keys = append(keys, names...)
+	}
+	return keys
+}
@@ -0,0 +1,227 @@
+package jwt_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"time"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyjwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	envoylua "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("jwt plugin", func() {
+
+	var (
+		params      plugins.Params
+		upstream    *v1.Upstream
+		provider    *jwt.Provider
+		virtualHost *v1.VirtualHost
+		listener    *v1.HttpListener
+	)
+
+	BeforeEach(func() {
+		upstream = &v1.Upstream{Metadata: &core.Metadata{Namespace: "gloo-system", Name: "jwks"}}
+		params = plugins.Params{Snapshot: &v1snap.ApiSnapshot{Upstreams: v1.UpstreamList{upstream}}}
+		provider = &jwt.Provider{
+			Jwks: &jwt.Jwks{Jwks: &jwt.Jwks_Remote{Remote: &jwt.RemoteJwks{
+				Url:           "http://jwks/keys",
+				UpstreamRef:   upstream.GetMetadata().Ref(),
+				CacheDuration: ptypes.DurationProto(time.Minute),
+			}}},
+			Issuer:    "issuer",
+			Audiences: []string{"audience"},
+			TokenSource: &jwt.TokenSource{
+				Headers:     []*jwt.TokenSource_HeaderSource{{Header: "x-jwt", Prefix: "Bearer "}},
+				QueryParams: []string{"token"},
+			},
+			KeepToken: true,
+		}
+		virtualHost = &v1.VirtualHost{
+			Name: "vhost",
+			Options: &v1.VirtualHostOptions{
+				JwtConfig: &v1.VirtualHostOptions_JwtStaged{JwtStaged: &jwt.JwtStagedVhostExtension{
+					BeforeExtAuth: &jwt.VhostExtension{Providers: map[string]*jwt.Provider{"provider": provider}},
+				}},
+			},
+		}
+		listener = &v1.HttpListener{VirtualHosts: []*v1.VirtualHost{virtualHost, {Name: "other"}}}
+	})
+
+	filterConfig := func(filter plugins.StagedHttpFilter) *envoyjwt.JwtAuthentication {
+		var cfg envoyjwt.JwtAuthentication
+		ExpectWithOffset(1, ptypes.UnmarshalAny(filter.HttpFilter.GetTypedConfig(), &cfg)).NotTo(HaveOccurred())
+		ExpectWithOffset(1, cfg.Validate()).NotTo(HaveOccurred())
+		return &cfg
+	}
+
+	It("does not add filters without jwt config", func() {
+		filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{VirtualHosts: []*v1.VirtualHost{{Name: "vhost"}}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("translates the providers of the virtual hosts before extauth", func() {
+		filters, err := NewPlugin().HttpFilters(params, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.GetName()).To(Equal(BeforeExtAuthFilterName))
+		Expect(plugins.FilterStageComparison(filters[0].Stage, plugins.DuringStage(plugins.AuthNStage))).To(Equal(-1))
+
+		cfg := filterConfig(filters[0])
+		providerName := ProviderName("vhost", "before_ext_auth", "provider")
+		Expect(cfg.GetProviders()).To(HaveLen(1))
+		envoyProvider := cfg.GetProviders()[providerName]
+		Expect(envoyProvider.GetIssuer()).To(Equal("issuer"))
+		Expect(envoyProvider.GetAudiences()).To(Equal([]string{"audience"}))
+		Expect(envoyProvider.GetForward()).To(BeTrue())
+		Expect(envoyProvider.GetFromHeaders()).To(HaveLen(1))
+		Expect(envoyProvider.GetFromHeaders()[0].GetName()).To(Equal("x-jwt"))
+		Expect(envoyProvider.GetFromHeaders()[0].GetValuePrefix()).To(Equal("Bearer "))
+		Expect(envoyProvider.GetFromParams()).To(Equal([]string{"token"}))
+		Expect(envoyProvider.GetPayloadInMetadata()).To(Equal(providerName))
+
+		remoteJwks := envoyProvider.GetRemoteJwks()
+		Expect(remoteJwks.GetHttpUri().GetUri()).To(Equal("http://jwks/keys"))
+		Expect(remoteJwks.GetHttpUri().GetCluster()).To(Equal("jwks_gloo-system"))
+		Expect(remoteJwks.GetHttpUri().GetTimeout().AsDuration()).To(Equal(RemoteJwksTimeout))
+		Expect(remoteJwks.GetCacheDuration().AsDuration()).To(Equal(time.Minute))
+
+		Expect(cfg.GetRequirementMap()["vhost"].GetProviderName()).To(Equal(providerName))
+		Expect(cfg.GetRequirementMap()["vhost/disable_after_ext_auth"].GetProviderName()).To(Equal(providerName))
+		Expect(cfg.GetRequirementMap()["vhost/disable_before_ext_auth"].GetRequiresType()).To(BeNil())
+		Expect(cfg.GetRequirementMap()).NotTo(HaveKey("other"))
+	})
+
+	It("translates the deprecated jwt config after extauth", func() {
+		virtualHost.Options.JwtConfig = &v1.VirtualHostOptions_Jwt{Jwt: &jwt.VhostExtension{
+			Providers:               map[string]*jwt.Provider{"b": provider, "a": provider},
+			AllowMissingOrFailedJwt: true,
+		}}
+
+		filters, err := NewPlugin().HttpFilters(params, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.GetName()).To(Equal(AfterExtAuthFilterName))
+		Expect(filters[0].Stage).To(Equal(plugins.AfterStage(plugins.AuthNStage)))
+
+		requirements := filterConfig(filters[0]).GetRequirementMap()["vhost"].GetRequiresAny().GetRequirements()
+		Expect(requirements).To(HaveLen(3))
+		Expect(requirements[0].GetProviderName()).To(Equal(ProviderName("vhost", "after_ext_auth", "a")))
+		Expect(requirements[1].GetProviderName()).To(Equal(ProviderName("vhost", "after_ext_auth", "b")))
+		Expect(requirements[2].GetAllowMissingOrFailed()).NotTo(BeNil())
+	})
+
+	It("converts local PEM public keys to JWKS", func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+		provider.Jwks = &jwt.Jwks{Jwks: &jwt.Jwks_Local{Local: &jwt.LocalJwks{
+			Key: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+		}}}
+
+		filters, err := NewPlugin().HttpFilters(params, listener)
+		Expect(err).NotTo(HaveOccurred())
+		inlineJwks := filterConfig(filters[0]).GetProviders()[ProviderName("vhost", "before_ext_auth", "provider")].GetLocalJwks().GetInlineString()
+
+		var jwks struct {
+			Keys []map[string]string `json:"keys"`
+		}
+		Expect(json.Unmarshal([]byte(inlineJwks), &jwks)).NotTo(HaveOccurred())
+		Expect(jwks.Keys).To(HaveLen(1))
+		Expect(jwks.Keys[0]).To(HaveKeyWithValue("kty", "RSA"))
+		Expect(jwks.Keys[0]).To(HaveKeyWithValue("e", "AQAB"))
+	})
+
+	It("errors when the upstream of the remote jwks does not exist", func() {
+		params.Snapshot.Upstreams = nil
+		_, err := NewPlugin().HttpFilters(params, listener)
+		Expect(err).To(MatchError(ContainSubstring("no upstream found for remote jwks upstream ref gloo-system.jwks")))
+	})
+
+	It("errors when a virtual host has no providers", func() {
+		virtualHost.GetOptions().GetJwtStaged().BeforeExtAuth.Providers = nil
+		_, err := NewPlugin().HttpFilters(params, listener)
+		Expect(err).To(MatchError(NoProvidersError("vhost", "before_ext_auth")))
+	})
+
+	It("copies the claims to headers after the jwt_authn filter", func() {
+		provider.ClaimsToHeaders = []*jwt.ClaimToHeader{
+			{Claim: "sub", Header: "x-sub"},
+			{Claim: "groups\"", Header: "x-groups", Append: true},
+		}
+
+		filters, err := NewPlugin().HttpFilters(params, listener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(2))
+		Expect(filters[1].HttpFilter.GetName()).To(Equal(BeforeExtAuthClaimsFilterName))
+		Expect(plugins.FilterStageComparison(filters[0].Stage, filters[1].Stage)).To(Equal(-1))
+		Expect(plugins.FilterStageComparison(filters[1].Stage, plugins.DuringStage(plugins.AuthNStage))).To(Equal(-1))
+
+		var lua envoylua.Lua
+		Expect(ptypes.UnmarshalAny(filters[1].HttpFilter.GetTypedConfig(), &lua)).NotTo(HaveOccurred())
+		Expect(lua.GetInlineCode()).To(ContainSubstring(`["vhost_before_ext_auth_provider"] = {
+    {claim = "sub", header = "x-sub", append = false},
+    {claim = "groups\"", header = "x-groups", append = true},
+  },`))
+		Expect(lua.GetInlineCode()).To(ContainSubstring(`dynamicMetadata():get("envoy.filters.http.jwt_authn")`))
+	})
+
+	Context("per route config", func() {
+
+		perRouteConfig := func(typedPerFilterConfig map[string]*any.Any) *envoyjwt.PerRouteConfig {
+			var cfg envoyjwt.PerRouteConfig
+			ExpectWithOffset(1, ptypes.UnmarshalAny(typedPerFilterConfig[FilterName], &cfg)).NotTo(HaveOccurred())
+			return &cfg
+		}
+
+		processRoute := func(route *v1.Route) *envoy_config_route_v3.Route {
+			out := &envoy_config_route_v3.Route{}
+			err := NewPlugin().ProcessRoute(plugins.RouteParams{VirtualHost: virtualHost}, route, out)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return out
+		}
+
+		It("references the requirement of the virtual host", func() {
+			out := &envoy_config_route_v3.VirtualHost{}
+			Expect(NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{}, virtualHost, out)).NotTo(HaveOccurred())
+			Expect(perRouteConfig(out.GetTypedPerFilterConfig()).GetRequirementName()).To(Equal("vhost"))
+
+			route := processRoute(&v1.Route{})
+			Expect(perRouteConfig(route.GetTypedPerFilterConfig()).GetRequirementName()).To(Equal("vhost"))
+		})
+
+		It("disables jwt on the stages the routes disable it on", func() {
+			route := &v1.Route{Options: &v1.RouteOptions{
+				JwtConfig: &v1.RouteOptions_JwtStaged{JwtStaged: &jwt.JwtStagedRouteExtension{
+					BeforeExtAuth: &jwt.RouteExtension{Disable: true},
+				}},
+			}}
+			out := processRoute(route)
+			Expect(perRouteConfig(out.GetTypedPerFilterConfig()).GetRequirementName()).To(Equal("vhost/disable_before_ext_auth"))
+
+			route.GetOptions().GetJwtStaged().AfterExtAuth = &jwt.RouteExtension{Disable: true}
+			out = processRoute(route)
+			Expect(perRouteConfig(out.GetTypedPerFilterConfig()).GetDisabled()).To(BeTrue())
+		})
+
+		It("does not configure the routes of virtual hosts without jwt config", func() {
+			virtualHost.Options = nil
+			Expect(processRoute(&v1.Route{}).GetTypedPerFilterConfig()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,185 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyjwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	translatorutil "github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+// The time envoy waits for the remote JWKS to be fetched
+const RemoteJwksTimeout = 5 * time.Second
+
+var (
+	MissingJwksError = eris.New("no jwks provided")
+
+	MissingRemoteJwksFieldError = func(field string) error {
+		return eris.Errorf("remote jwks requires a %v", field)
+	}
+
+	UpstreamNotFoundError = func(namespace, name string) error {
+		return eris.Errorf("no upstream found for remote jwks upstream ref %v.%v", namespace, name)
+	}
+
+	InvalidLocalJwksError = func(err error) error {
+		return eris.Wrap(err, "local jwks key is neither a JWKS nor a PEM encoded public key")
+	}
+)
+
+func translateProvider(params plugins.Params, name string, provider *jwt.Provider) (*envoyjwt.JwtProvider, error) {
+	envoyProvider := &envoyjwt.JwtProvider{
+		Issuer:     provider.GetIssuer(),
+		Audiences:  provider.GetAudiences(),
+		Forward:    provider.GetKeepToken(),
+		FromParams: provider.GetTokenSource().GetQueryParams(),
+		// the payloads are read by the claims to headers filters and the access loggers
+		PayloadInMetadata: name,
+	}
+	for _, header := range provider.GetTokenSource().GetHeaders() {
+		envoyProvider.FromHeaders = append(envoyProvider.GetFromHeaders(), &envoyjwt.JwtHeader{
+			Name:        header.GetHeader(),
+			ValuePrefix: header.GetPrefix(),
+		})
+	}
+
+	switch jwks := provider.GetJwks().GetJwks().(type) {
+	case *jwt.Jwks_Remote:
+		remoteJwks, err := translateRemoteJwks(params, jwks.Remote)
+		if err != nil {
+			return nil, err
+		}
+		envoyProvider.JwksSourceSpecifier = &envoyjwt.JwtProvider_RemoteJwks{RemoteJwks: remoteJwks}
+	case *jwt.Jwks_Local:
+		inlineJwks, err := translateLocalJwks(jwks.Local.GetKey())
+		if err != nil {
+			return nil, err
+		}
+		envoyProvider.JwksSourceSpecifier = &envoyjwt.JwtProvider_LocalJwks{
+			LocalJwks: &envoy_config_core_v3.DataSource{
+				Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: inlineJwks},
+			},
+		}
+	default:
+		return nil, MissingJwksError
+	}
+	return envoyProvider, nil
+}
+
+func translateRemoteJwks(params plugins.Params, remote *jwt.RemoteJwks) (*envoyjwt.RemoteJwks, error) {
+	if remote.GetUrl() == "" {
+		return nil, MissingRemoteJwksFieldError("url")
+	}
+	upstreamRef := remote.GetUpstreamRef()
+	if upstreamRef == nil {
+		return nil, MissingRemoteJwksFieldError("upstream ref")
+	}
+	// Make sure the upstream exists
+	if _, err := params.Snapshot.Upstreams.Find(upstreamRef.GetNamespace(), upstreamRef.GetName()); err != nil {
+		return nil, UpstreamNotFoundError(upstreamRef.GetNamespace(), upstreamRef.GetName())
+	}
+
+	remoteJwks := &envoyjwt.RemoteJwks{
+		HttpUri: &envoy_config_core_v3.HttpUri{
+			Uri: remote.GetUrl(),
+			HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+				Cluster: translatorutil.UpstreamToClusterName(upstreamRef),
+			},
+			Timeout: ptypes.DurationProto(RemoteJwksTimeout),
+		},
+		CacheDuration: remote.GetCacheDuration(),
+	}
+	if asyncFetch := remote.GetAsyncFetch(); asyncFetch != nil {
+		remoteJwks.AsyncFetch = &envoyjwt.JwksAsyncFetch{FastListener: asyncFetch.GetFastListener()}
+	}
+	return remoteJwks, nil
+}
+
+// The local key is either a JWKS, or a PEM encoded public key, converted to a JWKS as envoy only reads the former
+func translateLocalJwks(key string) (string, error) {
+	key = strings.TrimSpace(key)
+	if strings.HasPrefix(key, "{") {
+		return key, nil
+	}
+
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return "", InvalidLocalJwksError(eris.New("no PEM data found"))
+	}
+	var publicKey interface{}
+	var err error
+	if block.Type == "RSA PUBLIC KEY" {
+		publicKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	} else {
+		publicKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	if err != nil {
+		return "", InvalidLocalJwksError(err)
+	}
+
+	encode := base64.RawURLEncoding.EncodeToString
+	var jwk map[string]string
+	switch publicKey := publicKey.(type) {
+	case *rsa.PublicKey:
+		jwk = map[string]string{
+			"kty": "RSA",
+			"n":   encode(publicKey.N.Bytes()),
+			"e":   encode(big.NewInt(int64(publicKey.E)).Bytes()),
+		}
+	case *ecdsa.PublicKey:
+		// the coordinates are padded to the size of the curve
+		size := (publicKey.Curve.Params().BitSize + 7) / 8
+		jwk = map[string]string{
+			"kty": "EC",
+			"crv": publicKey.Curve.Params().Name,
+			"x":   encode(publicKey.X.FillBytes(make([]byte, size))),
+			"y":   encode(publicKey.Y.FillBytes(make([]byte, size))),
+		}
+	default:
+		return "", InvalidLocalJwksError(eris.Errorf("unsupported public key type %T", publicKey))
+	}
+	jwks, err := json.Marshal(map[string][]map[string]string{"keys": {jwk}})
+	if err != nil {
+		return "", err
+	}
+	return string(jwks), nil
+}
+
+// The JWT must be verified by any of the providers, unless missing or failed JWTs are allowed.
+func translateRequirement(providerNames []string, allowMissingOrFailed bool) *envoyjwt.JwtRequirement {
+	// sorted for the config to be stable across translations
+	sort.Strings(providerNames)
+	var requirements []*envoyjwt.JwtRequirement
+	for _, name := range providerNames {
+		requirements = append(requirements, &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: name},
+		})
+	}
+	if allowMissingOrFailed {
+		requirements = append(requirements, &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_AllowMissingOrFailed{AllowMissingOrFailed: &empty.Empty{}},
+		})
+	}
+	if len(requirements) == 1 {
+		return requirements[0]
+	}
+	return &envoyjwt.JwtRequirement{
+		RequiresType: &envoyjwt.JwtRequirement_RequiresAny{
+			RequiresAny: &envoyjwt.JwtRequirementOrList{Requirements: requirements},
+		},
+	}
+}
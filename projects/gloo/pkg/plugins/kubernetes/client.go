@@ -8,9 +8,11 @@ import (
 	errors "github.com/rotisserie/eris"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/controller"
 	kubeinformers "k8s.io/client-go/informers"
 	kubelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -22,6 +24,10 @@ import (
 
 type KubePluginSharedFactory interface {
 	EndpointsLister(ns string) kubelisters.EndpointsLister
+	// EndpointSlicesLister returns nil unless the endpoint slices are watched rather than the endpoints
+	EndpointSlicesLister(ns string) discoverylisters.EndpointSliceLister
+	// NodeLister returns nil if gloo is not allowed to list the nodes of the cluster
+	NodeLister() kubelisters.NodeLister
 	Subscribe() <-chan struct{}
 	Unsubscribe(<-chan struct{})
 }
@@ -29,37 +35,60 @@ type KubePluginSharedFactory interface {
 type KubePluginListers struct {
 	initError error
 
-	endpointsLister map[string]kubelisters.EndpointsLister
+	endpointsLister      map[string]kubelisters.EndpointsLister
+	endpointSlicesLister map[string]discoverylisters.EndpointSliceLister
+	nodeLister           kubelisters.NodeLister
 
 	cacheUpdatedWatchers      []chan struct{}
 	cacheUpdatedWatchersMutex sync.Mutex
 }
 
-func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string, useEndpointSlices bool) *KubePluginListers {
 	if len(watchNamespaces) == 0 {
 		watchNamespaces = []string{metav1.NamespaceAll}
 	}
-	kubePluginSharedFactory := startInformerFactory(ctx, client, watchNamespaces)
+	kubePluginSharedFactory := startInformerFactory(ctx, client, watchNamespaces, useEndpointSlices)
 	if kubePluginSharedFactory.initError != nil {
 		panic(kubePluginSharedFactory.initError)
 	}
 	return kubePluginSharedFactory
 }
 
-func startInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+func startInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string, useEndpointSlices bool) *KubePluginListers {
 	resyncDuration := 12 * time.Hour
 
 	var informers []cache.SharedIndexInformer
 	k := &KubePluginListers{
-		endpointsLister: map[string]kubelisters.EndpointsLister{},
+		endpointsLister:      map[string]kubelisters.EndpointsLister{},
+		endpointSlicesLister: map[string]discoverylisters.EndpointSliceLister{},
 	}
 	for _, nsToWatch := range watchNamespaces {
 		kubeInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(client, resyncDuration, kubeinformers.WithNamespace(nsToWatch))
+		if useEndpointSlices {
+			endpointSliceInformer := kubeInformerFactory.Discovery().V1().EndpointSlices()
+			informers = append(informers, endpointSliceInformer.Informer())
+			k.endpointSlicesLister[nsToWatch] = endpointSliceInformer.Lister()
+			continue
+		}
 		endpointInformer := kubeInformerFactory.Core().V1().Endpoints()
 		informers = append(informers, endpointInformer.Informer())
 		k.endpointsLister[nsToWatch] = endpointInformer.Lister()
 	}
 
+	// the nodes are only watched for the topology labels giving the locality of the endpoints, which seldom change,
+	// so their updates do not trigger the endpoints watch. gloo may not be allowed to list the nodes, e.g. with
+	// namespaced RBAC, in which case the endpoints have no locality.
+	var nodeInformer cache.SharedIndexInformer
+	if _, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{Limit: 1}); err != nil {
+		contextutils.LoggerFrom(ctx).Warnf("cannot list kubernetes nodes, the locality of endpoints will not be discovered: %v", err)
+	} else {
+		kubeInformerFactory := kubeinformers.NewSharedInformerFactory(client, resyncDuration)
+		nodes := kubeInformerFactory.Core().V1().Nodes()
+		nodeInformer = nodes.Informer()
+		k.nodeLister = nodes.Lister()
+		kubeInformerFactory.Start(ctx.Done())
+	}
+
 	kubeController := controller.NewController("kube-plugin-controller",
 		controller.NewLockingSyncHandler(k.updatedOccurred),
 		informers...)
@@ -75,6 +104,9 @@ func startInformerFactory(ctx context.Context, client kubernetes.Interface, watc
 	for _, informer := range informers {
 		syncFuncs = append(syncFuncs, informer.HasSynced)
 	}
+	if nodeInformer != nil {
+		syncFuncs = append(syncFuncs, nodeInformer.HasSynced)
+	}
 
 	ok := cache.WaitForCacheSync(stop, syncFuncs...)
 	if !ok && ctx.Err() == nil {
@@ -89,6 +121,14 @@ func (k *KubePluginListers) EndpointsLister(ns string) kubelisters.EndpointsList
 	return k.endpointsLister[ns]
 }
 
+func (k *KubePluginListers) EndpointSlicesLister(ns string) discoverylisters.EndpointSliceLister {
+	return k.endpointSlicesLister[ns]
+}
+
+func (k *KubePluginListers) NodeLister() kubelisters.NodeLister {
+	return k.nodeLister
+}
+
 func (k *KubePluginListers) Subscribe() <-chan struct{} {
 	k.cacheUpdatedWatchersMutex.Lock()
 	defer k.cacheUpdatedWatchersMutex.Unlock()
@@ -18,6 +18,7 @@ import (
 	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	kubev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 )
@@ -25,7 +26,7 @@ import (
 func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
 
 	kubeFactory := func(namespaces []string) KubePluginSharedFactory {
-		return getInformerFactory(opts.Ctx, p.kube, namespaces)
+		return getInformerFactory(opts.Ctx, p.kube, namespaces, useEndpointSlices(settingsutil.FromContext(opts.Ctx)))
 	}
 	watcher, err := newEndpointWatcherForUpstreams(kubeFactory, p.kubeCoreCache, writeNamespace, upstreamsToTrack, opts)
 	if err != nil {
@@ -62,7 +63,15 @@ func newEndpointWatcherForUpstreams(kubeFactoryFactory func(ns []string) KubePlu
 	}
 	opts = opts.WithDefaults()
 
-	return newEndpointsWatcher(kubeCoreCache, namespaces, kubeFactory, upstreamsToTrack), nil
+	watcher := newEndpointsWatcher(kubeCoreCache, namespaces, kubeFactory, upstreamsToTrack)
+	watcher.useEndpointSlices = useEndpointSlices(settings)
+	return watcher, nil
+}
+
+// useEndpointSlices returns true if the endpoints are to be discovered from the EndpointSlice API rather than the
+// Endpoints API.
+func useEndpointSlices(settings *v1.Settings) bool {
+	return settings.GetKubernetes().GetEndpointsApi() == v1.Settings_KubernetesConfiguration_ENDPOINT_SLICES
 }
 
 type edsWatcher struct {
@@ -70,6 +79,7 @@ type edsWatcher struct {
 	kubeShareFactory  KubePluginSharedFactory
 	kubeCoreCache     corecache.KubeCoreCache
 	namespaces        []string
+	useEndpointSlices bool
 	lastEndpointsHash uint64
 }
 
@@ -93,6 +103,7 @@ func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []str
 
 func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.EndpointList, error) {
 	var endpointList []*kubev1.Endpoints
+	var endpointSliceList []*discoveryv1.EndpointSlice
 	var serviceList []*kubev1.Service
 	var podList []*kubev1.Pod
 	ctx := contextutils.WithLogger(opts.Ctx, "kubernetes_eds")
@@ -115,6 +126,14 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		}
 		podList = append(podList, pods...)
 
+		if c.useEndpointSlices {
+			endpointSlices, err := c.kubeShareFactory.EndpointSlicesLister(ns).List(labels.SelectorFromSet(opts.Selector))
+			if err != nil {
+				return nil, err
+			}
+			endpointSliceList = append(endpointSliceList, endpointSlices...)
+			continue
+		}
 		endpoints, err := c.kubeShareFactory.EndpointsLister(ns).List(labels.SelectorFromSet(opts.Selector))
 		if err != nil {
 			return nil, err
@@ -122,7 +141,24 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		endpointList = append(endpointList, endpoints...)
 	}
 
-	eps, warns, errsToLog := filterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, c.upstreams)
+	var nodeList []*kubev1.Node
+	if nodeLister := c.kubeShareFactory.NodeLister(); nodeLister != nil {
+		nodes, err := nodeLister.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		nodeList = nodes
+	}
+
+	var (
+		eps              v1.EndpointList
+		warns, errsToLog []string
+	)
+	if c.useEndpointSlices {
+		eps, warns, errsToLog = filterEndpointSlices(ctx, writeNamespace, endpointSliceList, serviceList, podList, nodeList, c.upstreams)
+	} else {
+		eps, warns, errsToLog = filterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, nodeList, c.upstreams)
+	}
 	warnsToLog = append(warnsToLog, warns...)
 
 	hasher := fnv.New64()
@@ -209,44 +245,16 @@ func filterEndpoints(
 	kubeEndpoints []*kubev1.Endpoints,
 	services []*kubev1.Service,
 	pods []*kubev1.Pod,
+	nodes []*kubev1.Node,
 	upstreams map[*core.ResourceRef]*kubeplugin.UpstreamSpec,
 ) (v1.EndpointList, []string, []string) {
-	var endpoints v1.EndpointList
-
 	var warnsToLog, errorsToLog []string
 
-	type Epkey struct {
-		Address      string
-		Port         uint32
-		PodName      string
-		PodNamespace string
-		UpstreamRef  *core.ResourceRef
-	}
-	endpointsMap := make(map[Epkey][]*core.ResourceRef)
+	endpointsMap := make(map[endpointKey][]*core.ResourceRef)
 
 	// for each upstream
 	for usRef, spec := range upstreams {
-		var kubeServicePort *kubev1.ServicePort
-		var singlePortService bool
-	findServicePort:
-		for _, svc := range services {
-			if svc.Namespace != spec.GetServiceNamespace() || svc.Name != spec.GetServiceName() {
-				continue
-			}
-			if len(svc.Spec.Ports) == 1 {
-				singlePortService = true
-				if spec.GetServicePort() == uint32(svc.Spec.Ports[0].Port) {
-					kubeServicePort = &svc.Spec.Ports[0]
-					break findServicePort
-				}
-			}
-			for _, port := range svc.Spec.Ports {
-				if spec.GetServicePort() == uint32(port.Port) {
-					kubeServicePort = &port
-					break findServicePort
-				}
-			}
-		}
+		kubeServicePort, singlePortService := findServicePort(spec, services)
 		if kubeServicePort == nil {
 			errorsToLog = append(errorsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v", usRef.Key(), spec.GetServicePort(), spec.GetServiceName()))
 			continue
@@ -274,14 +282,7 @@ func filterEndpoints(
 					continue
 				}
 				for _, addr := range subset.Addresses {
-					var podName, podNamespace string
-					targetRef := addr.TargetRef
-					if targetRef != nil {
-						if targetRef.Kind == "Pod" {
-							podName = targetRef.Name
-							podNamespace = targetRef.Namespace
-						}
-					}
+					podName, podNamespace := targetPod(addr.TargetRef)
 					if len(spec.GetSelector()) != 0 {
 						// determine whether labels for the owner of this ip (pod) matches the spec
 						podLabels, err := getPodLabelsForIp(addr.IP, podName, podNamespace, pods)
@@ -298,7 +299,7 @@ func filterEndpoints(
 							continue
 						}
 					}
-					key := Epkey{addr.IP, port, podName, podNamespace, usRef}
+					key := endpointKey{addr.IP, port, podName, podNamespace, usRef}
 					copyRef := *usRef
 					endpointsMap[key] = append(endpointsMap[key], &copyRef)
 				}
@@ -306,6 +307,183 @@ func filterEndpoints(
 		}
 	}
 
+	return createEndpoints(writeNamespace, endpointsMap, nil, pods, nodes), warnsToLog, errorsToLog
+}
+
+// filterEndpointSlices is the counterpart of filterEndpoints for the EndpointSlice API, which also tells the health
+// status and the zone of the endpoints.
+func filterEndpointSlices(
+	_ context.Context, // do not use for logging! return logging messages as strings and log them after hashing (see https://github.com/solo-io/gloo/issues/3761)
+	writeNamespace string,
+	endpointSlices []*discoveryv1.EndpointSlice,
+	services []*kubev1.Service,
+	pods []*kubev1.Pod,
+	nodes []*kubev1.Node,
+	upstreams map[*core.ResourceRef]*kubeplugin.UpstreamSpec,
+) (v1.EndpointList, []string, []string) {
+	var warnsToLog, errorsToLog []string
+
+	endpointsMap := make(map[endpointKey][]*core.ResourceRef)
+	states := make(map[endpointKey]endpointSliceState)
+
+	for usRef, spec := range upstreams {
+		kubeServicePort, singlePortService := findServicePort(spec, services)
+		if kubeServicePort == nil {
+			errorsToLog = append(errorsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v", usRef.Key(), spec.GetServicePort(), spec.GetServiceName()))
+			continue
+		}
+		for _, slice := range endpointSlices {
+			if slice.Namespace != spec.GetServiceNamespace() || slice.Labels[discoveryv1.LabelServiceName] != spec.GetServiceName() {
+				continue
+			}
+			var port uint32
+			for _, p := range slice.Ports {
+				if p.Port == nil {
+					continue
+				}
+				// as for endpoints, the ports of the slices of a single port service may not be named
+				if singlePortService || (p.Name != nil && *p.Name == kubeServicePort.Name) {
+					port = uint32(*p.Port)
+				}
+			}
+			if port == 0 {
+				warnsToLog = append(warnsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v in endpoint slice %v", usRef.Key(), spec.GetServicePort(), spec.GetServiceName(), slice.Name))
+				continue
+			}
+			for _, endpoint := range slice.Endpoints {
+				healthStatus, ok := endpointSliceHealthStatus(endpoint.Conditions)
+				if !ok || len(endpoint.Addresses) == 0 {
+					continue
+				}
+				// the addresses of an endpoint are fungible, only the first one is used
+				address := endpoint.Addresses[0]
+				podName, podNamespace := targetPod(endpoint.TargetRef)
+				if len(spec.GetSelector()) != 0 {
+					podLabels, err := getPodLabelsForIp(address, podName, podNamespace, pods)
+					if err != nil {
+						warnsToLog = append(warnsToLog, fmt.Sprintf("error for upstream %v service %v: %v", usRef.Key(), spec.GetServiceName(), err))
+						continue
+					}
+					if !labels.SelectorFromSet(spec.GetSelector()).Matches(labels.Set(podLabels)) {
+						continue
+					}
+				}
+				state := endpointSliceState{
+					healthStatus: healthStatus,
+					zone:         endpointZone(endpoint),
+					nodeName:     stringValue(endpoint.NodeName),
+				}
+				key := endpointKey{address, port, podName, podNamespace, usRef}
+				if existing, ok := states[key]; ok {
+					// an endpoint moving between the slices of its service is briefly in both of them
+					if existing.healthStatus != v1.Endpoint_HEALTHY {
+						states[key] = state
+					}
+					continue
+				}
+				states[key] = state
+				endpointsMap[key] = append(endpointsMap[key], &core.ResourceRef{Name: usRef.GetName(), Namespace: usRef.GetNamespace()})
+			}
+		}
+	}
+
+	return createEndpoints(writeNamespace, endpointsMap, states, pods, nodes), warnsToLog, errorsToLog
+}
+
+// an endpoint of an upstream, by address
+type endpointKey struct {
+	Address      string
+	Port         uint32
+	PodName      string
+	PodNamespace string
+	UpstreamRef  *core.ResourceRef
+}
+
+// the state of an endpoint that only the EndpointSlice API tells
+type endpointSliceState struct {
+	healthStatus v1.Endpoint_HealthStatus
+	zone         string
+	nodeName     string
+}
+
+// findServicePort returns the port of the service of the upstream, and whether the service has a single port.
+func findServicePort(spec *kubeplugin.UpstreamSpec, services []*kubev1.Service) (*kubev1.ServicePort, bool) {
+	var singlePortService bool
+	for _, svc := range services {
+		if svc.Namespace != spec.GetServiceNamespace() || svc.Name != spec.GetServiceName() {
+			continue
+		}
+		if len(svc.Spec.Ports) == 1 {
+			singlePortService = true
+			if spec.GetServicePort() == uint32(svc.Spec.Ports[0].Port) {
+				return &svc.Spec.Ports[0], singlePortService
+			}
+		}
+		for _, port := range svc.Spec.Ports {
+			if spec.GetServicePort() == uint32(port.Port) {
+				port := port
+				return &port, singlePortService
+			}
+		}
+	}
+	return nil, singlePortService
+}
+
+func targetPod(targetRef *kubev1.ObjectReference) (string, string) {
+	if targetRef == nil || targetRef.Kind != "Pod" {
+		return "", ""
+	}
+	return targetRef.Name, targetRef.Namespace
+}
+
+// endpointSliceHealthStatus returns the health status of an endpoint from its conditions, or false if the endpoint
+// should not receive requests at all. Endpoints that are neither ready nor terminating, e.g. the pods of a rollout
+// that are not ready yet, are left out rather than reported unhealthy, so that they do not count towards the panic
+// threshold of envoy.
+func endpointSliceHealthStatus(conditions discoveryv1.EndpointConditions) (v1.Endpoint_HealthStatus, bool) {
+	// an unknown ready condition is to be interpreted as ready
+	if conditions.Ready == nil || *conditions.Ready {
+		return v1.Endpoint_HEALTHY, true
+	}
+	// terminating endpoints that still serve get no new requests, and their active connections are drained
+	if conditions.Terminating != nil && *conditions.Terminating && conditions.Serving != nil && *conditions.Serving {
+		return v1.Endpoint_DRAINING, true
+	}
+	return v1.Endpoint_UNKNOWN, false
+}
+
+// endpointZone returns the zone of the endpoint, falling back to the zone hint of topology aware routing.
+func endpointZone(endpoint discoveryv1.Endpoint) string {
+	if endpoint.Zone != nil {
+		return *endpoint.Zone
+	}
+	if endpoint.Hints != nil && len(endpoint.Hints.ForZones) == 1 {
+		return endpoint.Hints.ForZones[0].Name
+	}
+	return ""
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func createEndpoints(
+	writeNamespace string,
+	endpointsMap map[endpointKey][]*core.ResourceRef,
+	states map[endpointKey]endpointSliceState,
+	pods []*kubev1.Pod,
+	nodes []*kubev1.Node,
+) v1.EndpointList {
+	var endpoints v1.EndpointList
+
+	nodeLabels := make(map[string]map[string]string, len(nodes))
+	for _, node := range nodes {
+		nodeLabels[node.Name] = node.Labels
+	}
+
 	for addr, refs := range endpointsMap {
 
 		// sort refs for idempotency
@@ -323,7 +501,10 @@ func filterEndpoints(
 		}, addr.Address)
 		endpointName := fmt.Sprintf("ep-%v-%v-%x", dnsname, addr.Port, hasher.Sum64())
 		pod, _ := getPodForIp(addr.Address, addr.PodName, addr.PodNamespace, pods)
-		ep := createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, pod)
+		ep := createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, pod, nodeLabels)
+		if state, ok := states[addr]; ok {
+			setEndpointSliceState(ep, state, nodeLabels)
+		}
 		endpoints = append(endpoints, ep)
 	}
 
@@ -332,10 +513,10 @@ func filterEndpoints(
 		return endpoints[i].GetMetadata().GetName() < endpoints[j].GetMetadata().GetName()
 	})
 
-	return endpoints, warnsToLog, errorsToLog
+	return endpoints
 }
 
-func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, address string, port uint32, pod *kubev1.Pod) *v1.Endpoint {
+func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, address string, port uint32, pod *kubev1.Pod, nodeLabels map[string]map[string]string) *v1.Endpoint {
 	ep := &v1.Endpoint{
 		Metadata: &core.Metadata{
 			Namespace: namespace,
@@ -344,15 +525,52 @@ func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, addre
 		Upstreams: upstreams,
 		Address:   address,
 		Port:      port,
-		// TODO: add locality info
 	}
 
 	if pod != nil {
 		ep.GetMetadata().Labels = pod.Labels
+		ep.Locality = getLocality(nodeLabels[pod.Spec.NodeName])
 	}
 	return ep
 }
 
+// setEndpointSliceState sets the health status of the endpoint, and completes its locality with the node and the zone
+// its EndpointSlice tells.
+func setEndpointSliceState(ep *v1.Endpoint, state endpointSliceState, nodeLabels map[string]map[string]string) {
+	ep.HealthStatus = state.healthStatus
+	if ep.GetLocality() == nil {
+		// e.g. the endpoints of services without selectors, which have no pod
+		ep.Locality = getLocality(nodeLabels[state.nodeName])
+	}
+	if state.zone == "" {
+		return
+	}
+	if ep.GetLocality() == nil {
+		ep.Locality = &v1.Locality{}
+	}
+	ep.GetLocality().Zone = state.zone
+}
+
+// getLocality returns the locality of the pods of a node from its topology labels, falling back
+// to the deprecated failure domain labels for older clusters.
+func getLocality(nodeLabels map[string]string) *v1.Locality {
+	region := nodeLabels[kubev1.LabelTopologyRegion]
+	if region == "" {
+		region = nodeLabels[kubev1.LabelFailureDomainBetaRegion]
+	}
+	zone := nodeLabels[kubev1.LabelTopologyZone]
+	if zone == "" {
+		zone = nodeLabels[kubev1.LabelFailureDomainBetaZone]
+	}
+	if region == "" && zone == "" {
+		return nil
+	}
+	return &v1.Locality{
+		Region: region,
+		Zone:   zone,
+	}
+}
+
 func getPodLabelsForIp(ip string, podName, podNamespace string, pods []*kubev1.Pod) (map[string]string, error) {
 	pod, err := getPodForIp(ip, podName, podNamespace, pods)
 	if err != nil {
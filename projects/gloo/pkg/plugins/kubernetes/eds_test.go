@@ -10,6 +10,10 @@ import (
 	mock_kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/mocks"
 	mock_cache "github.com/solo-io/gloo/test/mocks/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -46,6 +50,7 @@ var _ = Describe("Eds", func() {
 		upstreamsToTrack := v1.UpstreamList{up}
 
 		mockCache.EXPECT().NamespacedServiceLister("bar").Return(nil)
+		mockSharedFactory.EXPECT().NodeLister().Return(nil)
 
 		watcher, err := newEndpointWatcherForUpstreams(func([]string) KubePluginSharedFactory { return mockSharedFactory }, mockCache, "foo", upstreamsToTrack, clients.WatchOpts{Ctx: ctx})
 		Expect(err).NotTo(HaveOccurred())
@@ -54,4 +59,250 @@ var _ = Describe("Eds", func() {
 
 	})
 
+	Context("locality", func() {
+
+		var (
+			upstreams map[*core.ResourceRef]*kubev1.UpstreamSpec
+			services  []*corev1.Service
+			endpoints []*corev1.Endpoints
+			pods      []*corev1.Pod
+		)
+
+		BeforeEach(func() {
+			upstreams = map[*core.ResourceRef]*kubev1.UpstreamSpec{
+				{Name: "us", Namespace: "foo"}: {
+					ServiceName:      "svc",
+					ServiceNamespace: "foo",
+					ServicePort:      8080,
+				},
+			}
+			services = []*corev1.Service{{
+				ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "foo"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Port: 8080}},
+				},
+			}}
+			endpoints = []*corev1.Endpoints{{
+				ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "foo"},
+				Subsets: []corev1.EndpointSubset{{
+					Addresses: []corev1.EndpointAddress{
+						{IP: "10.0.0.1", TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "pod-1", Namespace: "foo"}},
+						{IP: "10.0.0.2", TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "pod-2", Namespace: "foo"}},
+					},
+					Ports: []corev1.EndpointPort{{Port: 8080}},
+				}},
+			}}
+			pods = []*corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: "foo"},
+					Spec:       corev1.PodSpec{NodeName: "node-1"},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "pod-2", Namespace: "foo"},
+					Spec:       corev1.PodSpec{NodeName: "node-2"},
+				},
+			}
+		})
+
+		localities := func(eps v1.EndpointList) map[string]*v1.Locality {
+			out := map[string]*v1.Locality{}
+			for _, ep := range eps {
+				out[ep.GetAddress()] = ep.GetLocality()
+			}
+			return out
+		}
+
+		It("derives the locality of endpoints from the topology labels of the nodes", func() {
+			nodes := []*corev1.Node{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{
+						corev1.LabelTopologyRegion: "us-east1",
+						corev1.LabelTopologyZone:   "us-east1-b",
+					}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-2", Labels: map[string]string{
+						corev1.LabelFailureDomainBetaRegion: "us-east1",
+						corev1.LabelFailureDomainBetaZone:   "us-east1-c",
+					}},
+				},
+			}
+
+			eps, _, errs := filterEndpoints(ctx, "foo", endpoints, services, pods, nodes, upstreams)
+			Expect(errs).To(BeEmpty())
+			Expect(localities(eps)).To(Equal(map[string]*v1.Locality{
+				"10.0.0.1": {Region: "us-east1", Zone: "us-east1-b"},
+				"10.0.0.2": {Region: "us-east1", Zone: "us-east1-c"},
+			}))
+		})
+
+		It("does not set the locality of endpoints without node topology labels", func() {
+			nodes := []*corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+			}
+
+			eps, _, errs := filterEndpoints(ctx, "foo", endpoints, services, pods, nodes, upstreams)
+			Expect(errs).To(BeEmpty())
+			Expect(localities(eps)).To(Equal(map[string]*v1.Locality{
+				"10.0.0.1": nil,
+				"10.0.0.2": nil,
+			}))
+		})
+	})
+
+	Context("endpoint slices", func() {
+
+		var (
+			upstreams map[*core.ResourceRef]*kubev1.UpstreamSpec
+			services  []*corev1.Service
+			pods      []*corev1.Pod
+			nodes     []*corev1.Node
+		)
+
+		boolPtr := func(b bool) *bool { return &b }
+		strPtr := func(s string) *string { return &s }
+		int32Ptr := func(i int32) *int32 { return &i }
+
+		newSlice := func(name string, endpoints ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+			return &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "foo",
+					Labels:    map[string]string{discoveryv1.LabelServiceName: "svc"},
+				},
+				AddressType: discoveryv1.AddressTypeIPv4,
+				Endpoints:   endpoints,
+				Ports: []discoveryv1.EndpointPort{
+					{Name: strPtr("metrics"), Port: int32Ptr(9090)},
+					{Name: strPtr("http"), Port: int32Ptr(8080)},
+				},
+			}
+		}
+
+		newEndpoint := func(ip, podName string, conditions discoveryv1.EndpointConditions) discoveryv1.Endpoint {
+			return discoveryv1.Endpoint{
+				Addresses:  []string{ip},
+				Conditions: conditions,
+				TargetRef:  &corev1.ObjectReference{Kind: "Pod", Name: podName, Namespace: "foo"},
+				NodeName:   strPtr("node-1"),
+			}
+		}
+
+		healthStatuses := func(eps v1.EndpointList) map[string]v1.Endpoint_HealthStatus {
+			out := map[string]v1.Endpoint_HealthStatus{}
+			for _, ep := range eps {
+				out[ep.GetAddress()] = ep.GetHealthStatus()
+			}
+			return out
+		}
+
+		BeforeEach(func() {
+			upstreams = map[*core.ResourceRef]*kubev1.UpstreamSpec{
+				{Name: "us", Namespace: "foo"}: {
+					ServiceName:      "svc",
+					ServiceNamespace: "foo",
+					ServicePort:      80,
+				},
+			}
+			services = []*corev1.Service{{
+				ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "foo"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Name: "metrics", Port: 9090}, {Name: "http", Port: 80}},
+				},
+			}}
+			pods = []*corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: "foo", Labels: map[string]string{"version": "v1"}},
+					Spec:       corev1.PodSpec{NodeName: "node-1"},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "pod-2", Namespace: "foo", Labels: map[string]string{"version": "v2"}},
+					Spec:       corev1.PodSpec{NodeName: "node-1"},
+				},
+			}
+			nodes = []*corev1.Node{{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{
+					corev1.LabelTopologyRegion: "us-east1",
+					corev1.LabelTopologyZone:   "us-east1-b",
+				}},
+			}}
+		})
+
+		It("maps the conditions of the endpoints to their health status", func() {
+			slices := []*discoveryv1.EndpointSlice{newSlice("svc-abcde",
+				newEndpoint("10.0.0.1", "pod-1", discoveryv1.EndpointConditions{}),
+				newEndpoint("10.0.0.2", "pod-2", discoveryv1.EndpointConditions{Ready: boolPtr(true), Serving: boolPtr(true)}),
+				newEndpoint("10.0.0.3", "pod-3", discoveryv1.EndpointConditions{Ready: boolPtr(false), Serving: boolPtr(true), Terminating: boolPtr(true)}),
+				newEndpoint("10.0.0.4", "pod-4", discoveryv1.EndpointConditions{Ready: boolPtr(false), Serving: boolPtr(false), Terminating: boolPtr(true)}),
+				newEndpoint("10.0.0.5", "pod-5", discoveryv1.EndpointConditions{Ready: boolPtr(false), Serving: boolPtr(false)}),
+			)}
+
+			eps, _, errs := filterEndpointSlices(ctx, "foo", slices, services, pods, nodes, upstreams)
+			Expect(errs).To(BeEmpty())
+			Expect(healthStatuses(eps)).To(Equal(map[string]v1.Endpoint_HealthStatus{
+				"10.0.0.1": v1.Endpoint_HEALTHY,
+				"10.0.0.2": v1.Endpoint_HEALTHY,
+				"10.0.0.3": v1.Endpoint_DRAINING,
+			}))
+			for _, ep := range eps {
+				Expect(ep.GetPort()).To(Equal(uint32(8080)))
+			}
+		})
+
+		It("keeps the healthiest state of endpoints in several slices", func() {
+			slices := []*discoveryv1.EndpointSlice{
+				newSlice("svc-abcde", newEndpoint("10.0.0.1", "pod-1", discoveryv1.EndpointConditions{Ready: boolPtr(false), Serving: boolPtr(true), Terminating: boolPtr(true)})),
+				newSlice("svc-fghij", newEndpoint("10.0.0.1", "pod-1", discoveryv1.EndpointConditions{Ready: boolPtr(true)})),
+			}
+
+			eps, _, _ := filterEndpointSlices(ctx, "foo", slices, services, pods, nodes, upstreams)
+			Expect(eps).To(HaveLen(1))
+			Expect(eps[0].GetHealthStatus()).To(Equal(v1.Endpoint_HEALTHY))
+			Expect(eps[0].GetUpstreams()).To(HaveLen(1))
+		})
+
+		It("uses the zone of the endpoints for their locality", func() {
+			withZone := newEndpoint("10.0.0.1", "pod-1", discoveryv1.EndpointConditions{})
+			withZone.Zone = strPtr("us-east1-c")
+			withHint := newEndpoint("10.0.0.2", "pod-2", discoveryv1.EndpointConditions{})
+			withHint.Hints = &discoveryv1.EndpointHints{ForZones: []discoveryv1.ForZone{{Name: "us-east1-d"}}}
+			withoutPod := discoveryv1.Endpoint{Addresses: []string{"10.0.0.3"}, NodeName: strPtr("node-1")}
+			slices := []*discoveryv1.EndpointSlice{newSlice("svc-abcde", withZone, withHint, withoutPod)}
+
+			eps, _, _ := filterEndpointSlices(ctx, "foo", slices, services, pods, nodes, upstreams)
+			localities := map[string]*v1.Locality{}
+			for _, ep := range eps {
+				localities[ep.GetAddress()] = ep.GetLocality()
+			}
+			Expect(localities).To(Equal(map[string]*v1.Locality{
+				"10.0.0.1": {Region: "us-east1", Zone: "us-east1-c"},
+				"10.0.0.2": {Region: "us-east1", Zone: "us-east1-d"},
+				"10.0.0.3": {Region: "us-east1", Zone: "us-east1-b"},
+			}))
+		})
+
+		It("selects the endpoints of subsets by the labels of their pods", func() {
+			for _, spec := range upstreams {
+				spec.Selector = map[string]string{"version": "v2"}
+			}
+			slices := []*discoveryv1.EndpointSlice{newSlice("svc-abcde",
+				newEndpoint("10.0.0.1", "pod-1", discoveryv1.EndpointConditions{}),
+				newEndpoint("10.0.0.2", "pod-2", discoveryv1.EndpointConditions{}),
+			)}
+
+			eps, _, _ := filterEndpointSlices(ctx, "foo", slices, services, pods, nodes, upstreams)
+			Expect(eps).To(HaveLen(1))
+			Expect(eps[0].GetAddress()).To(Equal("10.0.0.2"))
+			Expect(eps[0].GetMetadata().GetLabels()).To(Equal(map[string]string{"version": "v2"}))
+		})
+
+		It("ignores the slices of other services", func() {
+			slice := newSlice("other-abcde", newEndpoint("10.0.0.1", "pod-1", discoveryv1.EndpointConditions{}))
+			slice.Labels[discoveryv1.LabelServiceName] = "other"
+
+			eps, _, _ := filterEndpointSlices(ctx, "foo", []*discoveryv1.EndpointSlice{slice}, services, pods, nodes, upstreams)
+			Expect(eps).To(BeEmpty())
+		})
+	})
+
 })
@@ -2,6 +2,7 @@ package loadbalancer
 
 import (
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -16,6 +17,7 @@ var (
 	_ plugins.Plugin         = new(plugin)
 	_ plugins.RoutePlugin    = new(plugin)
 	_ plugins.UpstreamPlugin = new(plugin)
+	_ plugins.EndpointPlugin = new(plugin)
 )
 
 const (
@@ -111,6 +113,10 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 				out.GetCommonLbConfig().LocalityConfigSpecifier = &envoy_config_cluster_v3.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
 					LocalityWeightedLbConfig: &envoy_config_cluster_v3.Cluster_CommonLbConfig_LocalityWeightedLbConfig{},
 				}
+			case *v1.LoadBalancerConfig_ZoneAwareLbConfig_:
+				out.GetCommonLbConfig().LocalityConfigSpecifier = &envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig_{
+					ZoneAwareLbConfig: getZoneAwareLbConfig(cfg.GetZoneAwareLbConfig()),
+				}
 			}
 		}
 	}
@@ -143,6 +149,43 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	return nil
 }
 
+func getZoneAwareLbConfig(in *v1.LoadBalancerConfig_ZoneAwareLbConfig) *envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig {
+	out := &envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig{
+		MinClusterSize:     in.GetMinClusterSize(),
+		FailTrafficOnPanic: in.GetFailTrafficOnPanic(),
+	}
+	if in.GetRoutingEnabled() != nil {
+		out.RoutingEnabled = &envoy_type_v3.Percent{
+			Value: in.GetRoutingEnabled().GetValue(),
+		}
+	}
+	return out
+}
+
+// With locality weighted load balancing, envoy does not send traffic to the localities without a weight.
+// The localities of the discovered endpoints are weighted by their number of endpoints, so that traffic is
+// spread evenly across the endpoints.
+func (p *plugin) ProcessEndpoints(params plugins.Params, in *v1.Upstream, out *envoy_config_endpoint_v3.ClusterLoadAssignment) error {
+	WeightLocalities(in, out.GetEndpoints())
+	return nil
+}
+
+// WeightLocalities weights the localities without a weight by their number of endpoints, if the upstream uses
+// locality weighted load balancing. Plugins adding localities after this plugin ran weight them with it.
+func WeightLocalities(in *v1.Upstream, localities []*envoy_config_endpoint_v3.LocalityLbEndpoints) {
+	if in.GetLoadBalancerConfig().GetLocalityWeightedLbConfig() == nil {
+		return
+	}
+	for _, localityEndpoints := range localities {
+		if localityEndpoints.GetLoadBalancingWeight() != nil || len(localityEndpoints.GetLbEndpoints()) == 0 {
+			continue
+		}
+		localityEndpoints.LoadBalancingWeight = &wrappers.UInt32Value{
+			Value: uint32(len(localityEndpoints.GetLbEndpoints())),
+		}
+	}
+}
+
 func setRingHashLbConfig(out *envoy_config_cluster_v3.Cluster, userConfig *v1.LoadBalancerConfig_RingHashConfig) {
 	cfg := &envoy_config_cluster_v3.Cluster_RingHashLbConfig_{
 		RingHashLbConfig: &envoy_config_cluster_v3.Cluster_RingHashLbConfig{},
@@ -6,7 +6,10 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -215,6 +218,28 @@ status: {}
 			}))
 	})
 
+	It("should set locality config - zone aware lb config", func() {
+		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			LocalityConfig: &v1.LoadBalancerConfig_ZoneAwareLbConfig_{
+				ZoneAwareLbConfig: &v1.LoadBalancerConfig_ZoneAwareLbConfig{
+					RoutingEnabled:     &wrappers.DoubleValue{Value: 50},
+					MinClusterSize:     &wrappers.UInt64Value{Value: 3},
+					FailTrafficOnPanic: true,
+				},
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.CommonLbConfig.LocalityConfigSpecifier).To(Equal(
+			&envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig_{
+				ZoneAwareLbConfig: &envoy_config_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig{
+					RoutingEnabled:     &envoy_type_v3.Percent{Value: 50},
+					MinClusterSize:     &wrappers.UInt64Value{Value: 3},
+					FailTrafficOnPanic: true,
+				},
+			}))
+	})
+
 	It("should not set locality config if no config", func() {
 		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
 			// We include this, so that the plugin generates a CommonLbConfig object
@@ -228,6 +253,57 @@ status: {}
 		Expect(out.CommonLbConfig.LocalityConfigSpecifier).To(BeNil())
 	})
 
+	Context("endpoint plugin", func() {
+
+		var (
+			endpointPlugin plugins.EndpointPlugin
+			loadAssignment *envoy_config_endpoint_v3.ClusterLoadAssignment
+		)
+
+		BeforeEach(func() {
+			endpointPlugin = NewPlugin()
+			lbEndpoint := &envoy_config_endpoint_v3.LbEndpoint{}
+			loadAssignment = &envoy_config_endpoint_v3.ClusterLoadAssignment{
+				Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{
+					{
+						Locality:    &envoy_config_core_v3.Locality{Region: "us-east1", Zone: "us-east1-b"},
+						LbEndpoints: []*envoy_config_endpoint_v3.LbEndpoint{lbEndpoint, lbEndpoint},
+					},
+					{
+						Locality:    &envoy_config_core_v3.Locality{Region: "us-east1", Zone: "us-east1-c"},
+						LbEndpoints: []*envoy_config_endpoint_v3.LbEndpoint{lbEndpoint},
+					},
+					{
+						Locality:            &envoy_config_core_v3.Locality{Region: "us-west1"},
+						LbEndpoints:         []*envoy_config_endpoint_v3.LbEndpoint{lbEndpoint},
+						LoadBalancingWeight: &wrappers.UInt32Value{Value: 10},
+					},
+				},
+			}
+		})
+
+		It("weights the localities by their number of endpoints with locality weighted lb", func() {
+			upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				LocalityConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig{
+					LocalityWeightedLbConfig: &empty.Empty{},
+				},
+			}
+			err := endpointPlugin.ProcessEndpoints(params, upstream, loadAssignment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loadAssignment.GetEndpoints()[0].GetLoadBalancingWeight().GetValue()).To(BeEquivalentTo(2))
+			Expect(loadAssignment.GetEndpoints()[1].GetLoadBalancingWeight().GetValue()).To(BeEquivalentTo(1))
+			// explicit weights are kept
+			Expect(loadAssignment.GetEndpoints()[2].GetLoadBalancingWeight().GetValue()).To(BeEquivalentTo(10))
+		})
+
+		It("does not weight the localities without locality weighted lb", func() {
+			err := endpointPlugin.ProcessEndpoints(params, upstream, loadAssignment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loadAssignment.GetEndpoints()[0].GetLoadBalancingWeight()).To(BeNil())
+			Expect(loadAssignment.GetEndpoints()[1].GetLoadBalancingWeight()).To(BeNil())
+		})
+	})
+
 	Context("route plugin", func() {
 
 		var (
@@ -10,7 +10,9 @@ import (
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 
 	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 
 	"github.com/solo-io/go-utils/contextutils"
 )
@@ -88,3 +90,30 @@ func configureHeadersSingleDest(
 	*out = append(*out, config...)
 	return nil
 }
+
+// EnvoyHeaderMatchers converts header matchers to envoy header matchers, e.g. to match requests in the options of a route
+func EnvoyHeaderMatchers(ctx context.Context, in []*matchers.HeaderMatcher) []*envoy_config_route_v3.HeaderMatcher {
+	var out []*envoy_config_route_v3.HeaderMatcher
+	for _, matcher := range in {
+		envoyMatch := &envoy_config_route_v3.HeaderMatcher{
+			Name:        matcher.GetName(),
+			InvertMatch: matcher.GetInvertMatch(),
+		}
+		switch {
+		case matcher.GetValue() == "":
+			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_PresentMatch{
+				PresentMatch: true,
+			}
+		case matcher.GetRegex():
+			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
+				SafeRegexMatch: regexutils.NewRegex(ctx, matcher.GetValue()),
+			}
+		default:
+			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_ExactMatch{
+				ExactMatch: matcher.GetValue(),
+			}
+		}
+		out = append(out, envoyMatch)
+	}
+	return out
+}
@@ -0,0 +1,112 @@
+package rbac
+
+import (
+	envoy_config_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/hcm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.HttpFilterPlugin  = new(plugin)
+	_ plugins.VirtualHostPlugin = new(plugin)
+	_ plugins.RoutePlugin       = new(plugin)
+)
+
+const (
+	ExtensionName = "rbac"
+	FilterName    = wellknown.HTTPRoleBasedAccessControl
+)
+
+// the principals are matched against the JWTs verified by the jwt filters, which run before authorization
+var pluginStage = plugins.DuringStage(plugins.AuthZStage)
+
+// The rbac plugin translates the policies of virtual hosts and routes into the per-route config of the rbac filter.
+// The config of the filter itself only denies the requests of the virtual hosts without policies, if rbac is required.
+// Envoy applies the per-route config to every rbac filter, including the one of the hcm plugin rejecting the
+// x-forwarded-for headers of untrusted peers, so the per-route config enforces the policy of the latter as well.
+type plugin struct {
+	settings *rbac.Settings
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	p.settings = params.Settings.GetRbac()
+	return nil
+}
+
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if !p.settings.GetRequireRbac() && !isRbacConfigured(listener) {
+		return nil, nil
+	}
+
+	filterConfig := &envoyrbac.RBAC{}
+	if p.settings.GetRequireRbac() {
+		// allowing no policy denies every request
+		filterConfig.Rules = &envoy_config_rbac_v3.RBAC{Action: envoy_config_rbac_v3.RBAC_ALLOW}
+	}
+	rbacFilter, err := plugins.NewStagedFilterWithConfig(FilterName, filterConfig, pluginStage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{rbacFilter}, nil
+}
+
+func (p *plugin) ProcessVirtualHost(
+	params plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	rbacConfig := in.GetOptions().GetRbac()
+	if rbacConfig == nil {
+		return nil
+	}
+
+	perRouteConfig, err := translatePerRouteConfig(in, rbacConfig, hcm.TrustedXffPolicy(params.HttpListener))
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, perRouteConfig)
+}
+
+// The settings of a route override the ones of its virtual host entirely
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	rbacConfig := in.GetOptions().GetRbac()
+	if rbacConfig == nil {
+		return nil
+	}
+
+	perRouteConfig, err := translatePerRouteConfig(params.VirtualHost, rbacConfig, hcm.TrustedXffPolicy(params.HttpListener))
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, perRouteConfig)
+}
+
+func isRbacConfigured(listener *v1.HttpListener) bool {
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		if virtualHost.GetOptions().GetRbac() != nil {
+			return true
+		}
+		for _, route := range virtualHost.GetRoutes() {
+			if route.GetOptions().GetRbac() != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,286 @@
+package rbac_test
+
+import (
+	"fmt"
+	"net"
+
+	envoy_config_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/hcm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	jwtplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/rbac"
+)
+
+var _ = Describe("rbac plugin", func() {
+
+	var (
+		policy      *rbac.Policy
+		virtualHost *v1.VirtualHost
+	)
+
+	BeforeEach(func() {
+		policy = &rbac.Policy{
+			Principals: []*rbac.Principal{{
+				JwtPrincipal: &rbac.JWTPrincipal{
+					Claims:   map[string]string{"sub": "user"},
+					Provider: "provider",
+				},
+			}},
+			Permissions: &rbac.Permissions{
+				PathPrefix: "/api",
+				Methods:    []string{"GET", "POST"},
+			},
+		}
+		virtualHost = &v1.VirtualHost{
+			Name: "vhost",
+			Options: &v1.VirtualHostOptions{
+				JwtConfig: &v1.VirtualHostOptions_JwtStaged{JwtStaged: &jwt.JwtStagedVhostExtension{
+					BeforeExtAuth: &jwt.VhostExtension{Providers: map[string]*jwt.Provider{"provider": {}, "other": {}}},
+				}},
+				Rbac: &rbac.ExtensionSettings{Policies: map[string]*rbac.Policy{"policy": policy}},
+			},
+		}
+	})
+
+	initPlugin := func(settings *rbac.Settings) plugins.HttpFilterPlugin {
+		p := NewPlugin()
+		ExpectWithOffset(1, p.Init(plugins.InitParams{Settings: &v1.Settings{Rbac: settings}})).NotTo(HaveOccurred())
+		return p
+	}
+
+	processVirtualHost := func() *envoyrbac.RBACPerRoute {
+		out := &envoy_config_route_v3.VirtualHost{}
+		ExpectWithOffset(1, NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{}, virtualHost, out)).NotTo(HaveOccurred())
+		var cfg envoyrbac.RBACPerRoute
+		ExpectWithOffset(1, ptypes.UnmarshalAny(out.GetTypedPerFilterConfig()[FilterName], &cfg)).NotTo(HaveOccurred())
+		ExpectWithOffset(1, cfg.Validate()).NotTo(HaveOccurred())
+		return &cfg
+	}
+
+	Context("filter", func() {
+
+		It("is not added without rbac config", func() {
+			filters, err := initPlugin(nil).HttpFilters(plugins.Params{}, &v1.HttpListener{VirtualHosts: []*v1.VirtualHost{{}}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("allows every request by default", func() {
+			filters, err := initPlugin(nil).HttpFilters(plugins.Params{}, &v1.HttpListener{VirtualHosts: []*v1.VirtualHost{virtualHost}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].Stage).To(Equal(plugins.DuringStage(plugins.AuthZStage)))
+
+			var cfg envoyrbac.RBAC
+			Expect(ptypes.UnmarshalAny(filters[0].HttpFilter.GetTypedConfig(), &cfg)).NotTo(HaveOccurred())
+			Expect(cfg.GetRules()).To(BeNil())
+		})
+
+		It("denies the requests of virtual hosts without policies when rbac is required", func() {
+			filters, err := initPlugin(&rbac.Settings{RequireRbac: true}).HttpFilters(plugins.Params{}, &v1.HttpListener{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+
+			var cfg envoyrbac.RBAC
+			Expect(ptypes.UnmarshalAny(filters[0].HttpFilter.GetTypedConfig(), &cfg)).NotTo(HaveOccurred())
+			Expect(cfg.GetRules().GetAction()).To(Equal(envoy_config_rbac_v3.RBAC_ALLOW))
+			Expect(cfg.GetRules().GetPolicies()).To(BeEmpty())
+		})
+	})
+
+	It("translates the permissions of the policies", func() {
+		envoyPolicy := processVirtualHost().GetRbac().GetRules().GetPolicies()["policy"]
+		Expect(envoyPolicy.GetPermissions()).To(HaveLen(1))
+
+		rules := envoyPolicy.GetPermissions()[0].GetAndRules().GetRules()
+		Expect(rules).To(HaveLen(2))
+		Expect(rules[0].GetUrlPath().GetPath().GetPrefix()).To(Equal("/api"))
+		methods := rules[1].GetOrRules().GetRules()
+		Expect(methods).To(HaveLen(2))
+		Expect(methods[0].GetHeader().GetName()).To(Equal(":method"))
+		Expect(methods[0].GetHeader().GetExactMatch()).To(Equal("GET"))
+		Expect(methods[1].GetHeader().GetExactMatch()).To(Equal("POST"))
+
+		policy.Permissions = nil
+		envoyPolicy = processVirtualHost().GetRbac().GetRules().GetPolicies()["policy"]
+		Expect(envoyPolicy.GetPermissions()[0].GetAny()).To(BeTrue())
+	})
+
+	It("matches the jwt principals against the payloads of the jwt provider", func() {
+		principals := processVirtualHost().GetRbac().GetRules().GetPolicies()["policy"].GetPrincipals()
+		Expect(principals).To(HaveLen(1))
+
+		metadata := principals[0].GetMetadata()
+		Expect(metadata.GetFilter()).To(Equal(jwtplugin.PayloadMetadataNamespace))
+		Expect(metadata.GetPath()).To(HaveLen(2))
+		Expect(metadata.GetPath()[0].GetKey()).To(Equal(jwtplugin.ProviderName("vhost", "before_ext_auth", "provider")))
+		Expect(metadata.GetPath()[1].GetKey()).To(Equal("sub"))
+		Expect(metadata.GetValue().GetStringMatch().GetExact()).To(Equal("user"))
+	})
+
+	It("matches the jwt principals without provider against the payloads of every provider", func() {
+		principal := policy.GetPrincipals()[0].GetJwtPrincipal()
+		principal.Provider = ""
+		principal.Claims = map[string]string{"groups": "admin", "org.verified": "true"}
+		principal.Matcher = rbac.JWTPrincipal_LIST_CONTAINS
+		policy.NestedClaimDelimiter = "."
+
+		principals := processVirtualHost().GetRbac().GetRules().GetPolicies()["policy"].GetPrincipals()
+		byProvider := principals[0].GetOrIds().GetIds()
+		Expect(byProvider).To(HaveLen(2))
+		Expect(byProvider[0].GetAndIds().GetIds()[0].GetMetadata().GetPath()[0].GetKey()).To(Equal(jwtplugin.ProviderName("vhost", "before_ext_auth", "other")))
+
+		claims := byProvider[1].GetAndIds().GetIds()
+		Expect(claims).To(HaveLen(2))
+		Expect(claims[0].GetMetadata().GetValue().GetListMatch().GetOneOf().GetStringMatch().GetExact()).To(Equal("admin"))
+		nestedPath := claims[1].GetMetadata().GetPath()
+		Expect(nestedPath).To(HaveLen(3))
+		Expect(nestedPath[1].GetKey()).To(Equal("org"))
+		Expect(nestedPath[2].GetKey()).To(Equal("verified"))
+	})
+
+	It("matches boolean claims", func() {
+		principal := policy.GetPrincipals()[0].GetJwtPrincipal()
+		principal.Claims = map[string]string{"admin": "true"}
+		principal.Matcher = rbac.JWTPrincipal_BOOLEAN
+
+		principals := processVirtualHost().GetRbac().GetRules().GetPolicies()["policy"].GetPrincipals()
+		Expect(principals[0].GetMetadata().GetValue().GetBoolMatch()).To(BeTrue())
+
+		principal.Claims = map[string]string{"admin": "yes"}
+		err := NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{}, virtualHost, &envoy_config_route_v3.VirtualHost{})
+		Expect(err).To(MatchError(InvalidBooleanClaimError("policy", "admin", "yes")))
+	})
+
+	It("reports the policies referencing undefined providers", func() {
+		policy.GetPrincipals()[0].GetJwtPrincipal().Provider = "undefined"
+		err := NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{}, virtualHost, &envoy_config_route_v3.VirtualHost{})
+		Expect(err).To(MatchError(UndefinedProviderError("policy", "undefined")))
+
+		virtualHost.GetOptions().JwtConfig = nil
+		policy.GetPrincipals()[0].GetJwtPrincipal().Provider = ""
+		err = NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{}, virtualHost, &envoy_config_route_v3.VirtualHost{})
+		Expect(err).To(MatchError(NoProvidersError("policy")))
+	})
+
+	It("overrides the settings of the virtual host on routes", func() {
+		route := &v1.Route{Options: &v1.RouteOptions{Rbac: &rbac.ExtensionSettings{Disable: true}}}
+		out := &envoy_config_route_v3.Route{}
+		err := NewPlugin().ProcessRoute(plugins.RouteParams{VirtualHost: virtualHost}, route, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		var cfg envoyrbac.RBACPerRoute
+		Expect(ptypes.UnmarshalAny(out.GetTypedPerFilterConfig()[FilterName], &cfg)).NotTo(HaveOccurred())
+		Expect(cfg.GetRbac()).NotTo(BeNil())
+		Expect(cfg.GetRbac().GetRules()).To(BeNil())
+	})
+
+	Context("trusted xff", func() {
+
+		var listener *v1.HttpListener
+
+		BeforeEach(func() {
+			listener = &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					HttpConnectionManagerSettings: &hcm.HttpConnectionManagerSettings{
+						XffOriginalIpDetection: &hcm.HttpConnectionManagerSettings_XffOriginalIpDetection{
+							NumTrustedHops: 1,
+							TrustedCidrs:   []*v3.CidrRange{{AddressPrefix: "10.0.0.0", PrefixLen: &wrappers.UInt32Value{Value: 8}}},
+						},
+					},
+				},
+			}
+		})
+
+		// whether a request authorized by its jwt, from the peer, is allowed by the per-route config
+		allowed := func(cfg *envoyrbac.RBACPerRoute, peer string, xff bool) bool {
+			var matches func(principal *envoy_config_rbac_v3.Principal) bool
+			matches = func(principal *envoy_config_rbac_v3.Principal) bool {
+				switch id := principal.GetIdentifier().(type) {
+				case *envoy_config_rbac_v3.Principal_Any:
+					return true
+				case *envoy_config_rbac_v3.Principal_Metadata:
+					return true
+				case *envoy_config_rbac_v3.Principal_Header:
+					return xff && id.Header.GetName() == "x-forwarded-for"
+				case *envoy_config_rbac_v3.Principal_DirectRemoteIp:
+					_, cidr, err := net.ParseCIDR(fmt.Sprintf("%s/%d", id.DirectRemoteIp.GetAddressPrefix(), id.DirectRemoteIp.GetPrefixLen().GetValue()))
+					ExpectWithOffset(3, err).NotTo(HaveOccurred())
+					return cidr.Contains(net.ParseIP(peer))
+				case *envoy_config_rbac_v3.Principal_NotId:
+					return !matches(id.NotId)
+				case *envoy_config_rbac_v3.Principal_AndIds:
+					for _, and := range id.AndIds.GetIds() {
+						if !matches(and) {
+							return false
+						}
+					}
+					return true
+				case *envoy_config_rbac_v3.Principal_OrIds:
+					for _, or := range id.OrIds.GetIds() {
+						if matches(or) {
+							return true
+						}
+					}
+					return false
+				}
+				Fail(fmt.Sprintf("unexpected principal %v", principal))
+				return false
+			}
+
+			if cfg.GetRbac().GetRules() == nil {
+				return true
+			}
+			ExpectWithOffset(1, cfg.GetRbac().GetRules().GetAction()).To(Equal(envoy_config_rbac_v3.RBAC_ALLOW))
+			for _, policy := range cfg.GetRbac().GetRules().GetPolicies() {
+				for _, principal := range policy.GetPrincipals() {
+					if matches(principal) {
+						return true
+					}
+				}
+			}
+			return false
+		}
+
+		processRoute := func(route *v1.Route) *envoyrbac.RBACPerRoute {
+			out := &envoy_config_route_v3.Route{}
+			params := plugins.RouteParams{VirtualHostParams: plugins.VirtualHostParams{HttpListener: listener}, VirtualHost: virtualHost}
+			ExpectWithOffset(1, NewPlugin().ProcessRoute(params, route, out)).NotTo(HaveOccurred())
+			var cfg envoyrbac.RBACPerRoute
+			ExpectWithOffset(1, ptypes.UnmarshalAny(out.GetTypedPerFilterConfig()[FilterName], &cfg)).NotTo(HaveOccurred())
+			ExpectWithOffset(1, cfg.Validate()).NotTo(HaveOccurred())
+			return &cfg
+		}
+
+		It("rejects the x-forwarded-for headers of untrusted peers on routes with policies", func() {
+			cfg := processRoute(&v1.Route{Options: &v1.RouteOptions{Rbac: virtualHost.GetOptions().GetRbac()}})
+			Expect(allowed(cfg, "192.168.0.1", true)).To(BeFalse())
+			Expect(allowed(cfg, "192.168.0.1", false)).To(BeTrue())
+			Expect(allowed(cfg, "10.0.0.1", true)).To(BeTrue())
+		})
+
+		It("rejects the x-forwarded-for headers of untrusted peers on routes disabling rbac", func() {
+			cfg := processRoute(&v1.Route{Options: &v1.RouteOptions{Rbac: &rbac.ExtensionSettings{Disable: true}}})
+			Expect(allowed(cfg, "192.168.0.1", true)).To(BeFalse())
+			Expect(allowed(cfg, "192.168.0.1", false)).To(BeTrue())
+			Expect(allowed(cfg, "10.0.0.1", true)).To(BeTrue())
+		})
+
+		It("denies requests that match no policy from trusted peers", func() {
+			policy.Principals = nil
+			cfg := processRoute(&v1.Route{Options: &v1.RouteOptions{Rbac: virtualHost.GetOptions().GetRbac()}})
+			Expect(allowed(cfg, "10.0.0.1", true)).To(BeFalse())
+			Expect(allowed(cfg, "10.0.0.1", false)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,277 @@
+package rbac
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	envoy_config_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/hcm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+)
+
+var (
+	UndefinedProviderError = func(policy, provider string) error {
+		return eris.Errorf("rbac policy %v references jwt provider %v, which is not defined on the virtual host", policy, provider)
+	}
+
+	NoProvidersError = func(policy string) error {
+		return eris.Errorf("rbac policy %v has jwt principals, but no jwt provider is defined on the virtual host", policy)
+	}
+
+	InvalidBooleanClaimError = func(policy, claim, value string) error {
+		return eris.Errorf("rbac policy %v matches claim %v against %v, which is not a boolean", policy, claim, value)
+	}
+)
+
+// Disabled settings are translated into an empty config, which allows every request.
+// The trusted xff policy, if any, must hold for any of the policies to apply: it is the only one of disabled settings.
+func translatePerRouteConfig(
+	virtualHost *v1.VirtualHost,
+	settings *rbac.ExtensionSettings,
+	trustedXffPolicy *envoy_config_rbac_v3.Policy,
+) (*envoyrbac.RBACPerRoute, error) {
+	if settings.GetDisable() {
+		if trustedXffPolicy == nil {
+			return &envoyrbac.RBACPerRoute{Rbac: &envoyrbac.RBAC{}}, nil
+		}
+		return &envoyrbac.RBACPerRoute{Rbac: &envoyrbac.RBAC{Rules: &envoy_config_rbac_v3.RBAC{
+			Action:   envoy_config_rbac_v3.RBAC_ALLOW,
+			Policies: map[string]*envoy_config_rbac_v3.Policy{hcm.TrustedXffPolicyName: trustedXffPolicy},
+		}}}, nil
+	}
+
+	rules := &envoy_config_rbac_v3.RBAC{
+		Action:   envoy_config_rbac_v3.RBAC_ALLOW,
+		Policies: map[string]*envoy_config_rbac_v3.Policy{},
+	}
+	for name, policy := range settings.GetPolicies() {
+		envoyPolicy, err := translatePolicy(virtualHost, name, policy)
+		if err != nil {
+			return nil, err
+		}
+		if trustedXffPolicy != nil {
+			envoyPolicy.Principals = []*envoy_config_rbac_v3.Principal{andPrincipals([]*envoy_config_rbac_v3.Principal{
+				orPrincipals(trustedXffPolicy.GetPrincipals()),
+				orPrincipals(envoyPolicy.GetPrincipals()),
+			})}
+		}
+		rules.GetPolicies()[name] = envoyPolicy
+	}
+	return &envoyrbac.RBACPerRoute{Rbac: &envoyrbac.RBAC{Rules: rules}}, nil
+}
+
+// The permissions are granted to any of the principals
+func translatePolicy(virtualHost *v1.VirtualHost, name string, policy *rbac.Policy) (*envoy_config_rbac_v3.Policy, error) {
+	envoyPolicy := &envoy_config_rbac_v3.Policy{
+		Permissions: []*envoy_config_rbac_v3.Permission{translatePermissions(policy.GetPermissions())},
+	}
+	for _, principal := range policy.GetPrincipals() {
+		envoyPrincipal, err := translateJwtPrincipal(virtualHost, name, policy.GetNestedClaimDelimiter(), principal.GetJwtPrincipal())
+		if err != nil {
+			return nil, err
+		}
+		envoyPolicy.Principals = append(envoyPolicy.GetPrincipals(), envoyPrincipal)
+	}
+	if len(envoyPolicy.GetPrincipals()) == 0 {
+		// the permissions are granted to no one, as envoy requires policies to have principals
+		envoyPolicy.Principals = []*envoy_config_rbac_v3.Principal{{
+			Identifier: &envoy_config_rbac_v3.Principal_NotId{
+				NotId: &envoy_config_rbac_v3.Principal{Identifier: &envoy_config_rbac_v3.Principal_Any{Any: true}},
+			},
+		}}
+	}
+	return envoyPolicy, nil
+}
+
+// All the set permissions must match, and unset permissions match any request
+func translatePermissions(permissions *rbac.Permissions) *envoy_config_rbac_v3.Permission {
+	var rules []*envoy_config_rbac_v3.Permission
+	if prefix := permissions.GetPathPrefix(); prefix != "" {
+		rules = append(rules, &envoy_config_rbac_v3.Permission{
+			Rule: &envoy_config_rbac_v3.Permission_UrlPath{
+				UrlPath: &envoy_type_matcher_v3.PathMatcher{
+					Rule: &envoy_type_matcher_v3.PathMatcher_Path{
+						Path: &envoy_type_matcher_v3.StringMatcher{
+							MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: prefix},
+						},
+					},
+				},
+			},
+		})
+	}
+	if len(permissions.GetMethods()) > 0 {
+		var methods []*envoy_config_rbac_v3.Permission
+		for _, method := range permissions.GetMethods() {
+			methods = append(methods, &envoy_config_rbac_v3.Permission{
+				Rule: &envoy_config_rbac_v3.Permission_Header{
+					Header: &envoy_config_route_v3.HeaderMatcher{
+						Name:                 ":method",
+						HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: method},
+					},
+				},
+			})
+		}
+		rules = append(rules, orPermissions(methods))
+	}
+
+	switch len(rules) {
+	case 0:
+		return &envoy_config_rbac_v3.Permission{Rule: &envoy_config_rbac_v3.Permission_Any{Any: true}}
+	case 1:
+		return rules[0]
+	default:
+		return &envoy_config_rbac_v3.Permission{
+			Rule: &envoy_config_rbac_v3.Permission_AndRules{
+				AndRules: &envoy_config_rbac_v3.Permission_Set{Rules: rules},
+			},
+		}
+	}
+}
+
+func orPermissions(rules []*envoy_config_rbac_v3.Permission) *envoy_config_rbac_v3.Permission {
+	if len(rules) == 1 {
+		return rules[0]
+	}
+	return &envoy_config_rbac_v3.Permission{
+		Rule: &envoy_config_rbac_v3.Permission_OrRules{
+			OrRules: &envoy_config_rbac_v3.Permission_Set{Rules: rules},
+		},
+	}
+}
+
+// A JWT principal matches the payload of a JWT verified by its provider, or by any provider of the virtual host
+// if it has none, written to the dynamic metadata by the jwt filters. All of its claims must match.
+func translateJwtPrincipal(
+	virtualHost *v1.VirtualHost,
+	policy string,
+	nestedClaimDelimiter string,
+	principal *rbac.JWTPrincipal,
+) (*envoy_config_rbac_v3.Principal, error) {
+	payloadKeys := jwt.PayloadKeys(virtualHost, principal.GetProvider())
+	if len(payloadKeys) == 0 {
+		if principal.GetProvider() != "" {
+			return nil, UndefinedProviderError(policy, principal.GetProvider())
+		}
+		return nil, NoProvidersError(policy)
+	}
+
+	// sorted for the config to be stable across translations
+	var claims []string
+	for claim := range principal.GetClaims() {
+		claims = append(claims, claim)
+	}
+	sort.Strings(claims)
+
+	var byProvider []*envoy_config_rbac_v3.Principal
+	for _, payloadKey := range payloadKeys {
+		var ids []*envoy_config_rbac_v3.Principal
+		for _, claim := range claims {
+			value, err := translateClaimValue(policy, claim, principal.GetClaims()[claim], principal.GetMatcher())
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, metadataPrincipal(claimPath(payloadKey, claim, nestedClaimDelimiter), value))
+		}
+		if len(ids) == 0 {
+			// a principal without claims matches any JWT verified by the provider
+			ids = append(ids, metadataPrincipal([]string{payloadKey}, &envoy_type_matcher_v3.ValueMatcher{
+				MatchPattern: &envoy_type_matcher_v3.ValueMatcher_PresentMatch{PresentMatch: true},
+			}))
+		}
+		byProvider = append(byProvider, andPrincipals(ids))
+	}
+
+	if len(byProvider) == 1 {
+		return byProvider[0], nil
+	}
+	return &envoy_config_rbac_v3.Principal{
+		Identifier: &envoy_config_rbac_v3.Principal_OrIds{
+			OrIds: &envoy_config_rbac_v3.Principal_Set{Ids: byProvider},
+		},
+	}, nil
+}
+
+func translateClaimValue(policy, claim, value string, matcher rbac.JWTPrincipal_ClaimMatcher) (*envoy_type_matcher_v3.ValueMatcher, error) {
+	stringMatch := &envoy_type_matcher_v3.ValueMatcher{
+		MatchPattern: &envoy_type_matcher_v3.ValueMatcher_StringMatch{
+			StringMatch: &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: value},
+			},
+		},
+	}
+	switch matcher {
+	case rbac.JWTPrincipal_BOOLEAN:
+		boolValue, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, InvalidBooleanClaimError(policy, claim, value)
+		}
+		return &envoy_type_matcher_v3.ValueMatcher{
+			MatchPattern: &envoy_type_matcher_v3.ValueMatcher_BoolMatch{BoolMatch: boolValue},
+		}, nil
+	case rbac.JWTPrincipal_LIST_CONTAINS:
+		return &envoy_type_matcher_v3.ValueMatcher{
+			MatchPattern: &envoy_type_matcher_v3.ValueMatcher_ListMatch{
+				ListMatch: &envoy_type_matcher_v3.ListMatcher{
+					MatchPattern: &envoy_type_matcher_v3.ListMatcher_OneOf{OneOf: stringMatch},
+				},
+			},
+		}, nil
+	default:
+		return stringMatch, nil
+	}
+}
+
+// The claims are nested in the payload if a delimiter is set
+func claimPath(payloadKey, claim, nestedClaimDelimiter string) []string {
+	if nestedClaimDelimiter == "" {
+		return []string{payloadKey, claim}
+	}
+	return append([]string{payloadKey}, strings.Split(claim, nestedClaimDelimiter)...)
+}
+
+func metadataPrincipal(path []string, value *envoy_type_matcher_v3.ValueMatcher) *envoy_config_rbac_v3.Principal {
+	var segments []*envoy_type_matcher_v3.MetadataMatcher_PathSegment
+	for _, key := range path {
+		segments = append(segments, &envoy_type_matcher_v3.MetadataMatcher_PathSegment{
+			Segment: &envoy_type_matcher_v3.MetadataMatcher_PathSegment_Key{Key: key},
+		})
+	}
+	return &envoy_config_rbac_v3.Principal{
+		Identifier: &envoy_config_rbac_v3.Principal_Metadata{
+			Metadata: &envoy_type_matcher_v3.MetadataMatcher{
+				Filter: jwt.PayloadMetadataNamespace,
+				Path:   segments,
+				Value:  value,
+			},
+		},
+	}
+}
+
+func andPrincipals(ids []*envoy_config_rbac_v3.Principal) *envoy_config_rbac_v3.Principal {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return &envoy_config_rbac_v3.Principal{
+		Identifier: &envoy_config_rbac_v3.Principal_AndIds{
+			AndIds: &envoy_config_rbac_v3.Principal_Set{Ids: ids},
+		},
+	}
+}
+
+func orPrincipals(ids []*envoy_config_rbac_v3.Principal) *envoy_config_rbac_v3.Principal {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return &envoy_config_rbac_v3.Principal{
+		Identifier: &envoy_config_rbac_v3.Principal_OrIds{
+			OrIds: &envoy_config_rbac_v3.Principal_Set{Ids: ids},
+		},
+	}
+}
@@ -13,7 +13,6 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
 	transformutils "github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/transformation"
-	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/errors"
@@ -34,8 +33,7 @@ if this destination spec has rest service spec
 this will grab the parameters from the route extension
 */
 type plugin struct {
-	recordedUpstreams map[string]*glooplugins.ServiceSpec_Rest
-	ctx               context.Context
+	ctx context.Context
 }
 
 func NewPlugin() plugins.Plugin {
@@ -48,7 +46,6 @@ func (p *plugin) Name() string {
 
 func (p *plugin) Init(params plugins.InitParams) error {
 	p.ctx = params.Ctx
-	p.recordedUpstreams = make(map[string]*glooplugins.ServiceSpec_Rest)
 	return nil
 }
 
@@ -74,11 +71,22 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, _ *envo
 		if restServiceSpec.Rest == nil {
 			return errors.Errorf("%v has an empty rest service spec", in.GetMetadata().Ref())
 		}
-		p.recordedUpstreams[translator.UpstreamToClusterName(in.GetMetadata().Ref())] = restServiceSpec
 	}
 	return nil
 }
 
+func getRestServiceSpec(upstream *v1.Upstream) *glooplugins.ServiceSpec_Rest {
+	withServiceSpec, ok := upstream.GetUpstreamType().(UpstreamWithServiceSpec)
+	if !ok {
+		return nil
+	}
+	restServiceSpec, ok := withServiceSpec.GetServiceSpec().GetPluginType().(*glooplugins.ServiceSpec_Rest)
+	if !ok || restServiceSpec.Rest == nil {
+		return nil
+	}
+	return restServiceSpec
+}
+
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
 	return pluginutils.MarkPerFilterConfig(p.ctx, params.Snapshot, in, out, transformation.FilterName,
 		func(spec *v1.Destination) (proto.Message, error) {
@@ -97,8 +105,9 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 				contextutils.LoggerFrom(p.ctx).Error(err)
 				return nil, err
 			}
-			restServiceSpec, ok := p.recordedUpstreams[translator.UpstreamToClusterName(upstreamRef)]
-			if !ok {
+			upstream, _ := params.Snapshot.Upstreams.Find(upstreamRef.GetNamespace(), upstreamRef.GetName())
+			restServiceSpec := getRestServiceSpec(upstream)
+			if restServiceSpec == nil {
 				return nil, errors.Errorf("%s does not have a rest service spec", upstreamRef)
 			}
 			funcname := restDestinationSpec.Rest.GetFunctionName()
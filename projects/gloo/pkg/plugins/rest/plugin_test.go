@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	envoy_transform "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	pluginsv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	v1rest "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
 	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
@@ -120,7 +121,15 @@ var _ = Describe("Plugin", func() {
 		})
 
 		It("should process route", func() {
-			var routeParams plugins.RouteParams
+			routeParams := plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{
+					Params: plugins.Params{
+						Snapshot: &v1snap.ApiSnapshot{
+							Upstreams: v1.UpstreamList{upstream},
+						},
+					},
+				},
+			}
 			err := p.ProcessUpstream(params, upstream, out)
 			Expect(err).NotTo(HaveOccurred())
 			err = p.ProcessRoute(routeParams, routeIn, routeOut)
@@ -146,7 +155,15 @@ var _ = Describe("Plugin", func() {
 			err := p.ProcessUpstream(params, upstream, out)
 			Expect(err).NotTo(HaveOccurred())
 
-			var routeParams plugins.RouteParams
+			routeParams := plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{
+					Params: plugins.Params{
+						Snapshot: &v1snap.ApiSnapshot{
+							Upstreams: v1.UpstreamList{upstream},
+						},
+					},
+				},
+			}
 			err = p.ProcessRoute(routeParams, routeIn, routeOut)
 			Expect(err).NotTo(HaveOccurred())
 
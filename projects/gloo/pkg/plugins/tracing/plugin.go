@@ -1,6 +1,8 @@
 package tracing
 
 import (
+	"sort"
+
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_config_trace_v3 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
@@ -128,6 +130,25 @@ func customTags(tracingSettings *tracing.ListenerTracingSettings) []*envoytracin
 		customTags = append(customTags, tag)
 	}
 
+	// envoy does not set the resource attributes of the spans it exports, so they are added as literal tags instead
+	resourceAttributes := tracingSettings.GetOpenTelemetryConfig().GetResourceAttributes()
+	attributeNames := make([]string, 0, len(resourceAttributes))
+	for name := range resourceAttributes {
+		attributeNames = append(attributeNames, name)
+	}
+	sort.Strings(attributeNames)
+	for _, name := range attributeNames {
+		tag := &envoytracing.CustomTag{
+			Tag: name,
+			Type: &envoytracing.CustomTag_Literal_{
+				Literal: &envoytracing.CustomTag_Literal{
+					Value: resourceAttributes[name],
+				},
+			},
+		}
+		customTags = append(customTags, tag)
+	}
+
 	return customTags
 }
 
@@ -146,6 +167,9 @@ func processEnvoyTracingProvider(
 	case *tracing.ListenerTracingSettings_DatadogConfig:
 		return processEnvoyDatadogTracing(snapshot, typed)
 
+	case *tracing.ListenerTracingSettings_OpenTelemetryConfig:
+		return processEnvoyOpenTelemetryTracing(snapshot, typed)
+
 	default:
 		return nil, errors.Errorf("Unsupported Tracing.ProviderConfiguration: %v", typed)
 	}
@@ -225,6 +249,38 @@ func processEnvoyDatadogTracing(
 	}, nil
 }
 
+func processEnvoyOpenTelemetryTracing(
+	snapshot *v1snap.ApiSnapshot,
+	openTelemetryTracingSettings *tracing.ListenerTracingSettings_OpenTelemetryConfig,
+) (*envoy_config_trace_v3.Tracing_Http, error) {
+	var collectorClusterName string
+
+	switch collectorCluster := openTelemetryTracingSettings.OpenTelemetryConfig.GetCollectorCluster().(type) {
+	case *v3.OpenTelemetryConfig_CollectorUpstreamRef:
+		// Support upstreams as the collector cluster
+		var err error
+		collectorClusterName, err = getEnvoyTracingCollectorClusterName(snapshot, collectorCluster.CollectorUpstreamRef)
+		if err != nil {
+			return nil, err
+		}
+	case *v3.OpenTelemetryConfig_ClusterName:
+		// Support static clusters as the collector cluster
+		collectorClusterName = collectorCluster.ClusterName
+	}
+
+	marshalledEnvoyConfig, err := api_conversion.ToEnvoyOpenTelemetryConfiguration(openTelemetryTracingSettings.OpenTelemetryConfig, collectorClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envoy_config_trace_v3.Tracing_Http{
+		Name: "envoy.tracers.opentelemetry",
+		ConfigType: &envoy_config_trace_v3.Tracing_Http_TypedConfig{
+			TypedConfig: marshalledEnvoyConfig,
+		},
+	}, nil
+}
+
 func getEnvoyTracingCollectorClusterName(snapshot *v1snap.ApiSnapshot, collectorUpstreamRef *core.ResourceRef) (string, error) {
 	if snapshot == nil {
 		return "", errors.Errorf("Invalid Snapshot (nil provided)")
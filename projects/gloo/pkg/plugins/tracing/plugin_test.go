@@ -1,11 +1,13 @@
 package tracing
 
 import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoytrace "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoytracing "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
 	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
@@ -17,6 +19,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tracing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
 var _ = Describe("Plugin", func() {
@@ -394,6 +397,149 @@ var _ = Describe("Plugin", func() {
 			})
 		})
 
+		Describe("when opentelemetry provider config", func() {
+			decodeEnvoyConfig := func(value []byte) (*envoy_config_core_v3.GrpcService, string) {
+				grpcService := &envoy_config_core_v3.GrpcService{}
+				var serviceName string
+				for len(value) > 0 {
+					num, typ, n := protowire.ConsumeTag(value)
+					ExpectWithOffset(1, n).To(BeNumerically(">", 0))
+					ExpectWithOffset(1, typ).To(Equal(protowire.BytesType))
+					value = value[n:]
+					field, n := protowire.ConsumeBytes(value)
+					ExpectWithOffset(1, n).To(BeNumerically(">", 0))
+					value = value[n:]
+					switch num {
+					case 1:
+						ExpectWithOffset(1, proto.Unmarshal(field, grpcService)).NotTo(HaveOccurred())
+					case 2:
+						serviceName = string(field)
+					}
+				}
+				return grpcService, serviceName
+			}
+
+			It("references invalid upstream", func() {
+				pluginParams = plugins.Params{
+					Snapshot: &v1snap.ApiSnapshot{},
+				}
+				cfg := &envoyhttp.HttpConnectionManager{}
+				hcmSettings = &hcm.HttpConnectionManagerSettings{
+					Tracing: &tracing.ListenerTracingSettings{
+						ProviderConfig: &tracing.ListenerTracingSettings_OpenTelemetryConfig{
+							OpenTelemetryConfig: &envoytrace_gloo.OpenTelemetryConfig{
+								CollectorCluster: &envoytrace_gloo.OpenTelemetryConfig_CollectorUpstreamRef{
+									CollectorUpstreamRef: &core.ResourceRef{
+										Name:      "invalid-name",
+										Namespace: "invalid-namespace",
+									},
+								},
+							},
+						},
+					},
+				}
+				err := processHcmNetworkFilter(cfg)
+				Expect(err).NotTo(BeNil())
+			})
+
+			It("references valid upstream", func() {
+				us := v1.NewUpstream("default", "valid")
+				pluginParams = plugins.Params{
+					Snapshot: &v1snap.ApiSnapshot{
+						Upstreams: v1.UpstreamList{us},
+					},
+				}
+				cfg := &envoyhttp.HttpConnectionManager{}
+				hcmSettings = &hcm.HttpConnectionManagerSettings{
+					Tracing: &tracing.ListenerTracingSettings{
+						ProviderConfig: &tracing.ListenerTracingSettings_OpenTelemetryConfig{
+							OpenTelemetryConfig: &envoytrace_gloo.OpenTelemetryConfig{
+								CollectorCluster: &envoytrace_gloo.OpenTelemetryConfig_CollectorUpstreamRef{
+									CollectorUpstreamRef: &core.ResourceRef{
+										Name:      "valid",
+										Namespace: "default",
+									},
+								},
+								ServiceName: "gateway-proxy",
+							},
+						},
+					},
+				}
+				err := processHcmNetworkFilter(cfg)
+				Expect(err).To(BeNil())
+
+				Expect(cfg.Tracing.Provider.GetName()).To(Equal("envoy.tracers.opentelemetry"))
+				Expect(cfg.Tracing.Provider.GetTypedConfig().GetTypeUrl()).To(Equal("type.googleapis.com/envoy.config.trace.v3.OpenTelemetryConfig"))
+				grpcService, serviceName := decodeEnvoyConfig(cfg.Tracing.Provider.GetTypedConfig().GetValue())
+				Expect(grpcService.GetEnvoyGrpc().GetClusterName()).To(Equal("valid_default"))
+				Expect(serviceName).To(Equal("gateway-proxy"))
+			})
+
+			It("references cluster name", func() {
+				cfg := &envoyhttp.HttpConnectionManager{}
+				hcmSettings = &hcm.HttpConnectionManagerSettings{
+					Tracing: &tracing.ListenerTracingSettings{
+						ProviderConfig: &tracing.ListenerTracingSettings_OpenTelemetryConfig{
+							OpenTelemetryConfig: &envoytrace_gloo.OpenTelemetryConfig{
+								CollectorCluster: &envoytrace_gloo.OpenTelemetryConfig_ClusterName{
+									ClusterName: "otel-collector",
+								},
+							},
+						},
+					},
+				}
+				err := processHcmNetworkFilter(cfg)
+				Expect(err).To(BeNil())
+
+				grpcService, serviceName := decodeEnvoyConfig(cfg.Tracing.Provider.GetTypedConfig().GetValue())
+				Expect(grpcService.GetEnvoyGrpc().GetClusterName()).To(Equal("otel-collector"))
+				Expect(serviceName).To(BeEmpty())
+			})
+
+			It("adds the resource attributes as literal tags", func() {
+				cfg := &envoyhttp.HttpConnectionManager{}
+				hcmSettings = &hcm.HttpConnectionManagerSettings{
+					Tracing: &tracing.ListenerTracingSettings{
+						LiteralsForTags: []*tracing.TracingTagLiteral{
+							{
+								Tag:   "literal",
+								Value: "value",
+							},
+						},
+						ProviderConfig: &tracing.ListenerTracingSettings_OpenTelemetryConfig{
+							OpenTelemetryConfig: &envoytrace_gloo.OpenTelemetryConfig{
+								CollectorCluster: &envoytrace_gloo.OpenTelemetryConfig_ClusterName{
+									ClusterName: "otel-collector",
+								},
+								ResourceAttributes: map[string]string{
+									"service.namespace":      "gloo-system",
+									"deployment.environment": "production",
+								},
+							},
+						},
+					},
+				}
+				err := processHcmNetworkFilter(cfg)
+				Expect(err).To(BeNil())
+
+				literalTag := func(tag, value string) *envoytracing.CustomTag {
+					return &envoytracing.CustomTag{
+						Tag: tag,
+						Type: &envoytracing.CustomTag_Literal_{
+							Literal: &envoytracing.CustomTag_Literal{
+								Value: value,
+							},
+						},
+					}
+				}
+				Expect(cfg.Tracing.CustomTags).To(Equal([]*envoytracing.CustomTag{
+					literalTag("literal", "value"),
+					literalTag("deployment.environment", "production"),
+					literalTag("service.namespace", "gloo-system"),
+				}))
+			})
+		})
+
 	})
 
 	It("should update routes properly", func() {
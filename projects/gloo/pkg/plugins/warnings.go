@@ -0,0 +1,25 @@
+package plugins
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ConfigurationWarning can be returned by plugins for configuration which is translated, but is unlikely to behave
+// as expected. It is reported as a warning on the resource being translated rather than as an error.
+type ConfigurationWarning struct {
+	message string
+}
+
+func NewConfigurationWarning(format string, args ...interface{}) *ConfigurationWarning {
+	return &ConfigurationWarning{message: fmt.Sprintf(format, args...)}
+}
+
+func (w *ConfigurationWarning) Error() string {
+	return w.message
+}
+
+func IsConfigurationWarning(err error) bool {
+	var warning *ConfigurationWarning
+	return errors.As(err, &warning)
+}
@@ -0,0 +1,112 @@
+package wasm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ModulePathPrefix is the prefix of the paths the wasm modules are served on, followed by their sha256
+const ModulePathPrefix = "/wasm/"
+
+// ModuleStore holds the wasm modules of the wasm filters, and serves them to envoy over http by their sha256.
+// The modules no longer referenced by the snapshots served to envoy are dropped by the module pruner.
+type ModuleStore struct {
+	lock    sync.RWMutex
+	modules map[string][]byte
+	// the sha256 of the modules read from disk, by path
+	files map[string]fileModule
+}
+
+type fileModule struct {
+	modTime time.Time
+	size    int64
+	sha256  string
+}
+
+func NewModuleStore() *ModuleStore {
+	return &ModuleStore{
+		modules: map[string][]byte{},
+		files:   map[string]fileModule{},
+	}
+}
+
+// Add stores the module, and returns its hex encoded sha256
+func (s *ModuleStore) Add(module []byte) string {
+	sum := sha256.Sum256(module)
+	key := hex.EncodeToString(sum[:])
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.modules[key] = module
+	return key
+}
+
+// AddFile stores the module read from the file at the given path, and returns its hex encoded sha256.
+// The file is only read again once it changes.
+func (s *ModuleStore) AddFile(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	s.lock.RLock()
+	file, ok := s.files[path]
+	s.lock.RUnlock()
+	if ok && file.modTime.Equal(info.ModTime()) && file.size == info.Size() {
+		return file.sha256, nil
+	}
+
+	module, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	key := s.Add(module)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.files[path] = fileModule{modTime: info.ModTime(), size: info.Size(), sha256: key}
+	return key, nil
+}
+
+func (s *ModuleStore) Get(sha256 string) ([]byte, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	module, ok := s.modules[sha256]
+	return module, ok
+}
+
+// Retain drops the modules whose sha256 is not in the given set
+func (s *ModuleStore) Retain(sha256s map[string]bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for key := range s.modules {
+		if !sha256s[key] {
+			delete(s.modules, key)
+		}
+	}
+	for path, file := range s.files {
+		if !sha256s[file.sha256] {
+			delete(s.files, path)
+		}
+	}
+}
+
+func (s *ModuleStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	module, ok := s.Get(strings.TrimPrefix(r.URL.Path, ModulePathPrefix))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/wasm")
+	_, _ = w.Write(module)
+}
@@ -0,0 +1,73 @@
+package wasm
+
+import (
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+)
+
+var (
+	_ plugins.Plugin           = new(plugin)
+	_ plugins.HttpFilterPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "wasm"
+	FilterName    = "envoy.filters.http.wasm"
+)
+
+// The wasm plugin adds a wasm filter for each filter of the listener's wasm options. Envoy fetches their modules
+// from gloo, which reads them from its disk or from artifacts and serves them with the module store.
+type plugin struct {
+	modules *ModuleStore
+}
+
+// The modules are not served to envoy without the module store of the control plane
+func NewPlugin(modules *ModuleStore) *plugin {
+	if modules == nil {
+		modules = NewModuleStore()
+	}
+	return &plugin{modules: modules}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) error {
+	return nil
+}
+
+// The filters with bad module references are reported, and the remaining ones still added
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	var filters []plugins.StagedHttpFilter
+	var errs *multierror.Error
+	for _, filter := range listener.GetOptions().GetWasm().GetFilters() {
+		filterConfig, err := p.translateFilter(params, filter)
+		if err != nil {
+			errs = multierror.Append(errs, eris.Wrapf(err, "wasm filter %v", filter.GetName()))
+			continue
+		}
+		stagedFilter, err := plugins.NewStagedFilterWithConfig(FilterName, filterConfig, translateFilterStage(filter.GetFilterStage()))
+		if err != nil {
+			return nil, eris.Wrap(err, "generating filter config")
+		}
+		filters = append(filters, stagedFilter)
+	}
+	return filters, errs.ErrorOrNil()
+}
+
+func translateFilterStage(filterStage *wasm.FilterStage) plugins.FilterStage {
+	// the stages of the api are in the order of the well known stages
+	wellKnown := plugins.WellKnownFilterStage(filterStage.GetStage())
+	switch filterStage.GetPredicate() {
+	case wasm.FilterStage_Before:
+		return plugins.BeforeStage(wellKnown)
+	case wasm.FilterStage_After:
+		return plugins.AfterStage(wellKnown)
+	default:
+		return plugins.DuringStage(wellKnown)
+	}
+}
@@ -0,0 +1,216 @@
+package wasm_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoywasm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("wasm plugin", func() {
+
+	var (
+		module       []byte
+		moduleSha256 string
+		modules      *ModuleStore
+		params       plugins.Params
+		artifact     *v1.Artifact
+	)
+
+	BeforeEach(func() {
+		module = []byte("\x00asm\x01\x00\x00\x00")
+		sum := sha256.Sum256(module)
+		moduleSha256 = hex.EncodeToString(sum[:])
+		modules = NewModuleStore()
+		artifact = &v1.Artifact{
+			Metadata: &core.Metadata{Name: "filter", Namespace: "gloo-system"},
+			Data:     map[string]string{"filter.wasm": base64.StdEncoding.EncodeToString(module)},
+		}
+		params = plugins.Params{Snapshot: &v1snap.ApiSnapshot{Artifacts: v1.ArtifactList{artifact}}}
+	})
+
+	listenerWith := func(filters ...*wasm.WasmFilter) *v1.HttpListener {
+		return &v1.HttpListener{Options: &v1.HttpListenerOptions{Wasm: &wasm.PluginSource{Filters: filters}}}
+	}
+
+	artifactFilter := func() *wasm.WasmFilter {
+		return &wasm.WasmFilter{
+			Name:   "filter",
+			RootId: "root",
+			Src:    &wasm.WasmFilter_ArtifactRef{ArtifactRef: artifact.GetMetadata().Ref()},
+		}
+	}
+
+	translateFilter := func(filter plugins.StagedHttpFilter) *envoywasm.Wasm {
+		var cfg envoywasm.Wasm
+		ExpectWithOffset(1, ptypes.UnmarshalAny(filter.HttpFilter.GetTypedConfig(), &cfg)).NotTo(HaveOccurred())
+		ExpectWithOffset(1, cfg.Validate()).NotTo(HaveOccurred())
+		return &cfg
+	}
+
+	It("does not add filters without wasm config", func() {
+		filters, err := NewPlugin(modules).HttpFilters(params, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("fetches the modules of artifacts from gloo", func() {
+		filters, err := NewPlugin(modules).HttpFilters(params, listenerWith(artifactFilter()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+
+		cfg := translateFilter(filters[0])
+		Expect(cfg.GetConfig().GetName()).To(Equal("filter"))
+		Expect(cfg.GetConfig().GetRootId()).To(Equal("root"))
+		vmConfig := cfg.GetConfig().GetVmConfig()
+		Expect(vmConfig.GetRuntime()).To(Equal(V8Runtime))
+		remote := vmConfig.GetCode().GetRemote()
+		Expect(remote.GetSha256()).To(Equal(moduleSha256))
+		Expect(remote.GetHttpUri().GetCluster()).To(Equal(defaults.GlooRestXdsName))
+		Expect(remote.GetHttpUri().GetUri()).To(HaveSuffix(ModulePathPrefix + moduleSha256))
+
+		stored, ok := modules.Get(moduleSha256)
+		Expect(ok).To(BeTrue())
+		Expect(stored).To(Equal(module))
+	})
+
+	It("reads the modules of artifacts as is or base64 encoded", func() {
+		artifact.Data = map[string]string{"other": "", "filter.wasm": string(module)}
+		filter := artifactFilter()
+		filter.ArtifactKey = "filter.wasm"
+		_, err := NewPlugin(modules).HttpFilters(params, listenerWith(filter))
+		Expect(err).NotTo(HaveOccurred())
+		_, ok := modules.Get(moduleSha256)
+		Expect(ok).To(BeTrue())
+
+		artifact.Data["filter.wasm"] = "not a module"
+		_, err = NewPlugin(modules).HttpFilters(params, listenerWith(filter))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("neither a wasm module nor a base64 encoded one"))
+	})
+
+	It("fetches the modules of files from gloo", func() {
+		dir, err := ioutil.TempDir("", "wasm")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "filter.wasm")
+		Expect(ioutil.WriteFile(path, module, 0644)).NotTo(HaveOccurred())
+
+		filter := &wasm.WasmFilter{Name: "filter", Src: &wasm.WasmFilter_FilePath{FilePath: path}, VmType: wasm.WasmFilter_WAVM}
+		filters, err := NewPlugin(modules).HttpFilters(params, listenerWith(filter))
+		Expect(err).NotTo(HaveOccurred())
+		vmConfig := translateFilter(filters[0]).GetConfig().GetVmConfig()
+		Expect(vmConfig.GetRuntime()).To(Equal(WavmRuntime))
+		Expect(vmConfig.GetCode().GetRemote().GetSha256()).To(Equal(moduleSha256))
+
+		filter.Src = &wasm.WasmFilter_FilePath{FilePath: filepath.Join(dir, "missing.wasm")}
+		_, err = NewPlugin(modules).HttpFilters(params, listenerWith(filter))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("places the filters at their stage", func() {
+		filter := artifactFilter()
+		filter.FilterStage = &wasm.FilterStage{Stage: wasm.FilterStage_AuthZStage, Predicate: wasm.FilterStage_Before}
+		filters, err := NewPlugin(modules).HttpFilters(params, listenerWith(filter))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters[0].Stage).To(Equal(plugins.BeforeStage(plugins.AuthZStage)))
+
+		filter.FilterStage = &wasm.FilterStage{Stage: wasm.FilterStage_RouteStage, Predicate: wasm.FilterStage_After}
+		filters, err = NewPlugin(modules).HttpFilters(params, listenerWith(filter))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters[0].Stage).To(Equal(plugins.AfterStage(plugins.RouteStage)))
+	})
+
+	It("reports the filters with bad module references and adds the others", func() {
+		mismatch := artifactFilter()
+		mismatch.Sha256 = "abcd"
+		missing := artifactFilter()
+		missing.Src = &wasm.WasmFilter_ArtifactRef{ArtifactRef: &core.ResourceRef{Name: "missing", Namespace: "gloo-system"}}
+		image := &wasm.WasmFilter{Src: &wasm.WasmFilter_Image{Image: "image"}}
+		valid := artifactFilter()
+		valid.Sha256 = moduleSha256
+
+		filters, err := NewPlugin(modules).HttpFilters(params, listenerWith(mismatch, missing, image, valid))
+		Expect(filters).To(HaveLen(1))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(Sha256MismatchError(moduleSha256, "abcd").Error()))
+		Expect(err.Error()).To(ContainSubstring(ArtifactNotFoundError("gloo-system", "missing").Error()))
+		Expect(err.Error()).To(ContainSubstring(UnsupportedImageError("image").Error()))
+	})
+
+	It("serves the modules by their sha256", func() {
+		key := modules.Add(module)
+		Expect(key).To(Equal(moduleSha256))
+
+		recorder := httptest.NewRecorder()
+		modules.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, ModulePathPrefix+moduleSha256, nil))
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.Bytes()).To(Equal(module))
+
+		recorder = httptest.NewRecorder()
+		modules.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, ModulePathPrefix+"unknown", nil))
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("drops the modules the served snapshots no longer reference", func() {
+		filters, err := NewPlugin(modules).HttpFilters(params, listenerWith(artifactFilter()))
+		Expect(err).NotTo(HaveOccurred())
+		hcm, err := utils.MessageToAny(&envoyhcm.HttpConnectionManager{HttpFilters: []*envoyhcm.HttpFilter{filters[0].HttpFilter}})
+		Expect(err).NotTo(HaveOccurred())
+		listener := &envoy_config_listener_v3.Listener{
+			Name: "listener",
+			FilterChains: []*envoy_config_listener_v3.FilterChain{{
+				Filters: []*envoy_config_listener_v3.Filter{{
+					Name:       wellknown.HTTPConnectionManager,
+					ConfigType: &envoy_config_listener_v3.Filter_TypedConfig{TypedConfig: hcm},
+				}},
+			}},
+		}
+		otherSha256 := modules.Add([]byte("\x00asm\x02\x00\x00\x00"))
+
+		proxy := &v1.Proxy{Metadata: &core.Metadata{Name: "proxy", Namespace: "gloo-system"}}
+		snap := &v1snap.ApiSnapshot{Proxies: v1.ProxyList{proxy}}
+		xdsCache := envoycache.NewSnapshotCache(true, xds.NewNodeHasher(), nil)
+		Expect(xdsCache.SetSnapshot(xds.SnapshotKey(proxy), xds.NewSnapshot("1", nil, nil, nil,
+			[]envoycache.Resource{resource.NewEnvoyResource(listener)}))).NotTo(HaveOccurred())
+
+		pruner := NewModulePruner(modules, nil)
+		_, err = pruner.Sync(context.Background(), snap, nil, xdsCache, nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, ok := modules.Get(moduleSha256)
+		Expect(ok).To(BeTrue())
+		_, ok = modules.Get(otherSha256)
+		Expect(ok).To(BeFalse())
+
+		Expect(xdsCache.SetSnapshot(xds.SnapshotKey(proxy), xds.NewSnapshot("2", nil, nil, nil, nil))).NotTo(HaveOccurred())
+		_, err = pruner.Sync(context.Background(), snap, nil, xdsCache, nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, ok = modules.Get(moduleSha256)
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,96 @@
+package wasm
+
+import (
+	"context"
+
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoywasm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+// ModulePruner is a translator syncer extension dropping the modules of the module store that the snapshots
+// served to envoy no longer reference, once the snapshots of a sync are set.
+// The modules of the snapshots envoy last acked are kept, as they are served again when envoy rejects a snapshot.
+type ModulePruner struct {
+	modules  *ModuleStore
+	rollback *xds.SnapshotRollback
+}
+
+// The rollback is nil if it is disabled
+func NewModulePruner(modules *ModuleStore, rollback *xds.SnapshotRollback) *ModulePruner {
+	return &ModulePruner{modules: modules, rollback: rollback}
+}
+
+// Sync does not serve a snapshot of its own, so returns no node ID
+func (p *ModulePruner) Sync(
+	_ context.Context,
+	snap *v1snap.ApiSnapshot,
+	_ *v1.Settings,
+	xdsCache envoycache.SnapshotCache,
+	_ reporter.ResourceReports,
+) (string, error) {
+	// envoys may still be served the snapshots of deleted proxies, when their garbage collection is disabled
+	keys := append(xds.GetValidKeys(snap.Proxies, nil), xdsCache.GetStatusKeys()...)
+	var snapshots []envoycache.Snapshot
+	for _, key := range keys {
+		if served, err := xdsCache.GetSnapshot(key); err == nil {
+			snapshots = append(snapshots, served)
+		}
+		if p.rollback != nil {
+			if acked := p.rollback.Acked(key); acked != nil {
+				snapshots = append(snapshots, acked)
+			}
+		}
+	}
+	p.modules.Retain(ReferencedModules(snapshots...))
+	return "", nil
+}
+
+// ReferencedModules returns the sha256 of the modules the wasm filters of the snapshots' listeners reference
+func ReferencedModules(snapshots ...envoycache.Snapshot) map[string]bool {
+	referenced := map[string]bool{}
+	for _, snap := range snapshots {
+		for _, res := range snap.GetResources(resource.ListenerTypeV3).Items {
+			listener, ok := res.ResourceProto().(*envoy_config_listener_v3.Listener)
+			if !ok {
+				continue
+			}
+			for _, filterChain := range listener.GetFilterChains() {
+				for _, filter := range filterChain.GetFilters() {
+					addReferencedModules(referenced, filter)
+				}
+			}
+		}
+	}
+	return referenced
+}
+
+func addReferencedModules(referenced map[string]bool, filter *envoy_config_listener_v3.Filter) {
+	if filter.GetName() != wellknown.HTTPConnectionManager || filter.GetTypedConfig() == nil {
+		return
+	}
+	hcm := &envoyhcm.HttpConnectionManager{}
+	if err := ptypes.UnmarshalAny(filter.GetTypedConfig(), hcm); err != nil {
+		return
+	}
+	for _, httpFilter := range hcm.GetHttpFilters() {
+		if httpFilter.GetName() != FilterName || httpFilter.GetTypedConfig() == nil {
+			continue
+		}
+		filterConfig := &envoywasm.Wasm{}
+		if err := ptypes.UnmarshalAny(httpFilter.GetTypedConfig(), filterConfig); err != nil {
+			continue
+		}
+		if sha256 := filterConfig.GetConfig().GetVmConfig().GetCode().GetRemote().GetSha256(); sha256 != "" {
+			referenced[sha256] = true
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package wasm
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoywasm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoy_extensions_wasm_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+)
+
+const (
+	V8Runtime   = "envoy.wasm.runtime.v8"
+	WavmRuntime = "envoy.wasm.runtime.wavm"
+
+	// The time envoy waits for a module to be fetched from gloo
+	ModuleFetchTimeout = 30 * time.Second
+)
+
+// the magic number every wasm module starts with
+var wasmMagic = []byte("\x00asm")
+
+var (
+	MissingSourceError = eris.New("no module source provided")
+
+	UnsupportedImageError = func(image string) error {
+		return eris.Errorf("module image %v is not supported, use a file path or an artifact instead", image)
+	}
+
+	ArtifactNotFoundError = func(namespace, name string) error {
+		return eris.Errorf("no artifact found for module artifact ref %v.%v", namespace, name)
+	}
+
+	ArtifactKeyNotFoundError = func(namespace, name, key string) error {
+		return eris.Errorf("artifact %v.%v has no key %v", namespace, name, key)
+	}
+
+	AmbiguousArtifactKeyError = func(namespace, name string) error {
+		return eris.Errorf("artifact %v.%v does not hold a single entry, an artifact key is required", namespace, name)
+	}
+
+	InvalidModuleError = func(err error) error {
+		return eris.Wrap(err, "artifact data is neither a wasm module nor a base64 encoded one")
+	}
+
+	ModuleFileError = func(err error, path string) error {
+		return eris.Wrapf(err, "reading module file %v", path)
+	}
+
+	Sha256MismatchError = func(actual, expected string) error {
+		return eris.Errorf("module has sha256 %v, expected %v", actual, expected)
+	}
+)
+
+func (p *plugin) translateFilter(params plugins.Params, filter *wasm.WasmFilter) (*envoywasm.Wasm, error) {
+	sha256, err := p.addModule(params, filter)
+	if err != nil {
+		return nil, err
+	}
+	if expected := filter.GetSha256(); expected != "" && !strings.EqualFold(sha256, expected) {
+		return nil, Sha256MismatchError(sha256, expected)
+	}
+
+	runtime := V8Runtime
+	if filter.GetVmType() == wasm.WasmFilter_WAVM {
+		runtime = WavmRuntime
+	}
+	return &envoywasm.Wasm{
+		Config: &envoy_extensions_wasm_v3.PluginConfig{
+			Name:          filter.GetName(),
+			RootId:        filter.GetRootId(),
+			Configuration: filter.GetConfig(),
+			Vm: &envoy_extensions_wasm_v3.PluginConfig_VmConfig{
+				VmConfig: &envoy_extensions_wasm_v3.VmConfig{
+					VmId:    filter.GetName(),
+					Runtime: runtime,
+					Code: &envoy_config_core_v3.AsyncDataSource{
+						Specifier: &envoy_config_core_v3.AsyncDataSource_Remote{
+							Remote: moduleDataSource(sha256),
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// The module is fetched from the REST xDS server of gloo, over the cluster envoy already reaches it with
+func moduleDataSource(sha256 string) *envoy_config_core_v3.RemoteDataSource {
+	return &envoy_config_core_v3.RemoteDataSource{
+		HttpUri: &envoy_config_core_v3.HttpUri{
+			Uri: fmt.Sprintf("http://%v%v%v", defaults.GlooRestXdsName, ModulePathPrefix, sha256),
+			HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+				Cluster: defaults.GlooRestXdsName,
+			},
+			Timeout: ptypes.DurationProto(ModuleFetchTimeout),
+		},
+		Sha256: sha256,
+	}
+}
+
+// Adds the module of the filter to the module store, and returns its sha256
+func (p *plugin) addModule(params plugins.Params, filter *wasm.WasmFilter) (string, error) {
+	switch src := filter.GetSrc().(type) {
+	case *wasm.WasmFilter_FilePath:
+		sha256, err := p.modules.AddFile(src.FilePath)
+		if err != nil {
+			return "", ModuleFileError(err, src.FilePath)
+		}
+		return sha256, nil
+	case *wasm.WasmFilter_ArtifactRef:
+		module, err := artifactModule(params, src.ArtifactRef.GetNamespace(), src.ArtifactRef.GetName(), filter.GetArtifactKey())
+		if err != nil {
+			return "", err
+		}
+		return p.modules.Add(module), nil
+	case *wasm.WasmFilter_Image:
+		return "", UnsupportedImageError(src.Image)
+	default:
+		return "", MissingSourceError
+	}
+}
+
+func artifactModule(params plugins.Params, namespace, name, key string) ([]byte, error) {
+	artifact, err := params.Snapshot.Artifacts.Find(namespace, name)
+	if err != nil {
+		return nil, ArtifactNotFoundError(namespace, name)
+	}
+
+	var data string
+	if key != "" {
+		var ok bool
+		if data, ok = artifact.GetData()[key]; !ok {
+			return nil, ArtifactKeyNotFoundError(namespace, name, key)
+		}
+	} else {
+		if len(artifact.GetData()) != 1 {
+			return nil, AmbiguousArtifactKeyError(namespace, name)
+		}
+		for _, value := range artifact.GetData() {
+			data = value
+		}
+	}
+
+	if bytes.HasPrefix([]byte(data), wasmMagic) {
+		return []byte(data), nil
+	}
+	module, err := base64.StdEncoding.DecodeString(strings.TrimSpace(data))
+	if err != nil {
+		return nil, InvalidModuleError(err)
+	}
+	if !bytes.HasPrefix(module, wasmMagic) {
+		return nil, InvalidModuleError(eris.New("missing wasm magic number"))
+	}
+	return module, nil
+}
@@ -0,0 +1,92 @@
+package syncer
+
+import (
+	"context"
+	"sync"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	syncerstats "github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+// the keys of the secrets whose certificate expiry was last measured
+var (
+	certExpiryLock    sync.Mutex
+	certExpirySecrets = map[string]bool{}
+)
+
+// measureCertificateExpiry records the unix time at which the certificate of each TLS secret referenced by the
+// ssl configurations of the proxies and upstreams expires. The time is absolute, as the secrets are only measured
+// when the snapshot changes: alerts compare it with the current time.
+// OpenCensus cannot drop the rows of a view, so the view is registered again once a secret is no longer measured,
+// for its metric not to be reported anymore.
+func measureCertificateExpiry(ctx context.Context, snap *v1snap.ApiSnapshot) {
+	measured := map[string]bool{}
+	recorded := map[string]bool{}
+	// recorded once the view no longer holds the secrets which are not measured anymore
+	var measurements []func()
+	measure := func(secretRef *core.ResourceRef) {
+		if secretRef == nil || measured[secretRef.Key()] {
+			return
+		}
+		measured[secretRef.Key()] = true
+		secret, err := snap.Secrets.Find(secretRef.Strings())
+		if err != nil {
+			return
+		}
+		notAfter, err := utils.CertificateNotAfter(secret.GetTls().GetCertChain())
+		if err != nil {
+			return
+		}
+		if ctxWithTags, err := tag.New(ctx,
+			tag.Insert(syncerstats.SecretNameKey, secretRef.GetName()),
+			tag.Insert(syncerstats.SecretNamespaceKey, secretRef.GetNamespace()),
+		); err == nil {
+			recorded[secretRef.Key()] = true
+			measurements = append(measurements, func() {
+				stats.Record(ctxWithTags, certNotAfter.M(notAfter.Unix()))
+			})
+		}
+	}
+
+	for _, proxy := range snap.Proxies {
+		for _, listener := range proxy.GetListeners() {
+			for _, sslConfig := range listener.GetSslConfigurations() {
+				measure(sslConfig.GetSecretRef())
+			}
+			measureTcpHosts(listener.GetTcpListener(), measure)
+			for _, matchedListener := range listener.GetHybridListener().GetMatchedListeners() {
+				measure(matchedListener.GetMatcher().GetSslConfig().GetSecretRef())
+				measureTcpHosts(matchedListener.GetTcpListener(), measure)
+			}
+		}
+	}
+	for _, upstream := range snap.Upstreams {
+		measure(upstream.GetSslConfig().GetSecretRef())
+	}
+
+	certExpiryLock.Lock()
+	defer certExpiryLock.Unlock()
+	for key := range certExpirySecrets {
+		if !recorded[key] {
+			view.Unregister(certNotAfterView)
+			_ = view.Register(certNotAfterView)
+			break
+		}
+	}
+	certExpirySecrets = recorded
+	for _, record := range measurements {
+		record()
+	}
+}
+
+func measureTcpHosts(tcpListener *v1.TcpListener, measure func(*core.ResourceRef)) {
+	for _, tcpHost := range tcpListener.GetTcpHosts() {
+		measure(tcpHost.GetSslConfig().GetSecretRef())
+	}
+}
@@ -2,21 +2,23 @@ package syncer
 
 import (
 	"context"
-	"fmt"
-	"net/http"
+	"sync"
+	"time"
 
 	syncerstats "github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
 	"github.com/solo-io/go-utils/hashutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
 
-	"github.com/gorilla/mux"
+	"github.com/hashicorp/go-multierror"
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
+	"github.com/solo-io/gloo/projects/gloo/pkg/admin"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/go-utils/contextutils"
-	"github.com/solo-io/go-utils/log"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
@@ -39,10 +41,28 @@ var (
 		Aggregation: view.LastValue(),
 		TagKeys:     []tag.Key{syncerstats.ProxyNameKey, resourceNameKey},
 	}
+
+	proxyTranslationTime     = stats.Float64("api.gloo.solo.io/translator/translation_time_sec", "The time taken to translate a proxy into an xDS snapshot", "s")
+	proxyTranslationTimeView = &view.View{
+		Name:        "api.gloo.solo.io/translator/translation_time_sec",
+		Measure:     proxyTranslationTime,
+		Description: "The time taken to translate and sanitize the xDS snapshot for a proxy",
+		Aggregation: view.Distribution(0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30),
+		TagKeys:     []tag.Key{syncerstats.ProxyNameKey},
+	}
+
+	certNotAfter     = stats.Int64("gloo.solo.io/secret/cert_not_after_seconds", "The unix time at which the certificate of a TLS secret expires", "s")
+	certNotAfterView = &view.View{
+		Name:        "gloo.solo.io/secret/cert_not_after_seconds",
+		Measure:     certNotAfter,
+		Description: "The unix time at which the certificate of a TLS secret referenced by a proxy or upstream expires, to compare with the current time",
+		Aggregation: view.LastValue(),
+		TagKeys:     []tag.Key{syncerstats.SecretNameKey, syncerstats.SecretNamespaceKey},
+	}
 )
 
 func init() {
-	_ = view.Register(envoySnapshotOutView)
+	_ = view.Register(envoySnapshotOutView, proxyTranslationTimeView, certNotAfterView)
 }
 
 // empty resources to give to envoy when a proxy was deleted
@@ -58,6 +78,7 @@ var (
 		emptyResource,
 		emptyResource,
 		emptyResource,
+		emptyResource,
 	)
 )
 
@@ -71,7 +92,6 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1snap.ApiSnapsh
 	ctx, span := trace.StartSpan(ctx, "gloo.syncer.Sync")
 	defer span.End()
 
-	s.latestSnap = snap
 	ctx = contextutils.WithLogger(ctx, "envoyTranslatorSyncer")
 	logger := contextutils.LoggerFrom(ctx)
 	snapHash := hashutils.MustHash(snap)
@@ -89,6 +109,8 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1snap.ApiSnapsh
 	allReports.Accept(snap.UpstreamGroups.AsInputResources()...)
 	allReports.Accept(snap.Proxies.AsInputResources()...)
 
+	measureCertificateExpiry(ctx, snap)
+
 	if !s.settings.GetGloo().GetDisableProxyGarbageCollection().GetValue() {
 		allKeys := map[string]bool{
 			xds.FallbackNodeKey: true,
@@ -111,97 +133,200 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1snap.ApiSnapsh
 		}
 	}
 
-	for _, proxy := range snap.Proxies {
-		proxyCtx := ctx
-		if ctxWithTags, err := tag.New(proxyCtx, tag.Insert(syncerstats.ProxyNameKey, proxy.GetMetadata().Ref().Key())); err == nil {
-			proxyCtx = ctxWithTags
+	history := s.translationState.History()
+	var resourceHashes admin.ResourceHashes
+	if history.Enabled() {
+		resourceHashes = admin.HashResources(snap)
+	}
+	defer history.Retain(xds.GetValidKeys(snap.Proxies, nil))
+
+	var multiErr *multierror.Error
+	sanitizerActions := make(map[string]*admin.SanitizerActions)
+	defer s.translationState.SetSanitizerActions(sanitizerActions)
+	// proxies are translated concurrently, but their results are applied in the order the proxies
+	// appear in the snapshot, so that the merged reports do not depend on scheduling
+	for _, result := range s.translateProxies(ctx, snap) {
+		proxy := result.proxy
+		if result.err != nil {
+			logger.DPanicw("", zap.Error(result.err))
+			allReports.AddError(proxy, result.err)
+			multiErr = multierror.Append(multiErr, result.err)
+			continue
 		}
-
-		params := plugins.Params{
-			Ctx:      proxyCtx,
-			Snapshot: snap,
+		if result.sanitizerActions != nil {
+			sanitizerActions[result.key] = result.sanitizerActions
 		}
-
-		xdsSnapshot, reports, _, err := s.translator.Translate(params, proxy)
-		if err != nil {
-			err := eris.Wrapf(err, "translation loop failed")
-			logger.DPanicw("", zap.Error(err))
-			return err
-		}
-
-		if validateErr := reports.ValidateStrict(); validateErr != nil {
-			logger.Warnw("Proxy had invalid config", zap.Any("proxy", proxy.GetMetadata().Ref()), zap.Error(validateErr))
+		if result.snapshot == nil {
+			// the proxy was rejected and we were unable to update its endpoints, keep serving the previous snapshot
+			continue
 		}
 
-		key := xds.SnapshotKey(proxy)
-
-		sanitizedSnapshot, err := s.sanitizer.SanitizeSnapshot(ctx, snap, xdsSnapshot, reports)
-		if err != nil {
-			logger.Errorf("proxy %v was rejected due to invalid config: %v\n"+
-				"Attempting to update only EDS information", proxy.GetMetadata().Ref().Key(), err)
-
-			// If the snapshot is invalid, attempt at least to update the EDS information. This is important because
-			// endpoints are relatively ephemeral entities and the previous snapshot Envoy got might be stale by now.
-			sanitizedSnapshot, err = s.updateEndpointsOnly(key, xdsSnapshot)
-			if err != nil {
-				logger.Errorf("endpoint update failed. xDS snapshot for proxy %v will not be updated. "+
-					"Error is: %s", proxy.GetMetadata().Ref().Key(), err)
-				continue
+		// Merge reports after sanitization to capture changes made by the sanitizers
+		allReports.Merge(result.reports)
+		if s.rollback != nil {
+			if rejection := s.rollback.Rejected(result.key, result.snapshot); rejection != nil {
+				// envoy rejected this very snapshot, keep serving the resources of the rejected type it last accepted
+				allReports.AddError(proxy, rejection)
+				result.snapshot = s.rollback.WithAckedResources(result.key, result.snapshot, rejection)
+				if result.snapshot == nil {
+					continue
+				}
 			}
-			logger.Infof("successfully updated EDS information for proxy %v", proxy.GetMetadata().Ref().Key())
 		}
-
-		// Merge reports after sanitization to capture changes made by the sanitizers
-		allReports.Merge(reports)
-		if err := s.xdsCache.SetSnapshot(key, sanitizedSnapshot); err != nil {
-			err := eris.Wrapf(err, "failed while updating xDS snapshot cache")
+		if err := s.xdsCache.SetSnapshot(result.key, result.snapshot); err != nil {
+			err := eris.Wrapf(err, "failed while updating xDS snapshot cache for proxy %v", proxy.GetMetadata().Ref().Key())
 			logger.DPanicw("", zap.Error(err))
-			return err
+			multiErr = multierror.Append(multiErr, err)
+			continue
 		}
+		history.Record(result.key, result.snapshot, resourceHashes)
 
 		// Record some metrics
-		clustersLen := len(xdsSnapshot.GetResources(resource.ClusterTypeV3).Items)
-		listenersLen := len(xdsSnapshot.GetResources(resource.ListenerTypeV3).Items)
-		routesLen := len(xdsSnapshot.GetResources(resource.RouteTypeV3).Items)
-		endpointsLen := len(xdsSnapshot.GetResources(resource.EndpointTypeV3).Items)
+		clustersLen := len(result.translated.GetResources(resource.ClusterTypeV3).Items)
+		listenersLen := len(result.translated.GetResources(resource.ListenerTypeV3).Items)
+		routesLen := len(result.translated.GetResources(resource.RouteTypeV3).Items)
+		endpointsLen := len(result.translated.GetResources(resource.EndpointTypeV3).Items)
 
-		measureResource(proxyCtx, "clusters", clustersLen)
-		measureResource(proxyCtx, "listeners", listenersLen)
-		measureResource(proxyCtx, "routes", routesLen)
-		measureResource(proxyCtx, "endpoints", endpointsLen)
+		measureResource(result.ctx, "clusters", clustersLen)
+		measureResource(result.ctx, "listeners", listenersLen)
+		measureResource(result.ctx, "routes", routesLen)
+		measureResource(result.ctx, "endpoints", endpointsLen)
 
-		logger.Infow("Setting xDS Snapshot", "key", key,
+		logger.Infow("Setting xDS Snapshot", "key", result.key,
 			"clusters", clustersLen,
 			"listeners", listenersLen,
 			"routes", routesLen,
 			"endpoints", endpointsLen)
 
-		logger.Debugf("Full snapshot for proxy %v: %+v", proxy.GetMetadata().GetName(), xdsSnapshot)
+		logger.Debugf("Full snapshot for proxy %v: %+v", proxy.GetMetadata().GetName(), result.translated)
 	}
 
 	logger.Debugf("gloo reports to be written: %v", allReports)
 
-	return nil
+	return multiErr.ErrorOrNil()
+}
+
+// the outcome of translating and sanitizing a single proxy
+type proxyTranslationResult struct {
+	proxy *v1.Proxy
+	// the context carrying the proxy name tag, used to record metrics
+	ctx context.Context
+	key string
+	// the snapshot produced by the translator
+	translated envoycache.Snapshot
+	// the snapshot to store in the xDS cache, nil if the cache should not be updated
+	snapshot envoycache.Snapshot
+	// how the sanitizers changed the snapshot, nil if they did not
+	sanitizerActions *admin.SanitizerActions
+	reports          reporter.ResourceReports
+	err              error
+}
+
+// translates every proxy in the snapshot, using at most `Settings.Gloo.ProxyTranslationConcurrency` workers.
+// the returned results are in the same order as `snap.Proxies`.
+func (s *translatorSyncer) translateProxies(ctx context.Context, snap *v1snap.ApiSnapshot) []proxyTranslationResult {
+	results := make([]proxyTranslationResult, len(snap.Proxies))
+
+	workers := int(s.settings.GetGloo().GetProxyTranslationConcurrency().GetValue())
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(snap.Proxies) {
+		workers = len(snap.Proxies)
+	}
+
+	proxyIndexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range proxyIndexes {
+				results[idx] = s.translateProxy(ctx, snap, snap.Proxies[idx])
+			}
+		}()
+	}
+	for idx := range snap.Proxies {
+		proxyIndexes <- idx
+	}
+	close(proxyIndexes)
+	wg.Wait()
+
+	return results
 }
 
-// TODO(ilackarms): move this somewhere else, make it part of dev-mode
-func (s *translatorSyncer) ServeXdsSnapshots() error {
-	r := mux.NewRouter()
-	r.HandleFunc("/xds", func(w http.ResponseWriter, r *http.Request) {
-		_, _ = fmt.Fprintf(w, log.Sprintf("%v", s.xdsCache))
-	})
-	r.HandleFunc("/api", func(w http.ResponseWriter, r *http.Request) {
-		_, _ = fmt.Fprintf(w, log.Sprintf("%v", s.latestSnap))
-	})
-	return http.ListenAndServe(":10010", r)
+// translateProxy must be safe to call concurrently for different proxies.
+// It does not modify the xDS cache or any state shared with other proxies.
+func (s *translatorSyncer) translateProxy(ctx context.Context, snap *v1snap.ApiSnapshot, proxy *v1.Proxy) proxyTranslationResult {
+	logger := contextutils.LoggerFrom(ctx)
+
+	proxyCtx := ctx
+	if ctxWithTags, err := tag.New(proxyCtx, tag.Insert(syncerstats.ProxyNameKey, proxy.GetMetadata().Ref().Key())); err == nil {
+		proxyCtx = ctxWithTags
+	}
+	result := proxyTranslationResult{
+		proxy: proxy,
+		ctx:   proxyCtx,
+		key:   xds.SnapshotKey(proxy),
+	}
+
+	start := time.Now()
+	defer func() {
+		stats.Record(proxyCtx, proxyTranslationTime.M(time.Since(start).Seconds()))
+	}()
+
+	params := plugins.Params{
+		Ctx:      proxyCtx,
+		Snapshot: snap,
+	}
+
+	xdsSnapshot, reports, _, err := s.translator.Translate(params, proxy)
+	if err != nil {
+		result.err = eris.Wrapf(err, "translation loop failed for proxy %v", proxy.GetMetadata().Ref().Key())
+		return result
+	}
+	if reports == nil {
+		reports = make(reporter.ResourceReports)
+	}
+	result.translated = xdsSnapshot
+	result.reports = reports
+
+	if validateErr := reports.ValidateStrict(); validateErr != nil {
+		logger.Warnw("Proxy had invalid config", zap.Any("proxy", proxy.GetMetadata().Ref()), zap.Error(validateErr))
+	}
+
+	// the sanitizers may remove resources from the snapshot in place
+	unsanitizedSnapshot := xds.CopySnapshotResources(xdsSnapshot)
+	sanitizedSnapshot, err := s.sanitizer.SanitizeSnapshot(ctx, snap, xdsSnapshot, reports)
+	if err != nil {
+		result.sanitizerActions = &admin.SanitizerActions{Rejected: err.Error()}
+		logger.Errorf("proxy %v was rejected due to invalid config: %v\n"+
+			"Attempting to update only EDS information", proxy.GetMetadata().Ref().Key(), err)
+
+		// If the snapshot is invalid, attempt at least to update the EDS information. This is important because
+		// endpoints are relatively ephemeral entities and the previous snapshot Envoy got might be stale by now.
+		sanitizedSnapshot, err = s.updateEndpointsOnly(result.key, xdsSnapshot, snap.Secrets)
+		if err != nil {
+			logger.Errorf("endpoint update failed. xDS snapshot for proxy %v will not be updated. "+
+				"Error is: %s", proxy.GetMetadata().Ref().Key(), err)
+			return result
+		}
+		logger.Infof("successfully updated EDS information for proxy %v", proxy.GetMetadata().Ref().Key())
+	} else if changes := xds.DiffSnapshots(unsanitizedSnapshot, sanitizedSnapshot); len(changes) > 0 {
+		result.sanitizerActions = &admin.SanitizerActions{Changes: changes}
+	}
+	result.snapshot = sanitizedSnapshot
+
+	return result
 }
 
 // TODO(marco): should we update CDS resources as well?
 // Builds an xDS snapshot by combining:
 // - CDS/LDS/RDS information from the previous xDS snapshot
 // - EDS from the Gloo API snapshot translated curing this sync
+// - the secrets the previous listeners and the clusters translated during this sync reference
 // The resulting snapshot will be checked for consistency before being returned.
-func (s *translatorSyncer) updateEndpointsOnly(snapshotKey string, current envoycache.Snapshot) (envoycache.Snapshot, error) {
+func (s *translatorSyncer) updateEndpointsOnly(snapshotKey string, current envoycache.Snapshot, secrets v1.SecretList) (envoycache.Snapshot, error) {
 	var newSnapshot cache.Snapshot
 
 	// Get a copy of the last successful snapshot
@@ -213,13 +338,17 @@ func (s *translatorSyncer) updateEndpointsOnly(snapshotKey string, current envoy
 			current.GetResources(resource.ClusterTypeV3),
 		)
 	} else {
+		// Set endpoints and clusters calculated during this sync
+		endpoints, clusters := current.GetResources(resource.EndpointTypeV3), current.GetResources(resource.ClusterTypeV3)
+		// Keep other resources from previous snapshot
+		routes, listeners := previous.GetResources(resource.RouteTypeV3), previous.GetResources(resource.ListenerTypeV3)
+		referencing := xds.NewSnapshotFromResources(envoycache.Resources{}, clusters, routes, listeners, envoycache.Resources{})
 		newSnapshot = xds.NewSnapshotFromResources(
-			// Set endpoints and clusters calculated during this sync
-			current.GetResources(resource.EndpointTypeV3),
-			current.GetResources(resource.ClusterTypeV3),
-			// Keep other resources from previous snapshot
-			previous.GetResources(resource.RouteTypeV3),
-			previous.GetResources(resource.ListenerTypeV3),
+			endpoints,
+			clusters,
+			routes,
+			listeners,
+			translator.GlooSdsSecretResources(secrets, referencing),
 		)
 	}
 
@@ -198,6 +198,7 @@ func (s *RouteReplacingSanitizer) SanitizeSnapshot(
 		clusters,
 		translator.MakeRdsResources(replacedRouteConfigs),
 		listeners,
+		xdsSnapshot.GetResources(resource.SecretTypeV3),
 	)
 
 	// If the snapshot is not consistent, error
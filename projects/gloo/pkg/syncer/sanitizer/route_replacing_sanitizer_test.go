@@ -227,6 +227,7 @@ var _ = Describe("RouteReplacingSanitizer", func() {
 			envoycache.NewResources("listeners", []envoycache.Resource{
 				resource.NewEnvoyResource(listener),
 			}),
+			envoycache.NewResources("", nil),
 		)
 
 		sanitizer, err := NewRouteReplacingSanitizer(invalidCfgPolicy)
@@ -302,6 +303,7 @@ var _ = Describe("RouteReplacingSanitizer", func() {
 			envoycache.NewResources("listeners", []envoycache.Resource{
 				resource.NewEnvoyResource(listener),
 			}),
+			envoycache.NewResources("", nil),
 		)
 
 		sanitizer, err := NewRouteReplacingSanitizer(invalidCfgPolicy)
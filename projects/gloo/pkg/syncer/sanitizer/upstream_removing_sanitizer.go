@@ -80,6 +80,7 @@ func (s *UpstreamRemovingSanitizer) SanitizeSnapshot(
 		clusters,
 		xdsSnapshot.GetResources(resource.RouteTypeV3),
 		xdsSnapshot.GetResources(resource.ListenerTypeV3),
+		xdsSnapshot.GetResources(resource.SecretTypeV3),
 	)
 
 	// If the snapshot is not consistent,
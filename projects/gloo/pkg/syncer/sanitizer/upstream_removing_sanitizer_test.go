@@ -75,6 +75,7 @@ var _ = Describe("UpstreamRemovingSanitizer", func() {
 			}),
 			envoycache.NewResources("", nil),
 			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
 		)
 		sanitizer := NewUpstreamRemovingSanitizer()
 
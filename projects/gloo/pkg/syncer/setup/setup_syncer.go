@@ -27,6 +27,7 @@ import (
 	"github.com/solo-io/gloo/pkg/utils/channelutils"
 	"github.com/solo-io/gloo/pkg/utils/setuputils"
 	gateway "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/admin"
 	rlv1alpha1 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	extauth "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
@@ -37,7 +38,9 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/tracing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
@@ -130,7 +133,8 @@ type setupSyncer struct {
 func NewControlPlane(ctx context.Context, grpcServer *grpc.Server, bindAddr net.Addr, callbacks xdsserver.Callbacks, start bool) bootstrap.ControlPlane {
 	hasher := &xds.ProxyKeyHasher{}
 	snapshotCache := cache.NewSnapshotCache(true, hasher, contextutils.LoggerFrom(ctx))
-	xdsServer := server.NewServer(ctx, snapshotCache, callbacks)
+	nodeTracker := xds.NewNodeTracker()
+	xdsServer := server.NewServer(ctx, snapshotCache, xds.MultiCallbacks{nodeTracker, callbacks})
 	reflection.Register(grpcServer)
 
 	return bootstrap.ControlPlane{
@@ -142,6 +146,8 @@ func NewControlPlane(ctx context.Context, grpcServer *grpc.Server, bindAddr net.
 		},
 		SnapshotCache: snapshotCache,
 		XDSServer:     xdsServer,
+		NodeTracker:   nodeTracker,
+		WasmModules:   wasm.NewModuleStore(),
 	}
 }
 
@@ -161,6 +167,7 @@ var (
 	DefaultXdsBindAddr        = fmt.Sprintf("0.0.0.0:%v", defaults.GlooXdsPort)
 	DefaultValidationBindAddr = fmt.Sprintf("0.0.0.0:%v", defaults.GlooValidationPort)
 	DefaultRestXdsBindAddr    = fmt.Sprintf("0.0.0.0:%v", defaults.GlooRestXdsPort)
+	DefaultAdminApiBindAddr   = fmt.Sprintf("127.0.0.1:%v", defaults.GlooAdminApiPort)
 )
 
 func getAddr(addr string) (*net.TCPAddr, error) {
@@ -464,7 +471,7 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions, apiEmitte
 	}
 
 	// Register grpc endpoints to the grpc server
-	xds.SetupEnvoyXds(opts.ControlPlane.GrpcServer, opts.ControlPlane.XDSServer, opts.ControlPlane.SnapshotCache)
+	xds.SetupEnvoyXds(opts.ControlPlane.GrpcServer, opts.ControlPlane.XDSServer, opts.ControlPlane.SnapshotCache, opts.ControlPlane.NodeTracker)
 	xdsHasher := xds.NewNodeHasher()
 
 	pluginRegistryFactory := extensions.PluginRegistryFactory
@@ -484,6 +491,12 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions, apiEmitte
 
 	startRestXdsServer(opts)
 
+	if controlPlaneTracing := opts.Settings.GetGloo().GetControlPlaneTracing(); controlPlaneTracing != nil {
+		if err := tracing.StartExporter(opts.WatchOpts.Ctx, controlPlaneTracing); err != nil {
+			return err
+		}
+	}
+
 	errs := make(chan error)
 
 	statusClient := gloostatusutils.GetStatusClientForNamespace(opts.StatusReporterNamespace)
@@ -548,7 +561,7 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions, apiEmitte
 		rlReporterClient,
 	)
 
-	t := translator.NewTranslator(sslutils.NewSslConfigTranslator(), opts.Settings, pluginRegistryFactory)
+	t := translator.NewTranslator(sslutils.NewSslConfigTranslatorForSettings(opts.Settings), opts.Settings, pluginRegistryFactory)
 
 	routeReplacingSanitizer, err := sanitizer.NewRouteReplacingSanitizer(opts.Settings.GetGloo().GetInvalidConfigPolicy())
 	if err != nil {
@@ -593,7 +606,30 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions, apiEmitte
 	if err != nil {
 		return err
 	}
-	translationSync := syncer.NewTranslatorSyncer(t, opts.ControlPlane.SnapshotCache, xdsHasher, xdsSanitizer, rpt, opts.DevMode, syncerExtensions, opts.Settings, statusMetrics)
+	historySize := admin.DefaultSnapshotHistorySize
+	if size := opts.Settings.GetGloo().GetXdsSnapshotHistorySize(); size != nil {
+		historySize = int(size.GetValue())
+	}
+	translationState := admin.NewTranslationState(historySize)
+	var rollback *xds.SnapshotRollback
+	if nodeTracker := opts.ControlPlane.NodeTracker; nodeTracker != nil {
+		if opts.Settings.GetGloo().GetDisableNackRollback().GetValue() {
+			// the settings may have changed since the control plane started
+			nodeTracker.SetAckListener(nil)
+		} else {
+			rollback = xds.NewSnapshotRollback(watchOpts.Ctx, opts.ControlPlane.SnapshotCache, opts.Settings.GetGloo().GetLastAckedSnapshotDir())
+			// serve the snapshots envoy last accepted until the first translation
+			if err := rollback.Restore(restoredSecrets(watchOpts.Ctx, secretClient, opts.WatchNamespaces)); err != nil {
+				logger.Warnw("failed to restore the acked xDS snapshots", zap.Error(err))
+			}
+			nodeTracker.SetAckListener(rollback)
+		}
+	}
+	if opts.ControlPlane.WasmModules != nil {
+		syncerExtensions = append(syncerExtensions, wasm.NewModulePruner(opts.ControlPlane.WasmModules, rollback))
+	}
+	translationSync := syncer.NewTranslatorSyncer(t, opts.ControlPlane.SnapshotCache, xdsHasher, xdsSanitizer, rpt, translationState, rollback, syncerExtensions, opts.Settings, statusMetrics)
+	startAdminApiServer(opts, admin.NewServer(opts.ControlPlane.SnapshotCache, opts.ControlPlane.NodeTracker, translationState))
 
 	syncers := v1snap.ApiSyncers{
 		translationSync,
@@ -712,9 +748,14 @@ func startRestXdsServer(opts bootstrap.Opts) {
 	if restXdsAddr == "" {
 		restXdsAddr = DefaultRestXdsBindAddr
 	}
+	mux := http.NewServeMux()
+	mux.Handle("/", restClient)
+	if opts.ControlPlane.WasmModules != nil {
+		mux.Handle(wasm.ModulePathPrefix, opts.ControlPlane.WasmModules)
+	}
 	srv := &http.Server{
 		Addr:    restXdsAddr,
-		Handler: restClient,
+		Handler: mux,
 	}
 	go func() {
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -730,6 +771,49 @@ func startRestXdsServer(opts bootstrap.Opts) {
 	}()
 }
 
+// restoredSecrets rebuilds the secrets of the restored xDS snapshots from the secrets in the watched namespaces,
+// which are only listed if a snapshot is restored
+func restoredSecrets(ctx context.Context, secretClient v1.SecretClient, namespaces []string) xds.RebuildSecrets {
+	var secrets v1.SecretList
+	listed := false
+	return func(snap cache.Snapshot) (cache.Resources, error) {
+		if !listed {
+			for _, ns := range namespaces {
+				nsSecrets, err := secretClient.List(ns, clients.ListOpts{Ctx: ctx})
+				if err != nil {
+					return cache.Resources{}, err
+				}
+				secrets = append(secrets, nsSecrets...)
+			}
+			secrets.Sort()
+			listed = true
+		}
+		return translator.GlooSdsSecretResources(secrets, snap), nil
+	}
+}
+
+func startAdminApiServer(opts bootstrap.Opts, adminServer *admin.Server) {
+	adminApiAddr := opts.Settings.GetGloo().GetAdminApiBindAddr()
+	if adminApiAddr == "" {
+		adminApiAddr = DefaultAdminApiBindAddr
+	}
+	srv := &http.Server{
+		Addr:    adminApiAddr,
+		Handler: adminServer.Handler(),
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			contextutils.LoggerFrom(opts.WatchOpts.Ctx).Warnf("error while running admin API server", zap.Error(err))
+		}
+	}()
+	go func() {
+		<-opts.WatchOpts.Ctx.Done()
+		if err := srv.Close(); err != nil {
+			contextutils.LoggerFrom(opts.WatchOpts.Ctx).Warnf("error while shutting down admin API server", zap.Error(err))
+		}
+	}()
+}
+
 func constructOpts(ctx context.Context, clientset *kubernetes.Interface, kubeCache kube.SharedCache, consulClient *consulapi.Client, vaultClient *vaultapi.Client, memCache memory.InMemoryResourceCache, settings *v1.Settings) (bootstrap.Opts, error) {
 
 	var (
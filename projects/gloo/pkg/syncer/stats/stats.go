@@ -3,5 +3,7 @@ package stats
 import "go.opencensus.io/tag"
 
 var (
-	ProxyNameKey, _ = tag.NewKey("proxy_name")
+	ProxyNameKey, _       = tag.NewKey("proxy_name")
+	SecretNameKey, _      = tag.NewKey("secret_name")
+	SecretNamespaceKey, _ = tag.NewKey("secret_namespace")
 )
@@ -3,6 +3,7 @@ package syncer
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
@@ -13,6 +14,8 @@ type MockXdsCache struct {
 	Called bool
 	// Snap that is set
 	SetSnap envoycache.Snapshot
+	// All snaps that are set, by node
+	SetSnaps map[string]envoycache.Snapshot
 	// Snap that is returned
 	GetSnap envoycache.Snapshot
 }
@@ -36,6 +39,10 @@ func (c *MockXdsCache) GetStatusKeys() []string {
 func (c *MockXdsCache) SetSnapshot(node string, snapshot envoycache.Snapshot) error {
 	c.Called = true
 	c.SetSnap = snapshot
+	if c.SetSnaps == nil {
+		c.SetSnaps = map[string]envoycache.Snapshot{}
+	}
+	c.SetSnaps[node] = snapshot
 	return nil
 }
 
@@ -51,13 +58,17 @@ func (*MockXdsCache) ClearSnapshot(node string) {
 }
 
 type MockXdsSanitizer struct {
+	// guards Called, as proxies may be sanitized concurrently
+	lock   sync.Mutex
 	Called bool
 	Snap   envoycache.Snapshot
 	Err    error
 }
 
 func (s *MockXdsSanitizer) SanitizeSnapshot(ctx context.Context, glooSnapshot *v1snap.ApiSnapshot, xdsSnapshot envoycache.Snapshot, reports reporter.ResourceReports) (envoycache.Snapshot, error) {
+	s.lock.Lock()
 	s.Called = true
+	s.lock.Unlock()
 	if s.Snap != nil {
 		return s.Snap, nil
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/gateway/pkg/utils/metrics"
+	"github.com/solo-io/gloo/projects/gloo/pkg/admin"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
 	"github.com/solo-io/go-utils/contextutils"
@@ -24,8 +25,10 @@ type translatorSyncer struct {
 	xdsCache   envoycache.SnapshotCache
 	xdsHasher  *xds.ProxyKeyHasher
 	reporter   reporter.StatusReporter
-	// used for debugging purposes only
-	latestSnap *v1snap.ApiSnapshot
+	// the outcome of the latest sync, exposed by the admin API for debugging purposes
+	translationState *admin.TranslationState
+	// rolls proxies back to the last snapshot acked by envoy when it rejects a snapshot, nil if disabled
+	rollback   *xds.SnapshotRollback
 	extensions []TranslatorSyncerExtension
 	// used to track which envoy node IDs exist without belonging to a proxy
 	extensionKeys map[string]struct{}
@@ -60,28 +63,27 @@ func NewTranslatorSyncer(
 	xdsHasher *xds.ProxyKeyHasher,
 	sanitizer sanitizer.XdsSanitizer,
 	reporter reporter.StatusReporter,
-	devMode bool,
+	translationState *admin.TranslationState,
+	rollback *xds.SnapshotRollback,
 	extensions []TranslatorSyncerExtension,
 	settings *v1.Settings,
 	statusMetrics metrics.ConfigStatusMetrics,
 ) v1snap.ApiSyncer {
-	s := &translatorSyncer{
-		translator:    translator,
-		xdsCache:      xdsCache,
-		xdsHasher:     xdsHasher,
-		reporter:      reporter,
-		extensions:    extensions,
-		sanitizer:     sanitizer,
-		settings:      settings,
-		statusMetrics: statusMetrics,
+	if translationState == nil {
+		translationState = admin.NewTranslationState(0)
 	}
-	if devMode {
-		// TODO(ilackarms): move this somewhere else?
-		go func() {
-			_ = s.ServeXdsSnapshots()
-		}()
+	return &translatorSyncer{
+		translator:       translator,
+		xdsCache:         xdsCache,
+		xdsHasher:        xdsHasher,
+		reporter:         reporter,
+		translationState: translationState,
+		rollback:         rollback,
+		extensions:       extensions,
+		sanitizer:        sanitizer,
+		settings:         settings,
+		statusMetrics:    statusMetrics,
 	}
-	return s
 }
 
 func (s *translatorSyncer) Sync(ctx context.Context, snap *v1snap.ApiSnapshot) error {
@@ -100,7 +102,9 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1snap.ApiSnapshot) e
 			multiErr = multierror.Append(multiErr, err)
 		}
 		reports.Merge(intermediateReports)
-		s.extensionKeys[nodeID] = struct{}{}
+		if nodeID != "" {
+			s.extensionKeys[nodeID] = struct{}{}
+		}
 	}
 
 	if err := s.reporter.WriteReports(ctx, reports, nil); err != nil {
@@ -112,6 +116,7 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1snap.ApiSnapshot) e
 		status := s.reporter.StatusFromReport(report, nil)
 		s.statusMetrics.SetResourceStatus(ctx, resource, status)
 	}
+	s.translationState.SetSnapshot(snap, reports)
 
 	return multiErr.ErrorOrNil()
 }
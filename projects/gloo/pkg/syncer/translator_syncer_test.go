@@ -4,8 +4,12 @@ import (
 	"context"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/solo-io/gloo/pkg/utils/statusutils"
@@ -15,7 +19,10 @@ import (
 	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer"
+	syncerstats "github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	gloohelpers "github.com/solo-io/gloo/test/helpers"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
@@ -25,6 +32,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"github.com/solo-io/solo-kit/pkg/errors"
+	"go.opencensus.io/stats/view"
 )
 
 var _ = Describe("Translate Proxy", func() {
@@ -77,7 +85,7 @@ var _ = Describe("Translate Proxy", func() {
 		rep := reporter.NewReporter(ref, statusClient, proxyClient.BaseClient(), upstreamClient)
 
 		xdsHasher := &xds.ProxyKeyHasher{}
-		syncer = NewTranslatorSyncer(&mockTranslator{true, false, nil}, xdsCache, xdsHasher, sanitizer, rep, false, nil, settings, statusMetrics)
+		syncer = NewTranslatorSyncer(&mockTranslator{true, false, nil}, xdsCache, xdsHasher, sanitizer, rep, nil, nil, nil, settings, statusMetrics)
 		snap = &v1snap.ApiSnapshot{
 			Proxies: v1.ProxyList{
 				proxy,
@@ -106,7 +114,7 @@ var _ = Describe("Translate Proxy", func() {
 		Expect(err).NotTo(HaveOccurred())
 		snap.Proxies[0] = p1
 
-		syncer = NewTranslatorSyncer(&mockTranslator{false, false, nil}, xdsCache, xdsHasher, sanitizer, rep, false, nil, settings, statusMetrics)
+		syncer = NewTranslatorSyncer(&mockTranslator{false, false, nil}, xdsCache, xdsHasher, sanitizer, rep, nil, nil, nil, settings, statusMetrics)
 
 		err = syncer.Sync(context.Background(), snap)
 		Expect(err).NotTo(HaveOccurred())
@@ -140,14 +148,44 @@ var _ = Describe("Translate Proxy", func() {
 	It("uses listeners and routes from the previous snapshot when sanitization fails", func() {
 		sanitizer.Err = errors.Errorf("we ran out of coffee")
 
+		tlsSecret := &v1.Secret{
+			Metadata: &core.Metadata{Name: "tls", Namespace: "gloo-system"},
+			Kind:     &v1.Secret_Tls{Tls: &v1.TlsSecret{CertChain: gloohelpers.Certificate(), PrivateKey: gloohelpers.PrivateKey()}},
+		}
+		certName := utils.GlooSdsCertificateName(tlsSecret.GetMetadata().Ref())
+		oldListener := &envoy_config_listener_v3.Listener{
+			Name: "old-listener",
+			FilterChains: []*envoy_config_listener_v3.FilterChain{{
+				TransportSocket: &envoy_config_core_v3.TransportSocket{
+					Name: wellknown.TransportSocketTls,
+					ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{
+						TypedConfig: utils.MustMessageToAny(&envoyauth.DownstreamTlsContext{
+							CommonTlsContext: &envoyauth.CommonTlsContext{
+								TlsCertificateSdsSecretConfigs: []*envoyauth.SdsSecretConfig{{
+									Name: certName,
+									SdsConfig: &envoy_config_core_v3.ConfigSource{
+										ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_Ads{Ads: &envoy_config_core_v3.AggregatedConfigSource{}},
+									},
+								}},
+							},
+						}),
+					},
+				},
+			}},
+		}
 		oldXdsSnap := xds.NewSnapshotFromResources(
 			envoycache.NewResources("", nil),
 			envoycache.NewResources("", nil),
 			envoycache.NewResources("", nil),
 			envoycache.NewResources("old listeners from before the war", []envoycache.Resource{
-				resource.NewEnvoyResource(&envoy_config_listener_v3.Listener{}),
+				resource.NewEnvoyResource(oldListener),
+			}),
+			envoycache.NewResources("old secrets", []envoycache.Resource{
+				xds.NewSecretResource(&envoyauth.Secret{Name: certName}),
+				xds.NewSecretResource(&envoyauth.Secret{Name: "removed-since"}),
 			}),
 		)
+		snap.Secrets = v1.SecretList{tlsSecret}
 
 		// return this old snapshot when the syncer asks for it
 		xdsCache.GetSnap = oldXdsSnap
@@ -166,6 +204,17 @@ var _ = Describe("Translate Proxy", func() {
 		newRoutes := xdsCache.SetSnap.GetResources(resource.RouteTypeV3)
 
 		Expect(oldRoutes).To(Equal(newRoutes))
+
+		// only the secrets the old listeners reference are served, at their latest version
+		secrets := xdsCache.SetSnap.GetResources(resource.SecretTypeV3)
+		Expect(secrets.Items).To(HaveLen(1))
+		Expect(secrets.Items).To(HaveKey(certName))
+		Expect(secrets.Items[certName].ResourceProto().(*envoyauth.Secret).GetTlsCertificate().GetCertificateChain().GetInlineString()).To(Equal(gloohelpers.Certificate()))
+
+		// the version of the secrets does not change with the endpoints
+		err = syncer.Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(xdsCache.SetSnap.GetResources(resource.SecretTypeV3).Version).To(Equal(secrets.Version))
 	})
 
 })
@@ -238,7 +287,7 @@ var _ = Describe("Empty cache", func() {
 		)
 		statusMetrics, err = metrics.NewConfigStatusMetrics(metrics.GetDefaultConfigStatusOptions())
 		Expect(err).NotTo(HaveOccurred())
-		syncer = NewTranslatorSyncer(&mockTranslator{true, false, snapshot}, xdsCache, xdsHasher, sanitizer, rep, false, nil, settings, statusMetrics)
+		syncer = NewTranslatorSyncer(&mockTranslator{true, false, snapshot}, xdsCache, xdsHasher, sanitizer, rep, nil, nil, nil, settings, statusMetrics)
 
 		_, err = proxyClient.Write(proxy, clients.WriteOpts{})
 		Expect(err).NotTo(HaveOccurred())
@@ -384,7 +433,7 @@ var _ = Describe("Translate mulitple proxies with errors", func() {
 		rep := reporter.NewReporter(ref, statusClient, proxyClient.BaseClient(), usClient)
 
 		xdsHasher := &xds.ProxyKeyHasher{}
-		syncer = NewTranslatorSyncer(&mockTranslator{true, true, nil}, xdsCache, xdsHasher, sanitizer, rep, false, nil, settings, statusMetrics)
+		syncer = NewTranslatorSyncer(&mockTranslator{true, true, nil}, xdsCache, xdsHasher, sanitizer, rep, nil, nil, nil, settings, statusMetrics)
 		snap = &v1snap.ApiSnapshot{
 			Proxies: v1.ProxyList{
 				proxy1,
@@ -460,6 +509,309 @@ var _ = Describe("Translate mulitple proxies with errors", func() {
 
 		Expect(xdsCache.Called).To(BeTrue())
 	})
+
+	It("merges reports in proxy order when proxies are translated concurrently", func() {
+		settings.Gloo = &v1.GlooOptions{
+			ProxyTranslationConcurrency: &wrappers.UInt32Value{Value: 2},
+		}
+
+		writeUniqueErrsToUpstreams()
+
+		upstreams, err := upstreamClient.List(ns, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(statusClient.GetStatus(upstreams[0])).To(Equal(&core.Status{
+			State:      2,
+			Reason:     "2 errors occurred:\n\t* upstream is bad - determined by proxy-name1\n\t* upstream is bad - determined by proxy-name2\n\n",
+			ReportedBy: ref,
+		}))
+	})
+})
+
+var _ = Describe("Translate proxies concurrently", func() {
+
+	var (
+		xdsCache     *MockXdsCache
+		syncer       v1snap.ApiSyncer
+		snap         *v1snap.ApiSnapshot
+		proxyClient  v1.ProxyClient
+		ns           = "any-ns"
+		ref          = "syncer-test"
+		statusClient resources.StatusClient
+	)
+
+	BeforeEach(func() {
+		xdsCache = &MockXdsCache{}
+
+		resourceClientFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+
+		proxyClient, _ = v1.NewProxyClient(context.Background(), resourceClientFactory)
+		usClient, err := resourceClientFactory.NewResourceClient(context.Background(), factory.NewResourceClientParams{ResourceType: &v1.Upstream{}})
+		Expect(err).NotTo(HaveOccurred())
+
+		snap = &v1snap.ApiSnapshot{}
+		for _, name := range []string{"proxy-1", "proxy-2", "proxy-3", "proxy-4"} {
+			proxy := &v1.Proxy{
+				Metadata: &core.Metadata{
+					Namespace: ns,
+					Name:      name,
+				},
+			}
+			proxy, err = proxyClient.Write(proxy, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			snap.Proxies = append(snap.Proxies, proxy)
+		}
+
+		settings := &v1.Settings{
+			Gloo: &v1.GlooOptions{
+				ProxyTranslationConcurrency: &wrappers.UInt32Value{Value: 3},
+			},
+		}
+		statusClient = statusutils.GetStatusClientFromEnvOrDefault(ns)
+		statusMetrics, err := metrics.NewConfigStatusMetrics(metrics.GetDefaultConfigStatusOptions())
+		Expect(err).NotTo(HaveOccurred())
+		rep := reporter.NewReporter(ref, statusClient, proxyClient.BaseClient(), usClient)
+
+		translator := &failingProxyTranslator{failingProxy: "proxy-2"}
+		syncer = NewTranslatorSyncer(translator, xdsCache, &xds.ProxyKeyHasher{}, &MockXdsSanitizer{}, rep, nil, nil, nil, settings, statusMetrics)
+	})
+
+	It("isolates translation errors to the proxy that failed", func() {
+		err := syncer.Sync(context.Background(), snap)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("proxy-2 is broken"))
+
+		Expect(xdsCache.SetSnaps).To(HaveLen(3))
+		for _, name := range []string{"proxy-1", "proxy-3", "proxy-4"} {
+			Expect(xdsCache.SetSnaps).To(HaveKey(ns + "~" + name))
+		}
+		Expect(xdsCache.SetSnaps).NotTo(HaveKey(ns + "~proxy-2"))
+
+		proxies, err := proxyClient.List(ns, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		for _, proxy := range proxies {
+			status := statusClient.GetStatus(proxy)
+			if proxy.GetMetadata().GetName() == "proxy-2" {
+				Expect(status.GetState()).To(Equal(core.Status_Rejected))
+				Expect(status.GetReason()).To(ContainSubstring("proxy-2 is broken"))
+			} else {
+				Expect(status.GetState()).To(Equal(core.Status_Accepted))
+			}
+		}
+	})
+})
+
+var _ = Describe("Snapshots rejected by envoy", func() {
+
+	var (
+		xdsCache     envoycache.SnapshotCache
+		rollback     *xds.SnapshotRollback
+		translator   *mockTranslator
+		syncer       v1snap.ApiSyncer
+		snap         *v1snap.ApiSnapshot
+		proxyClient  v1.ProxyClient
+		ns           = "any-ns"
+		key          = ns + "~proxy"
+		ref          = "syncer-test"
+		statusClient resources.StatusClient
+	)
+
+	snapshot := func(version string, clusterName string) envoycache.Snapshot {
+		return xds.NewSnapshot(version, nil, []envoycache.Resource{
+			resource.NewEnvoyResource(&envoy_config_cluster_v3.Cluster{Name: clusterName}),
+		}, nil, nil)
+	}
+
+	BeforeEach(func() {
+		ctx := context.Background()
+		xdsCache = envoycache.NewSnapshotCache(true, xds.NewNodeHasher(), nil)
+		rollback = xds.NewSnapshotRollback(ctx, xdsCache, "")
+
+		resourceClientFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		proxyClient, _ = v1.NewProxyClient(ctx, resourceClientFactory)
+		usClient, err := resourceClientFactory.NewResourceClient(ctx, factory.NewResourceClientParams{ResourceType: &v1.Upstream{}})
+		Expect(err).NotTo(HaveOccurred())
+		proxy, err := proxyClient.Write(&v1.Proxy{Metadata: &core.Metadata{Namespace: ns, Name: "proxy"}}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		snap = &v1snap.ApiSnapshot{Proxies: v1.ProxyList{proxy}}
+
+		statusClient = statusutils.GetStatusClientFromEnvOrDefault(ns)
+		statusMetrics, err := metrics.NewConfigStatusMetrics(metrics.GetDefaultConfigStatusOptions())
+		Expect(err).NotTo(HaveOccurred())
+		rep := reporter.NewReporter(ref, statusClient, proxyClient.BaseClient(), usClient)
+
+		translator = &mockTranslator{currentSnapshot: snapshot("1", "good")}
+		syncer = NewTranslatorSyncer(translator, xdsCache, &xds.ProxyKeyHasher{}, &MockXdsSanitizer{}, rep, nil, rollback, nil, &v1.Settings{}, statusMetrics)
+	})
+
+	// the proxy is modified, as unchanged proxies are not translated again
+	translateTo := func(xdsSnapshot envoycache.Snapshot) {
+		translator.currentSnapshot = xdsSnapshot
+		snap.Proxies[0].GetMetadata().Labels = map[string]string{
+			"clusters":  xdsSnapshot.GetResources(resource.ClusterTypeV3).Version,
+			"listeners": xdsSnapshot.GetResources(resource.ListenerTypeV3).Version,
+		}
+		ExpectWithOffset(1, syncer.Sync(context.Background(), snap)).NotTo(HaveOccurred())
+	}
+
+	// the version of the clusters of the snapshot in the cache
+	currentVersion := func() string {
+		current, err := xdsCache.GetSnapshot(key)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return current.GetResources(resource.ClusterTypeV3).Version
+	}
+
+	proxyStatus := func() *core.Status {
+		proxy, err := proxyClient.Read(ns, "proxy", clients.ReadOpts{})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return statusClient.GetStatus(proxy)
+	}
+
+	It("keeps serving the last acked snapshot and reports the rejected resources", func() {
+		Expect(syncer.Sync(context.Background(), snap)).NotTo(HaveOccurred())
+		rollback.OnAck(key, map[string]string{resource.ClusterTypeV3: "1"})
+
+		bad := snapshot("2", "bad")
+		translateTo(bad)
+		rollback.OnNack(key, "envoy", resource.ClusterTypeV3, "2", "cluster bad is invalid")
+		Expect(currentVersion()).To(Equal("1"))
+
+		// translating the proxy to the same snapshot does not push it again
+		translateTo(bad)
+		Expect(currentVersion()).To(Equal("1"))
+		Expect(proxyStatus().GetState()).To(Equal(core.Status_Rejected))
+		Expect(proxyStatus().GetReason()).To(ContainSubstring("rejected " + resource.ClusterTypeV3 + " bad"))
+
+		translateTo(snapshot("3", "fixed"))
+		Expect(currentVersion()).To(Equal("3"))
+		Expect(proxyStatus().GetState()).To(Equal(core.Status_Accepted))
+	})
+
+	It("keeps pushing the resources of the other types while the rejection is pending", func() {
+		Expect(syncer.Sync(context.Background(), snap)).NotTo(HaveOccurred())
+		rollback.OnAck(key, map[string]string{resource.ClusterTypeV3: "1"})
+
+		bad := snapshot("2", "bad")
+		translateTo(bad)
+		rollback.OnNack(key, "envoy", resource.ClusterTypeV3, "2", "cluster bad is invalid")
+
+		listeners := envoycache.NewResources("3", []envoycache.Resource{
+			resource.NewEnvoyResource(&envoy_config_listener_v3.Listener{Name: "listener"}),
+		})
+		translateTo(xds.NewSnapshotFromResources(envoycache.Resources{}, bad.GetResources(resource.ClusterTypeV3),
+			envoycache.Resources{}, listeners, envoycache.Resources{}))
+		current, err := xdsCache.GetSnapshot(key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(current.GetResources(resource.ClusterTypeV3).Version).To(Equal("1"))
+		Expect(current.GetResources(resource.ListenerTypeV3).Version).To(Equal("3"))
+		Expect(proxyStatus().GetState()).To(Equal(core.Status_Rejected))
+	})
+})
+
+// returns an error when translating the proxy with the given name
+type failingProxyTranslator struct {
+	failingProxy string
+}
+
+func (t *failingProxyTranslator) Translate(params plugins.Params, proxy *v1.Proxy) (envoycache.Snapshot, reporter.ResourceReports, *validation.ProxyReport, error) {
+	if proxy.GetMetadata().GetName() == t.failingProxy {
+		return nil, nil, nil, errors.Errorf("%s is broken", t.failingProxy)
+	}
+	return envoycache.NilSnapshot{}, reporter.ResourceReports{proxy: {}}, &validation.ProxyReport{}, nil
+}
+
+var _ = Describe("Certificate expiry metric", func() {
+
+	var (
+		syncer   v1snap.ApiSyncer
+		snap     *v1snap.ApiSnapshot
+		usClient clients.ResourceClient
+		ns       = "any-ns"
+	)
+
+	upstreamWithSecret := func(name string) *v1.Upstream {
+		return &v1.Upstream{
+			Metadata: &core.Metadata{Namespace: ns, Name: name},
+			SslConfig: &v1.UpstreamSslConfig{
+				SslSecrets: &v1.UpstreamSslConfig_SecretRef{SecretRef: &core.ResourceRef{Namespace: ns, Name: name}},
+			},
+		}
+	}
+
+	tlsSecret := func(name string) *v1.Secret {
+		return &v1.Secret{
+			Metadata: &core.Metadata{Namespace: ns, Name: name},
+			Kind:     &v1.Secret_Tls{Tls: &v1.TlsSecret{CertChain: gloohelpers.Certificate()}},
+		}
+	}
+
+	// the names of the secrets the metric is reported for
+	measuredSecrets := func() ([]string, error) {
+		rows, err := view.RetrieveData("gloo.solo.io/secret/cert_not_after_seconds")
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, row := range rows {
+			for _, t := range row.Tags {
+				if t.Key == syncerstats.SecretNameKey {
+					names = append(names, t.Value)
+				}
+			}
+		}
+		return names, nil
+	}
+
+	BeforeEach(func() {
+		ctx := context.Background()
+		resourceClientFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		proxyClient, _ := v1.NewProxyClient(ctx, resourceClientFactory)
+		var err error
+		usClient, err = resourceClientFactory.NewResourceClient(ctx, factory.NewResourceClientParams{ResourceType: &v1.Upstream{}})
+		Expect(err).NotTo(HaveOccurred())
+
+		snap = &v1snap.ApiSnapshot{Secrets: v1.SecretList{tlsSecret("kept"), tlsSecret("removed")}}
+		for _, name := range []string{"kept", "removed"} {
+			us, err := usClient.Write(upstreamWithSecret(name), clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			snap.Upstreams = append(snap.Upstreams, us.(*v1.Upstream))
+		}
+
+		statusMetrics, err := metrics.NewConfigStatusMetrics(metrics.GetDefaultConfigStatusOptions())
+		Expect(err).NotTo(HaveOccurred())
+		rep := reporter.NewReporter("syncer-test", statusutils.GetStatusClientFromEnvOrDefault(ns), proxyClient.BaseClient(), usClient)
+		syncer = NewTranslatorSyncer(&mockTranslator{}, &MockXdsCache{}, &xds.ProxyKeyHasher{}, &MockXdsSanitizer{}, rep, nil, nil, nil, &v1.Settings{}, statusMetrics)
+	})
+
+	It("reports the time at which the certificates expire", func() {
+		notAfter, err := utils.CertificateNotAfter(gloohelpers.Certificate())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(syncer.Sync(context.Background(), snap)).NotTo(HaveOccurred())
+		Eventually(func() ([]float64, error) {
+			rows, err := view.RetrieveData("gloo.solo.io/secret/cert_not_after_seconds")
+			var values []float64
+			for _, row := range rows {
+				values = append(values, row.Data.(*view.LastValueData).Value)
+			}
+			return values, err
+		}).Should(ConsistOf(float64(notAfter.Unix()), float64(notAfter.Unix())))
+	})
+
+	It("stops reporting the secrets no longer referenced", func() {
+		Expect(syncer.Sync(context.Background(), snap)).NotTo(HaveOccurred())
+		Eventually(measuredSecrets).Should(ConsistOf("kept", "removed"))
+
+		snap.Upstreams = snap.Upstreams[:1]
+		Expect(syncer.Sync(context.Background(), snap)).NotTo(HaveOccurred())
+		Eventually(measuredSecrets).Should(ConsistOf("kept"))
+	})
 })
 
 type mockTranslator struct {
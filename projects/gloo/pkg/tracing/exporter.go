@@ -0,0 +1,257 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.opencensus.io/trace"
+	coltrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcev1 "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+const (
+	DefaultServiceName = "gloo"
+	ServiceNameKey     = "service.name"
+
+	// FlushInterval is how often the buffered spans are sent to the collector
+	FlushInterval = 5 * time.Second
+	// MaxBufferedSpans is the number of spans buffered between flushes, after which new spans are dropped
+	MaxBufferedSpans = 2048
+
+	flushTimeout        = 10 * time.Second
+	instrumentationName = "github.com/solo-io/gloo"
+
+	// the sampler OpenCensus uses when none is configured
+	openCensusDefaultProbability = 1e-4
+)
+
+var (
+	_ trace.Exporter = new(Exporter)
+
+	InvalidSamplingProbabilityError = func(probability float64) error {
+		return eris.Errorf("sampling probability %v must be between 0 and 1", probability)
+	}
+
+	// the exporter whose sampler is applied, as the exporter of the previous settings may stop after the next one started
+	samplerLock  sync.Mutex
+	samplerOwner *Exporter
+)
+
+// Exporter exports the OpenCensus spans recorded by Gloo, such as the ones of the translator and the syncer,
+// to an OpenTelemetry collector over OTLP/gRPC.
+// Spans are buffered as they end and sent in batches by Flush, so that recording them never waits on the collector.
+type Exporter struct {
+	client   coltrace.TraceServiceClient
+	resource *resourcev1.Resource
+
+	lock    sync.Mutex
+	spans   []*tracev1.Span
+	dropped int
+}
+
+func NewExporter(client coltrace.TraceServiceClient, resourceAttributes map[string]string) *Exporter {
+	attributes := map[string]string{ServiceNameKey: DefaultServiceName}
+	for key, value := range resourceAttributes {
+		attributes[key] = value
+	}
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	resource := &resourcev1.Resource{}
+	for _, key := range keys {
+		resource.Attributes = append(resource.Attributes, &commonv1.KeyValue{Key: key, Value: otlpValue(attributes[key])})
+	}
+	return &Exporter{
+		client:   client,
+		resource: resource,
+	}
+}
+
+// StartExporter registers an Exporter sending the spans of Gloo to the collector of the settings, until ctx is done
+func StartExporter(ctx context.Context, settings *v1.GlooOptions_ControlPlaneTracing) error {
+	sampler := trace.AlwaysSample()
+	if probability := settings.GetSamplingProbability(); probability != nil {
+		if probability.GetValue() < 0 || probability.GetValue() > 1 {
+			return InvalidSamplingProbabilityError(probability.GetValue())
+		}
+		sampler = trace.ProbabilitySampler(probability.GetValue())
+	}
+
+	// the connection is established in the background, and re-established if lost
+	conn, err := grpc.DialContext(ctx, settings.GetOtlpCollectorAddr(), grpc.WithInsecure())
+	if err != nil {
+		return eris.Wrapf(err, "dialing OpenTelemetry collector at %v", settings.GetOtlpCollectorAddr())
+	}
+	exporter := NewExporter(coltrace.NewTraceServiceClient(conn), settings.GetResourceAttributes())
+	trace.RegisterExporter(exporter)
+	samplerLock.Lock()
+	samplerOwner = exporter
+	trace.ApplyConfig(trace.Config{DefaultSampler: sampler})
+	samplerLock.Unlock()
+
+	logger := contextutils.LoggerFrom(ctx)
+	go func() {
+		ticker := time.NewTicker(FlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := exporter.Flush(ctx); err != nil {
+					logger.Warnw("failed to export spans to the OpenTelemetry collector", zap.Error(err))
+				}
+			case <-ctx.Done():
+				trace.UnregisterExporter(exporter)
+				samplerLock.Lock()
+				if samplerOwner == exporter {
+					samplerOwner = nil
+					trace.ApplyConfig(trace.Config{DefaultSampler: trace.ProbabilitySampler(openCensusDefaultProbability)})
+				}
+				samplerLock.Unlock()
+				flushCtx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+				if err := exporter.Flush(flushCtx); err != nil {
+					logger.Warnw("failed to export spans to the OpenTelemetry collector", zap.Error(err))
+				}
+				cancel()
+				if err := conn.Close(); err != nil {
+					logger.Warnw("error while closing connection to the OpenTelemetry collector", zap.Error(err))
+				}
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// ExportSpan buffers the span until the next Flush
+func (e *Exporter) ExportSpan(span *trace.SpanData) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if len(e.spans) >= MaxBufferedSpans {
+		e.dropped++
+		return
+	}
+	e.spans = append(e.spans, translateSpan(span))
+}
+
+// Flush sends the buffered spans to the collector. The spans are dropped if the collector cannot be reached.
+func (e *Exporter) Flush(ctx context.Context) error {
+	e.lock.Lock()
+	spans, dropped := e.spans, e.dropped
+	e.spans, e.dropped = nil, 0
+	e.lock.Unlock()
+
+	var errs *multierror.Error
+	if dropped > 0 {
+		errs = multierror.Append(errs, eris.Errorf("dropped %v spans, as more than %v spans ended since the last export", dropped, MaxBufferedSpans))
+	}
+	if len(spans) == 0 {
+		return errs.ErrorOrNil()
+	}
+
+	_, err := e.client.Export(ctx, &coltrace.ExportTraceServiceRequest{
+		ResourceSpans: []*tracev1.ResourceSpans{{
+			Resource: e.resource,
+			InstrumentationLibrarySpans: []*tracev1.InstrumentationLibrarySpans{{
+				InstrumentationLibrary: &commonv1.InstrumentationLibrary{Name: instrumentationName},
+				Spans:                  spans,
+			}},
+		}},
+	})
+	if err != nil {
+		errs = multierror.Append(errs, eris.Wrapf(err, "exporting %v spans", len(spans)))
+	}
+	return errs.ErrorOrNil()
+}
+
+func translateSpan(in *trace.SpanData) *tracev1.Span {
+	out := &tracev1.Span{
+		TraceId:                in.TraceID[:],
+		SpanId:                 in.SpanID[:],
+		Name:                   in.Name,
+		Kind:                   translateSpanKind(in.SpanKind),
+		StartTimeUnixNano:      uint64(in.StartTime.UnixNano()),
+		EndTimeUnixNano:        uint64(in.EndTime.UnixNano()),
+		Attributes:             translateAttributes(in.Attributes),
+		DroppedAttributesCount: uint32(in.DroppedAttributeCount),
+		DroppedEventsCount:     uint32(in.DroppedAnnotationCount + in.DroppedMessageEventCount),
+		DroppedLinksCount:      uint32(in.DroppedLinkCount),
+		Status:                 &tracev1.Status{Message: in.Status.Message},
+	}
+	if in.ParentSpanID != (trace.SpanID{}) {
+		out.ParentSpanId = in.ParentSpanID[:]
+	}
+	if in.Status.Code != trace.StatusCodeOK {
+		out.Status.Code = tracev1.Status_STATUS_CODE_ERROR
+	}
+	for _, annotation := range in.Annotations {
+		out.Events = append(out.Events, &tracev1.Span_Event{
+			TimeUnixNano: uint64(annotation.Time.UnixNano()),
+			Name:         annotation.Message,
+			Attributes:   translateAttributes(annotation.Attributes),
+		})
+	}
+	for _, link := range in.Links {
+		traceId, spanId := link.TraceID, link.SpanID
+		out.Links = append(out.Links, &tracev1.Span_Link{
+			TraceId:    traceId[:],
+			SpanId:     spanId[:],
+			Attributes: translateAttributes(link.Attributes),
+		})
+	}
+	return out
+}
+
+func translateSpanKind(kind int) tracev1.Span_SpanKind {
+	switch kind {
+	case trace.SpanKindServer:
+		return tracev1.Span_SPAN_KIND_SERVER
+	case trace.SpanKindClient:
+		return tracev1.Span_SPAN_KIND_CLIENT
+	default:
+		return tracev1.Span_SPAN_KIND_INTERNAL
+	}
+}
+
+func translateAttributes(attributes map[string]interface{}) []*commonv1.KeyValue {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var out []*commonv1.KeyValue
+	for _, key := range keys {
+		out = append(out, &commonv1.KeyValue{Key: key, Value: otlpValue(attributes[key])})
+	}
+	return out
+}
+
+// OpenCensus attributes are strings, booleans, 64-bit integers or floats
+func otlpValue(value interface{}) *commonv1.AnyValue {
+	switch v := value.(type) {
+	case bool:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_BoolValue{BoolValue: v}}
+	case int64:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_IntValue{IntValue: v}}
+	case float64:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_DoubleValue{DoubleValue: v}}
+	case string:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: v}}
+	default:
+		return &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: fmt.Sprint(v)}}
+	}
+}
@@ -0,0 +1,133 @@
+package tracing_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/tracing"
+	"go.opencensus.io/trace"
+	coltrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+)
+
+type fakeCollector struct {
+	requests []*coltrace.ExportTraceServiceRequest
+	err      error
+}
+
+func (c *fakeCollector) Export(_ context.Context, in *coltrace.ExportTraceServiceRequest, _ ...grpc.CallOption) (*coltrace.ExportTraceServiceResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.requests = append(c.requests, in)
+	return &coltrace.ExportTraceServiceResponse{}, nil
+}
+
+var _ = Describe("Exporter", func() {
+
+	var (
+		ctx       context.Context
+		collector *fakeCollector
+		exporter  *Exporter
+		span      *trace.SpanData
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		collector = &fakeCollector{}
+		exporter = NewExporter(collector, map[string]string{"deployment.environment": "production"})
+
+		start := time.Unix(100, 0)
+		span = &trace.SpanData{
+			SpanContext: trace.SpanContext{
+				TraceID: trace.TraceID{1, 2, 3},
+				SpanID:  trace.SpanID{4, 5, 6},
+			},
+			ParentSpanID: trace.SpanID{7, 8, 9},
+			Name:         "gloo.translator.Translate",
+			StartTime:    start,
+			EndTime:      start.Add(time.Second),
+			Attributes:   map[string]interface{}{"proxy": "gateway-proxy", "listeners": int64(2)},
+			Annotations:  []trace.Annotation{{Time: start, Message: "translated listeners"}},
+			Status:       trace.Status{Code: trace.StatusCodeInternal, Message: "invalid proxy"},
+		}
+	})
+
+	It("sends the buffered spans to the collector when flushed", func() {
+		exporter.ExportSpan(span)
+		Expect(collector.requests).To(BeEmpty())
+		Expect(exporter.Flush(ctx)).NotTo(HaveOccurred())
+		Expect(collector.requests).To(HaveLen(1))
+
+		resourceSpans := collector.requests[0].GetResourceSpans()[0]
+		var resourceAttributes []string
+		for _, attribute := range resourceSpans.GetResource().GetAttributes() {
+			resourceAttributes = append(resourceAttributes, attribute.GetKey()+"="+attribute.GetValue().GetStringValue())
+		}
+		Expect(resourceAttributes).To(Equal([]string{"deployment.environment=production", "service.name=gloo"}))
+
+		spans := resourceSpans.GetInstrumentationLibrarySpans()[0].GetSpans()
+		Expect(spans).To(HaveLen(1))
+		out := spans[0]
+		Expect(out.GetTraceId()).To(Equal(span.TraceID[:]))
+		Expect(out.GetSpanId()).To(Equal(span.SpanID[:]))
+		Expect(out.GetParentSpanId()).To(Equal(span.ParentSpanID[:]))
+		Expect(out.GetName()).To(Equal("gloo.translator.Translate"))
+		Expect(out.GetKind()).To(Equal(tracev1.Span_SPAN_KIND_INTERNAL))
+		Expect(out.GetEndTimeUnixNano() - out.GetStartTimeUnixNano()).To(Equal(uint64(time.Second)))
+		Expect(out.GetAttributes()).To(HaveLen(2))
+		Expect(out.GetAttributes()[0].GetKey()).To(Equal("listeners"))
+		Expect(out.GetAttributes()[0].GetValue().GetIntValue()).To(Equal(int64(2)))
+		Expect(out.GetAttributes()[1].GetValue().GetStringValue()).To(Equal("gateway-proxy"))
+		Expect(out.GetEvents()[0].GetName()).To(Equal("translated listeners"))
+		Expect(out.GetStatus().GetCode()).To(Equal(tracev1.Status_STATUS_CODE_ERROR))
+		Expect(out.GetStatus().GetMessage()).To(Equal("invalid proxy"))
+
+		Expect(exporter.Flush(ctx)).NotTo(HaveOccurred())
+		Expect(collector.requests).To(HaveLen(1))
+	})
+
+	It("drops the spans beyond the buffer and reports them", func() {
+		for i := 0; i < MaxBufferedSpans+1; i++ {
+			exporter.ExportSpan(span)
+		}
+		err := exporter.Flush(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("dropped 1 spans"))
+		Expect(collector.requests[0].GetResourceSpans()[0].GetInstrumentationLibrarySpans()[0].GetSpans()).To(HaveLen(MaxBufferedSpans))
+	})
+
+	It("reports the spans it failed to send", func() {
+		collector.err = eris.New("unavailable")
+		exporter.ExportSpan(span)
+		err := exporter.Flush(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("exporting 1 spans"))
+	})
+
+	It("keeps the sampler of the settings when the exporter of the previous settings stops", func() {
+		sampled := func() bool {
+			_, span := trace.StartSpan(context.Background(), "span")
+			defer span.End()
+			return span.SpanContext().IsSampled()
+		}
+		settings := &v1.GlooOptions_ControlPlaneTracing{OtlpCollectorAddr: "127.0.0.1:1"}
+
+		previousCtx, cancelPrevious := context.WithCancel(context.Background())
+		Expect(StartExporter(previousCtx, settings)).NotTo(HaveOccurred())
+		currentCtx, cancelCurrent := context.WithCancel(context.Background())
+		defer cancelCurrent()
+		Expect(StartExporter(currentCtx, settings)).NotTo(HaveOccurred())
+
+		cancelPrevious()
+		Consistently(sampled, time.Second).Should(BeTrue())
+
+		cancelCurrent()
+		Eventually(sampled).Should(BeFalse())
+	})
+})
@@ -0,0 +1,89 @@
+package translator
+
+import (
+	"time"
+
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+// reportCertificateExpiry warns on the upstreams and virtual hosts whose TLS secrets have a certificate that has
+// expired or expires within the warning window of the settings.
+// As the warnings depend on the time of the translation, this is not done as part of the cached listener subsystem.
+func (t *translatorInstance) reportCertificateExpiry(
+	params plugins.Params,
+	proxy *v1.Proxy,
+	proxyReport *validationapi.ProxyReport,
+	reports reporter.ResourceReports,
+) {
+	window := utils.CertificateExpiryWarningWindow(t.settings)
+	now := time.Now()
+	warningFor := func(secretRef *core.ResourceRef) string {
+		if secretRef == nil {
+			return ""
+		}
+		// missing secrets are reported when translating the ssl configuration
+		secret, err := params.Snapshot.Secrets.Find(secretRef.Strings())
+		if err != nil {
+			return ""
+		}
+		return utils.CertificateExpiryWarning(secret, window, now)
+	}
+
+	for _, upstream := range params.Snapshot.Upstreams {
+		reports.AddWarning(upstream, warningFor(upstream.GetSslConfig().GetSecretRef()))
+	}
+
+	for i, listener := range proxy.GetListeners() {
+		listenerReport := proxyReport.GetListenerReports()[i]
+		switch listenerType := listener.GetListenerType().(type) {
+		case *v1.Listener_HttpListener:
+			for _, sslConfig := range listener.GetSslConfigurations() {
+				warning := warningFor(sslConfig.GetSecretRef())
+				if warning == "" {
+					continue
+				}
+				vhostReports := listenerReport.GetHttpListenerReport().GetVirtualHostReports()
+				for j, virtualHost := range listenerType.HttpListener.GetVirtualHosts() {
+					if servesVirtualHost(sslConfig, virtualHost) {
+						validation.AppendVirtualHostWarning(vhostReports[j], validationapi.VirtualHostReport_Warning_CertificateExpiryWarning, warning)
+					}
+				}
+			}
+		case *v1.Listener_HybridListener:
+			matchedListenerReports := listenerReport.GetHybridListenerReport().GetMatchedListenerReports()
+			for _, matchedListener := range listenerType.HybridListener.GetMatchedListeners() {
+				warning := warningFor(matchedListener.GetMatcher().GetSslConfig().GetSecretRef())
+				if warning == "" {
+					continue
+				}
+				matchedListenerReport := matchedListenerReports[utils.MatchedRouteConfigName(listener, matchedListener.GetMatcher())]
+				// every virtual host of the matched listener is served with the certificate of its matcher
+				for _, vhostReport := range matchedListenerReport.GetHttpListenerReport().GetVirtualHostReports() {
+					validation.AppendVirtualHostWarning(vhostReport, validationapi.VirtualHostReport_Warning_CertificateExpiryWarning, warning)
+				}
+			}
+		}
+	}
+}
+
+// servesVirtualHost returns whether the certificate of the ssl configuration may be served for the virtual host,
+// that is if the ssl configuration is not restricted to SNI domains or one of them is a domain of the virtual host.
+func servesVirtualHost(sslConfig *v1.SslConfig, virtualHost *v1.VirtualHost) bool {
+	if len(sslConfig.GetSniDomains()) == 0 {
+		return true
+	}
+	for _, sniDomain := range sslConfig.GetSniDomains() {
+		for _, domain := range virtualHost.GetDomains() {
+			if sniDomain == domain || domain == "*" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,409 @@
+package translator
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-multierror"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/hashutils"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// The clusters and endpoints translated from the upstreams in a snapshot
+// are the same for every proxy, so they are computed once and shared by
+// every proxy translated from an equivalent snapshot.
+// When the snapshot changes, only the upstreams that changed (or whose endpoints changed)
+// are re-translated. All the upstreams are re-translated if the secrets, artifacts or settings change.
+type clusterSubsystemTranslator struct {
+	settings              *v1.Settings
+	pluginRegistryFactory plugins.PluginRegistryFactory
+
+	lock sync.Mutex
+	// hash of the snapshot inputs the latest cluster subsystem was computed from
+	latestHash uint64
+	latest     *clusterSubsystem
+	// hash of the inputs shared by all the upstreams the latest upstream translations were computed with
+	sharedInputsHash uint64
+	// the latest translation of each upstream, keyed by upstream ref
+	upstreams map[string]*upstreamTranslation
+}
+
+type clusterSubsystem struct {
+	clusters  []*envoy_config_cluster_v3.Cluster
+	endpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment
+	// reports on the upstreams and upstream groups encountered while translating the clusters,
+	// keyed by resource ref as the resources themselves may be replaced by equivalent ones
+	upstreamReports      map[string]reporter.Report
+	upstreamGroupReports map[string]reporter.Report
+}
+
+// the cluster and endpoints translated from a single upstream
+type upstreamTranslation struct {
+	// hash of the upstream and its endpoints
+	hash    uint64
+	cluster *envoy_config_cluster_v3.Cluster
+	// the load assignment for the cluster, if it has endpoints or uses EDS
+	endpoints *envoy_config_endpoint_v3.ClusterLoadAssignment
+	// whether the upstream has any endpoints
+	hasEndpoints bool
+	report       *reporter.Report
+}
+
+func newClusterSubsystemTranslator(settings *v1.Settings, pluginRegistryFactory plugins.PluginRegistryFactory) *clusterSubsystemTranslator {
+	return &clusterSubsystemTranslator{
+		settings:              settings,
+		pluginRegistryFactory: pluginRegistryFactory,
+	}
+}
+
+// Translate returns the cluster subsystem for the snapshot, reusing the latest one if the
+// upstreams, endpoints and related resources in the snapshot have not changed.
+func (t *clusterSubsystemTranslator) Translate(params plugins.Params) (*clusterSubsystem, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.latest != nil {
+		if hash, err := hashClusterSubsystemInputs(t.settings, params.Snapshot); err == nil && hash == t.latestHash {
+			return t.latest, nil
+		}
+	}
+
+	ctx, span := trace.StartSpan(params.Ctx, "gloo.translator.translateClusterSubsystem")
+	defer span.End()
+	params.Ctx = contextutils.WithLogger(ctx, "cluster_subsystem")
+	logger := contextutils.LoggerFrom(params.Ctx)
+
+	sharedInputsHash, err := hashUpstreamSharedInputs(t.settings, params.Snapshot)
+	if err != nil || sharedInputsHash != t.sharedInputsHash {
+		logger.Debugf("secrets, artifacts or settings changed, translating all upstreams")
+		t.upstreams = nil
+	}
+
+	// the cluster subsystem is translated with its own plugins, as it outlives the translation
+	// of the proxy that happened to trigger it. They are only initialized if an upstream needs to be translated.
+	instance := &translatorInstance{
+		settings: t.settings,
+	}
+
+	reports := make(reporter.ResourceReports)
+	logger.Debugf("verifying upstream groups")
+	instance.verifyUpstreamGroups(params, reports)
+
+	upstreamRefKeyToEndpoints := createUpstreamToEndpointsMap(params.Snapshot.Upstreams, params.Snapshot.Endpoints)
+
+	logger.Debugf("computing envoy clusters and endpoints")
+	upstreams := make(map[string]*upstreamTranslation, len(params.Snapshot.Upstreams))
+	translations := make([]*upstreamTranslation, 0, len(params.Snapshot.Upstreams))
+	translated := 0
+	for _, upstream := range params.Snapshot.Upstreams {
+		key := upstream.GetMetadata().Ref().Key()
+		clusterEndpoints := upstreamRefKeyToEndpoints[key]
+
+		if prev, ok := t.upstreams[key]; ok {
+			if hash, err := hashUpstreamInputs(upstream, clusterEndpoints); err == nil && hash == prev.hash {
+				upstreams[key] = prev
+				translations = append(translations, prev)
+				continue
+			}
+		}
+
+		if instance.pluginRegistry == nil {
+			pluginRegistry := t.pluginRegistryFactory(params.Ctx)
+			if err := initPlugins(params.Ctx, pluginRegistry, t.settings); err != nil {
+				return nil, err
+			}
+			instance.pluginRegistry = pluginRegistry
+		}
+
+		translated++
+		upstreamReports := make(reporter.ResourceReports)
+		cluster, endpoints := instance.computeUpstreamClusterSubsystem(params, upstream, upstreamRefKeyToEndpoints, upstreamReports)
+		translation := &upstreamTranslation{
+			cluster:      cluster,
+			endpoints:    endpoints,
+			hasEndpoints: len(clusterEndpoints) > 0,
+		}
+		if rpt, ok := upstreamReports[upstream]; ok {
+			translation.report = &rpt
+		}
+		translations = append(translations, translation)
+
+		// hash the upstream after translating, as translating it may set defaults on it
+		if translation.hash, err = hashUpstreamInputs(upstream, clusterEndpoints); err == nil {
+			upstreams[key] = translation
+		}
+	}
+	logger.Debugf("translated %d of %d upstreams", translated, len(params.Snapshot.Upstreams))
+	t.upstreams, t.sharedInputsHash = upstreams, sharedInputsHash
+
+	result := newClusterSubsystem(params.Snapshot, translations, reports)
+
+	// hash the snapshot after translating, as verifying the upstream groups
+	// sets the default namespace on their destinations
+	hash, err := hashClusterSubsystemInputs(t.settings, params.Snapshot)
+	if err != nil {
+		t.latest = nil
+		return result, nil
+	}
+	t.latest, t.latestHash = result, hash
+	return result, nil
+}
+
+func newClusterSubsystem(
+	snap *v1snap.ApiSnapshot,
+	translations []*upstreamTranslation,
+	upstreamGroupReports reporter.ResourceReports,
+) *clusterSubsystem {
+	result := &clusterSubsystem{
+		upstreamReports:      make(map[string]reporter.Report),
+		upstreamGroupReports: make(map[string]reporter.Report),
+	}
+	// the load assignments of the upstreams without endpoints come last
+	var emptyEndpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment
+	for i, translation := range translations {
+		result.clusters = append(result.clusters, translation.cluster)
+		switch {
+		case translation.endpoints == nil:
+		case translation.hasEndpoints:
+			result.endpoints = append(result.endpoints, translation.endpoints)
+		default:
+			emptyEndpoints = append(emptyEndpoints, translation.endpoints)
+		}
+		if translation.report != nil {
+			result.upstreamReports[snap.Upstreams[i].GetMetadata().Ref().Key()] = *translation.report
+		}
+	}
+	result.endpoints = append(result.endpoints, emptyEndpoints...)
+
+	for _, ug := range snap.UpstreamGroups {
+		if rpt, ok := upstreamGroupReports[ug]; ok {
+			result.upstreamGroupReports[ug.GetMetadata().Ref().Key()] = rpt
+		}
+	}
+	return result
+}
+
+// reportsFor returns a copy of the cluster subsystem reports, keyed by the matching resources in the snapshot,
+// that can be safely modified by a single proxy translation
+func (c *clusterSubsystem) reportsFor(snap *v1snap.ApiSnapshot) reporter.ResourceReports {
+	reports := make(reporter.ResourceReports)
+	for _, upstream := range snap.Upstreams {
+		if rpt, ok := c.upstreamReports[upstream.GetMetadata().Ref().Key()]; ok {
+			reports[upstream] = cloneReport(rpt)
+		}
+	}
+	for _, ug := range snap.UpstreamGroups {
+		if rpt, ok := c.upstreamGroupReports[ug.GetMetadata().Ref().Key()]; ok {
+			reports[ug] = cloneReport(rpt)
+		}
+	}
+	return reports
+}
+
+func cloneReport(rpt reporter.Report) reporter.Report {
+	cloned := reporter.Report{
+		Warnings: append([]string(nil), rpt.Warnings...),
+		Errors:   rpt.Errors,
+	}
+	// multierror.Append modifies the error it appends to
+	if multiErr, ok := rpt.Errors.(*multierror.Error); ok {
+		cloned.Errors = &multierror.Error{
+			Errors:      append([]error(nil), multiErr.Errors...),
+			ErrorFormat: multiErr.ErrorFormat,
+		}
+	}
+	return cloned
+}
+
+func hashClusterSubsystemInputs(settings *v1.Settings, snap *v1snap.ApiSnapshot) (uint64, error) {
+	return hashResources(
+		[]interface{}{settings},
+		snap.Upstreams.AsInterfaces(),
+		snap.UpstreamGroups.AsInterfaces(),
+		snap.Endpoints.AsInterfaces(),
+		snap.Secrets.AsInterfaces(),
+		snap.Artifacts.AsInterfaces(),
+	)
+}
+
+// hashUpstreamSharedInputs hashes the inputs that may be used to translate any of the upstreams
+func hashUpstreamSharedInputs(settings *v1.Settings, snap *v1snap.ApiSnapshot) (uint64, error) {
+	return hashResources(
+		[]interface{}{settings},
+		snap.Secrets.AsInterfaces(),
+		snap.Artifacts.AsInterfaces(),
+	)
+}
+
+func hashUpstreamInputs(upstream *v1.Upstream, endpoints []*v1.Endpoint) (uint64, error) {
+	resources := []interface{}{upstream}
+	for _, ep := range endpoints {
+		resources = append(resources, ep)
+	}
+	return hashResources(resources)
+}
+
+func hashResources(resourceLists ...[]interface{}) (uint64, error) {
+	hasher := fnv.New64()
+	for _, resources := range resourceLists {
+		if _, err := hashutils.HashAllSafe(hasher, resources...); err != nil {
+			return 0, err
+		}
+	}
+	return hasher.Sum64(), nil
+}
+
+func cloneClusters(clusters []*envoy_config_cluster_v3.Cluster) []*envoy_config_cluster_v3.Cluster {
+	cloned := make([]*envoy_config_cluster_v3.Cluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		cloned = append(cloned, proto.Clone(cluster).(*envoy_config_cluster_v3.Cluster))
+	}
+	return cloned
+}
+
+func cloneEndpoints(endpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment) []*envoy_config_endpoint_v3.ClusterLoadAssignment {
+	cloned := make([]*envoy_config_endpoint_v3.ClusterLoadAssignment, 0, len(endpoints))
+	for _, ep := range endpoints {
+		cloned = append(cloned, proto.Clone(ep).(*envoy_config_endpoint_v3.ClusterLoadAssignment))
+	}
+	return cloned
+}
+
+// pruneUnreferencedClusters returns only the clusters referenced by the route configurations and listeners
+// (directly, or through another referenced cluster), along with their endpoints.
+// A cluster is considered referenced if its name appears anywhere in the configuration. If the references
+// cannot be fully determined, for example because a route selects its cluster from a request header,
+// all the clusters are returned.
+func pruneUnreferencedClusters(
+	ctx context.Context,
+	clusters []*envoy_config_cluster_v3.Cluster,
+	endpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment,
+	routeConfigs []*envoy_config_route_v3.RouteConfiguration,
+	listeners []*envoy_config_listener_v3.Listener,
+) ([]*envoy_config_cluster_v3.Cluster, []*envoy_config_endpoint_v3.ClusterLoadAssignment) {
+	refs := &clusterReferences{
+		clusters:   make(map[string]*envoy_config_cluster_v3.Cluster, len(clusters)),
+		referenced: make(map[string]bool),
+	}
+	for _, cluster := range clusters {
+		refs.clusters[cluster.GetName()] = cluster
+	}
+
+	for _, routeConfig := range routeConfigs {
+		refs.collect(routeConfig.ProtoReflect())
+	}
+	for _, listener := range listeners {
+		refs.collect(listener.ProtoReflect())
+	}
+	// clusters may reference other clusters, e.g. aggregate clusters
+	for len(refs.pending) > 0 && !refs.incomplete {
+		cluster := refs.pending[0]
+		refs.pending = refs.pending[1:]
+		refs.collect(cluster.ProtoReflect())
+	}
+
+	if refs.incomplete {
+		contextutils.LoggerFrom(ctx).Debugf("unable to determine the clusters referenced by the proxy, sending all clusters")
+		return clusters, endpoints
+	}
+
+	var referencedClusters []*envoy_config_cluster_v3.Cluster
+	endpointClusterNames := make(map[string]bool)
+	for _, cluster := range clusters {
+		if !refs.referenced[cluster.GetName()] {
+			continue
+		}
+		referencedClusters = append(referencedClusters, cluster)
+		endpointClusterNames[cluster.GetName()] = true
+		if serviceName := cluster.GetEdsClusterConfig().GetServiceName(); serviceName != "" {
+			endpointClusterNames[serviceName] = true
+		}
+	}
+
+	var referencedEndpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment
+	for _, ep := range endpoints {
+		if endpointClusterNames[ep.GetClusterName()] {
+			referencedEndpoints = append(referencedEndpoints, ep)
+		}
+	}
+
+	return referencedClusters, referencedEndpoints
+}
+
+type clusterReferences struct {
+	clusters   map[string]*envoy_config_cluster_v3.Cluster
+	referenced map[string]bool
+	// referenced clusters whose own references have not been collected yet
+	pending []*envoy_config_cluster_v3.Cluster
+	// set if the referenced clusters cannot be determined
+	incomplete bool
+}
+
+func (r *clusterReferences) collect(msg protoreflect.Message) {
+	if r.incomplete {
+		return
+	}
+	if anyMsg, ok := msg.Interface().(*anypb.Any); ok {
+		typedConfig, err := anyMsg.UnmarshalNew()
+		if err != nil {
+			r.incomplete = true
+			return
+		}
+		r.collect(typedConfig.ProtoReflect())
+		return
+	}
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				r.collectValue(fd, list.Get(i))
+			}
+		case fd.IsMap():
+			v.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				if fd.MapKey().Kind() == protoreflect.StringKind {
+					r.add(key.String())
+				}
+				r.collectValue(fd.MapValue(), value)
+				return true
+			})
+		case fd.Name() == "cluster_header":
+			// the cluster is selected at request time
+			r.incomplete = true
+		default:
+			r.collectValue(fd, v)
+		}
+		return !r.incomplete
+	})
+}
+
+func (r *clusterReferences) collectValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		r.collect(v.Message())
+	case protoreflect.StringKind:
+		r.add(v.String())
+	}
+}
+
+func (r *clusterReferences) add(name string) {
+	cluster, ok := r.clusters[name]
+	if !ok || r.referenced[name] {
+		return
+	}
+	r.referenced[name] = true
+	r.pending = append(r.pending, cluster)
+}
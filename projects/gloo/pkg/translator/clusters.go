@@ -6,6 +6,7 @@ import (
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/duration"
@@ -21,37 +22,9 @@ import (
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"github.com/solo-io/solo-kit/pkg/utils/prototime"
-	"go.opencensus.io/trace"
 	_structpb "google.golang.org/protobuf/types/known/structpb"
 )
 
-func (t *translatorInstance) computeClusters(
-	params plugins.Params,
-	reports reporter.ResourceReports,
-	upstreamRefKeyToEndpoints map[string][]*v1.Endpoint,
-	proxy *v1.Proxy,
-) ([]*envoy_config_cluster_v3.Cluster, map[*envoy_config_cluster_v3.Cluster]*v1.Upstream) {
-
-	ctx, span := trace.StartSpan(params.Ctx, "gloo.translator.computeClusters")
-	defer span.End()
-	params.Ctx = contextutils.WithLogger(ctx, "compute_clusters")
-
-	// snapshot contains both real and service-derived upstreams
-	upstreamGroups := params.Snapshot.UpstreamGroups
-	upstreams := params.Snapshot.Upstreams
-	clusters := make([]*envoy_config_cluster_v3.Cluster, 0, len(upstreams))
-	validateUpstreamLambdaFunctions(proxy, upstreams, upstreamGroups, reports)
-
-	clusterToUpstreamMap := make(map[*envoy_config_cluster_v3.Cluster]*v1.Upstream)
-	for _, upstream := range upstreams {
-		cluster := t.computeCluster(params, upstream, upstreamRefKeyToEndpoints, reports)
-		clusterToUpstreamMap[cluster] = upstream
-		clusters = append(clusters, cluster)
-	}
-
-	return clusters, clusterToUpstreamMap
-}
-
 func (t *translatorInstance) computeCluster(
 	params plugins.Params,
 	upstream *v1.Upstream,
@@ -63,7 +36,7 @@ func (t *translatorInstance) computeCluster(
 
 	for _, plugin := range t.pluginRegistry.GetUpstreamPlugins() {
 		if err := plugin.ProcessUpstream(params, upstream, out); err != nil {
-			reports.AddError(upstream, err)
+			reportUpstreamPluginError(reports, upstream, err)
 		}
 	}
 	if err := validateCluster(out); err != nil {
@@ -88,11 +61,14 @@ func (t *translatorInstance) initializeCluster(
 		reports.AddError(upstream, err)
 	}
 
-	circuitBreakers := t.settings.GetGloo().GetCircuitBreakers()
+	circuitBreakers, err := getCircuitBreakers(upstream.GetCircuitBreakers(), t.settings.GetGloo().GetCircuitBreakers())
+	if err != nil {
+		reportUpstreamPluginError(reports, upstream, err)
+	}
 	out := &envoy_config_cluster_v3.Cluster{
 		Name:             UpstreamToClusterName(upstream.GetMetadata().Ref()),
 		Metadata:         new(envoy_config_core_v3.Metadata),
-		CircuitBreakers:  getCircuitBreakers(upstream.GetCircuitBreakers(), circuitBreakers),
+		CircuitBreakers:  circuitBreakers,
 		LbSubsetConfig:   createLbConfig(upstream),
 		HealthChecks:     hcConfig,
 		OutlierDetection: detectCfg,
@@ -104,7 +80,7 @@ func (t *translatorInstance) initializeCluster(
 
 	if sslConfig := upstream.GetSslConfig(); sslConfig != nil {
 		applyDefaultsToUpstreamSslConfig(sslConfig, t.settings.GetUpstreamOptions())
-		cfg, err := utils.NewSslConfigTranslator().ResolveUpstreamSslConfig(*secrets, sslConfig)
+		cfg, err := utils.NewSslConfigTranslatorForSettings(t.settings).ResolveUpstreamSslConfig(*secrets, sslConfig)
 		if err != nil {
 			reports.AddError(upstream, err)
 		} else {
@@ -132,6 +108,10 @@ var (
 	NilFieldError = func(fieldName string) error {
 		return eris.Errorf("The field %s cannot be nil", fieldName)
 	}
+
+	InvalidRetryBudgetPercentError = func(budgetPercent float64) error {
+		return eris.Errorf("circuit breaker retryBudget budgetPercent %v must be between 0 and 100", budgetPercent)
+	}
 )
 
 func createHealthCheckConfig(upstream *v1.Upstream, secrets *v1.SecretList) ([]*envoy_config_core_v3.HealthCheck, error) {
@@ -239,7 +219,8 @@ func validateCluster(c *envoy_config_cluster_v3.Cluster) error {
 }
 
 // Convert the first non nil circuit breaker.
-func getCircuitBreakers(cfgs ...*v1.CircuitBreakerConfig) *envoy_config_cluster_v3.CircuitBreakers {
+// The circuit breaker is returned along with a configuration warning if some of its options will not take effect.
+func getCircuitBreakers(cfgs ...*v1.CircuitBreakerConfig) (*envoy_config_cluster_v3.CircuitBreakers, error) {
 	for _, cfg := range cfgs {
 		if cfg != nil {
 			envoyCfg := &envoy_config_cluster_v3.CircuitBreakers{}
@@ -249,10 +230,27 @@ func getCircuitBreakers(cfgs ...*v1.CircuitBreakerConfig) *envoy_config_cluster_
 				MaxRequests:        cfg.GetMaxRequests(),
 				MaxRetries:         cfg.GetMaxRetries(),
 			}}
-			return envoyCfg
+			retryBudget := cfg.GetRetryBudget()
+			if retryBudget == nil {
+				return envoyCfg, nil
+			}
+			if budgetPercent := retryBudget.GetBudgetPercent(); budgetPercent != nil &&
+				(budgetPercent.GetValue() < 0 || budgetPercent.GetValue() > 100) {
+				return nil, InvalidRetryBudgetPercentError(budgetPercent.GetValue())
+			}
+			envoyCfg.Thresholds[0].RetryBudget = &envoy_config_cluster_v3.CircuitBreakers_Thresholds_RetryBudget{
+				MinRetryConcurrency: retryBudget.GetMinRetryConcurrency(),
+			}
+			if budgetPercent := retryBudget.GetBudgetPercent(); budgetPercent != nil {
+				envoyCfg.Thresholds[0].RetryBudget.BudgetPercent = &envoy_type_v3.Percent{Value: budgetPercent.GetValue()}
+			}
+			if cfg.GetMaxRetries() != nil {
+				return envoyCfg, plugins.NewConfigurationWarning("circuit breaker maxRetries is ignored, as a retryBudget is set")
+			}
+			return envoyCfg, nil
 		}
 	}
-	return nil
+	return nil, nil
 }
 
 func getHttp2options(us *v1.Upstream) *envoy_config_core_v3.Http2ProtocolOptions {
@@ -1,12 +1,13 @@
 package translator
 
 import (
+	"sort"
+
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
-	"go.opencensus.io/trace"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 )
@@ -18,28 +19,26 @@ const SoloAnnotations = "io.solo.annotations"
 
 func (t *translatorInstance) computeClusterEndpoints(
 	params plugins.Params,
-	upstreamRefKeyToEndpoints map[string][]*v1.Endpoint,
+	upstream *v1.Upstream,
+	clusterEndpoints []*v1.Endpoint,
 	reports reporter.ResourceReports,
-) []*envoy_config_endpoint_v3.ClusterLoadAssignment {
-
-	_, span := trace.StartSpan(params.Ctx, "gloo.translator.computeClusterEndpoints")
-	defer span.End()
-
-	var clusterEndpointAssignments []*envoy_config_endpoint_v3.ClusterLoadAssignment
-	for _, upstream := range params.Snapshot.Upstreams {
-		clusterEndpoints := upstreamRefKeyToEndpoints[upstream.GetMetadata().Ref().Key()]
-		// if there are any endpoints for this upstream, it's using eds and we need to create a load assignment for it
-		if len(clusterEndpoints) > 0 {
-			loadAssignment := loadAssignmentForUpstream(upstream, clusterEndpoints)
-			for _, plugin := range t.pluginRegistry.GetEndpointPlugins() {
-				if err := plugin.ProcessEndpoints(params, upstream, loadAssignment); err != nil {
-					reports.AddError(upstream, err)
-				}
-			}
-			clusterEndpointAssignments = append(clusterEndpointAssignments, loadAssignment)
+) *envoy_config_endpoint_v3.ClusterLoadAssignment {
+	loadAssignment := loadAssignmentForUpstream(upstream, clusterEndpoints)
+	for _, plugin := range t.pluginRegistry.GetEndpointPlugins() {
+		if err := plugin.ProcessEndpoints(params, upstream, loadAssignment); err != nil {
+			reportUpstreamPluginError(reports, upstream, err)
 		}
 	}
-	return clusterEndpointAssignments
+	return loadAssignment
+}
+
+// the configuration warnings of plugins are reported as warnings on the upstream rather than errors
+func reportUpstreamPluginError(reports reporter.ResourceReports, upstream *v1.Upstream, err error) {
+	if plugins.IsConfigurationWarning(err) {
+		reports.AddWarning(upstream, err.Error())
+		return
+	}
+	reports.AddError(upstream, err)
 }
 
 func loadAssignmentForUpstream(
@@ -47,7 +46,9 @@ func loadAssignmentForUpstream(
 	clusterEndpoints []*v1.Endpoint,
 ) *envoy_config_endpoint_v3.ClusterLoadAssignment {
 	clusterName := UpstreamToClusterName(upstream.GetMetadata().Ref())
-	var endpoints []*envoy_config_endpoint_v3.LbEndpoint
+	// endpoints are grouped by locality, the endpoints without a locality being in the first group
+	var localities []localityKey
+	endpointsByLocality := map[localityKey][]*envoy_config_endpoint_v3.LbEndpoint{}
 	for _, addr := range clusterEndpoints {
 		metadata := getLbMetadata(upstream, addr.GetMetadata().GetLabels(), "")
 		metadata = addAnnotations(metadata, addr.GetMetadata().GetAnnotations())
@@ -58,7 +59,8 @@ func loadAssignmentForUpstream(
 			}
 		}
 		lbEndpoint := envoy_config_endpoint_v3.LbEndpoint{
-			Metadata: metadata,
+			Metadata:     metadata,
+			HealthStatus: envoyHealthStatus(addr.GetHealthStatus()),
 			HostIdentifier: &envoy_config_endpoint_v3.LbEndpoint_Endpoint{
 				Endpoint: &envoy_config_endpoint_v3.Endpoint{
 					Address: &envoy_config_core_v3.Address{
@@ -77,14 +79,77 @@ func loadAssignmentForUpstream(
 				},
 			},
 		}
-		endpoints = append(endpoints, &lbEndpoint)
+		key := localityKey{
+			region:  addr.GetLocality().GetRegion(),
+			zone:    addr.GetLocality().GetZone(),
+			subZone: addr.GetLocality().GetSubZone(),
+		}
+		if _, ok := endpointsByLocality[key]; !ok {
+			localities = append(localities, key)
+		}
+		endpointsByLocality[key] = append(endpointsByLocality[key], &lbEndpoint)
+	}
+
+	if len(localities) == 0 {
+		return &envoy_config_endpoint_v3.ClusterLoadAssignment{
+			ClusterName: clusterName,
+			Endpoints:   []*envoy_config_endpoint_v3.LocalityLbEndpoints{{}},
+		}
+	}
+
+	sort.SliceStable(localities, func(i, j int) bool {
+		return localities[i].less(localities[j])
+	})
+	var localityEndpoints []*envoy_config_endpoint_v3.LocalityLbEndpoints
+	for _, key := range localities {
+		localityEndpoints = append(localityEndpoints, &envoy_config_endpoint_v3.LocalityLbEndpoints{
+			Locality:    key.toEnvoy(),
+			LbEndpoints: endpointsByLocality[key],
+		})
 	}
 
 	return &envoy_config_endpoint_v3.ClusterLoadAssignment{
 		ClusterName: clusterName,
-		Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{
-			LbEndpoints: endpoints,
-		}},
+		Endpoints:   localityEndpoints,
+	}
+}
+
+// the endpoints whose health is not known are healthy for envoy
+func envoyHealthStatus(status v1.Endpoint_HealthStatus) envoy_config_core_v3.HealthStatus {
+	switch status {
+	case v1.Endpoint_HEALTHY:
+		return envoy_config_core_v3.HealthStatus_HEALTHY
+	case v1.Endpoint_UNHEALTHY:
+		return envoy_config_core_v3.HealthStatus_UNHEALTHY
+	case v1.Endpoint_DRAINING:
+		return envoy_config_core_v3.HealthStatus_DRAINING
+	}
+	return envoy_config_core_v3.HealthStatus_UNKNOWN
+}
+
+type localityKey struct {
+	region, zone, subZone string
+}
+
+func (k localityKey) less(other localityKey) bool {
+	if k.region != other.region {
+		return k.region < other.region
+	}
+	if k.zone != other.zone {
+		return k.zone < other.zone
+	}
+	return k.subZone < other.subZone
+}
+
+// the endpoints without a locality have no envoy locality
+func (k localityKey) toEnvoy() *envoy_config_core_v3.Locality {
+	if k == (localityKey{}) {
+		return nil
+	}
+	return &envoy_config_core_v3.Locality{
+		Region:  k.region,
+		Zone:    k.zone,
+		SubZone: k.subZone,
 	}
 }
 
@@ -0,0 +1,323 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+
+	envoy_config_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/rotisserie/eris"
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/envoyvalidation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	proto2 "google.golang.org/protobuf/proto"
+)
+
+// The results of validating the bootstraps built from the translation of a proxy with envoy, keyed by the hash of
+// the validated bootstrap, so that envoy is only run when the resources translated for the proxy change.
+type envoyValidationCache struct {
+	lock sync.Mutex
+	// the results of the latest translation of each proxy, keyed by proxy ref
+	proxies map[string]map[uint64]error
+}
+
+func newEnvoyValidationCache() *envoyValidationCache {
+	return &envoyValidationCache{
+		proxies: make(map[string]map[uint64]error),
+	}
+}
+
+func (c *envoyValidationCache) get(proxy *v1.Proxy) map[uint64]error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.proxies[proxy.GetMetadata().Ref().Key()]
+}
+
+// set stores the validation results of the latest translation of the proxy, and drops those of the proxies no longer
+// in the snapshot
+func (c *envoyValidationCache) set(snap *v1snap.ApiSnapshot, proxy *v1.Proxy, results map[uint64]error) {
+	key := proxy.GetMetadata().Ref().Key()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.proxies[key] = results
+	if len(c.proxies) <= len(snap.Proxies) {
+		return
+	}
+	current := map[string]bool{key: true}
+	for _, p := range snap.Proxies {
+		current[p.GetMetadata().Ref().Key()] = true
+	}
+	for k := range c.proxies {
+		if !current[k] {
+			delete(c.proxies, k)
+		}
+	}
+}
+
+// envoyValidator validates the bootstraps built during the translation of a proxy, reusing the results of the
+// previous translation of the proxy.
+// Only the verdicts of envoy are kept, the errors running envoy are retried.
+type envoyValidator struct {
+	ctx      context.Context
+	previous map[uint64]error
+	results  map[uint64]error
+}
+
+func (v *envoyValidator) validate(bs *envoy_config_bootstrap_v3.Bootstrap) error {
+	hash, err := hashBootstrap(bs)
+	if err != nil {
+		return err
+	}
+	if err, ok := v.results[hash]; ok {
+		return err
+	}
+	err, ok := v.previous[hash]
+	if !ok {
+		err = envoyvalidation.ValidateBootstrap(v.ctx, bs)
+	}
+	if err == nil || envoyvalidation.IsRejected(err) {
+		v.results[hash] = err
+	}
+	return err
+}
+
+func hashBootstrap(bs *envoy_config_bootstrap_v3.Bootstrap) (uint64, error) {
+	out, err := proto2.MarshalOptions{Deterministic: true}.Marshal(proto.MessageV2(bs))
+	if err != nil {
+		return 0, err
+	}
+	hasher := fnv.New64()
+	if _, err := hasher.Write(out); err != nil {
+		return 0, err
+	}
+	return hasher.Sum64(), nil
+}
+
+// validateWithEnvoy validates the resources translated for the proxy with a single run of envoy, when full envoy
+// validation is enabled in the settings.
+// If envoy rejects them, each virtual host, then each route of the rejected virtual hosts, is validated on its own to
+// report the error on the routes or virtual hosts envoy rejects. If envoy accepts every virtual host, each cluster,
+// then each listener, is validated on its own to report the error on the upstreams or listeners envoy rejects.
+func (t *translatorInstance) validateWithEnvoy(
+	params plugins.Params,
+	proxy *v1.Proxy,
+	proxyReport *validationapi.ProxyReport,
+	reports reporter.ResourceReports,
+	clusters []*envoy_config_cluster_v3.Cluster,
+	listeners []*envoy_config_listener_v3.Listener,
+	routeConfigs []*envoy_config_route_v3.RouteConfiguration,
+	secrets []*envoyauth.Secret,
+) {
+	if !t.settings.GetGateway().GetValidation().GetFullEnvoyValidation().GetValue() {
+		return
+	}
+
+	validator := &envoyValidator{
+		ctx:      params.Ctx,
+		previous: t.envoyValidationCache.get(proxy),
+		results:  map[uint64]error{},
+	}
+	defer t.envoyValidationCache.set(params.Snapshot, proxy, validator.results)
+
+	bs, err := envoyvalidation.BuildStaticBootstrap(clusters, listeners, routeConfigs, secrets)
+	if err == nil {
+		err = validator.validate(bs)
+	}
+	if err == nil {
+		return
+	}
+	if !envoyvalidation.IsRejected(err) {
+		// envoy could not tell whether it accepts the resources, which are validated again on the next translation
+		for _, listenerReport := range proxyReport.GetListenerReports() {
+			validation.AppendListenerError(listenerReport, validationapi.ListenerReport_Error_ProcessingError,
+				fmt.Sprintf("failed to validate the envoy configuration: %v", err))
+		}
+		return
+	}
+
+	routeConfigsByName := make(map[string]*envoy_config_route_v3.RouteConfiguration, len(routeConfigs))
+	for _, routeConfig := range routeConfigs {
+		routeConfigsByName[routeConfig.GetName()] = routeConfig
+	}
+	reported := false
+	for i, listener := range proxy.GetListeners() {
+		listenerReport := proxyReport.GetListenerReports()[i]
+		switch listenerType := listener.GetListenerType().(type) {
+		case *v1.Listener_HttpListener:
+			// the routes are split in a RouteConfiguration per filter chain if some of them match on SNI
+			listenerRouteConfigs := []*envoy_config_route_v3.RouteConfiguration{routeConfigsByName[utils.RouteConfigName(listener)]}
+			for j := 0; routeConfigsByName[utils.SniRouteConfigName(listener, j)] != nil; j++ {
+				listenerRouteConfigs = append(listenerRouteConfigs, routeConfigsByName[utils.SniRouteConfigName(listener, j)])
+			}
+			for _, routeConfig := range listenerRouteConfigs {
+				// each RouteConfiguration has the same virtual hosts, so the errors of the first rejected one are reported
+				if validator.reportVirtualHosts(routeConfig, listenerReport.GetHttpListenerReport().GetVirtualHostReports()) {
+					reported = true
+					break
+				}
+			}
+		case *v1.Listener_HybridListener:
+			matchedListenerReports := listenerReport.GetHybridListenerReport().GetMatchedListenerReports()
+			for _, matchedListener := range listenerType.HybridListener.GetMatchedListeners() {
+				routeConfigName := utils.MatchedRouteConfigName(listener, matchedListener.GetMatcher())
+				if validator.reportVirtualHosts(
+					routeConfigsByName[routeConfigName],
+					matchedListenerReports[routeConfigName].GetHttpListenerReport().GetVirtualHostReports(),
+				) {
+					reported = true
+				}
+			}
+		}
+	}
+	if reported {
+		return
+	}
+	if validator.reportClusters(params.Snapshot.Upstreams, clusters, secrets, reports) {
+		return
+	}
+	validator.reportListeners(proxy, proxyReport, clusters, listeners, routeConfigs, secrets, err)
+}
+
+// reportClusters validates each cluster on its own, and reports the errors on the upstreams of the clusters envoy
+// rejects.
+// It returns whether an error was reported.
+func (v *envoyValidator) reportClusters(
+	upstreams v1.UpstreamList,
+	clusters []*envoy_config_cluster_v3.Cluster,
+	secrets []*envoyauth.Secret,
+	reports reporter.ResourceReports,
+) bool {
+	upstreamsByCluster := make(map[string]*v1.Upstream, len(upstreams))
+	for _, upstream := range upstreams {
+		upstreamsByCluster[UpstreamToClusterName(upstream.GetMetadata().Ref())] = upstream
+	}
+	reported := false
+	for _, cluster := range clusters {
+		upstream, ok := upstreamsByCluster[cluster.GetName()]
+		if !ok {
+			// the clusters generated by plugins are not translated from an upstream
+			continue
+		}
+		bs, err := envoyvalidation.BuildStaticBootstrap([]*envoy_config_cluster_v3.Cluster{cluster}, nil, nil, secrets)
+		if err == nil {
+			err = v.validate(bs)
+		}
+		if envoyvalidation.IsRejected(err) {
+			reports.AddError(upstream, eris.Wrap(err, "invalid envoy configuration"))
+			reported = true
+		}
+	}
+	return reported
+}
+
+// reportListeners validates each listener on its own, and reports the errors on the listeners envoy rejects.
+// If envoy accepts each listener on its own, the error is reported on every listener of the proxy.
+func (v *envoyValidator) reportListeners(
+	proxy *v1.Proxy,
+	proxyReport *validationapi.ProxyReport,
+	clusters []*envoy_config_cluster_v3.Cluster,
+	listeners []*envoy_config_listener_v3.Listener,
+	routeConfigs []*envoy_config_route_v3.RouteConfiguration,
+	secrets []*envoyauth.Secret,
+	err error,
+) {
+	// envoy listeners are named after the listener of the proxy they are translated from
+	listenerReportsByName := make(map[string]*validationapi.ListenerReport, len(proxy.GetListeners()))
+	for i, listener := range proxy.GetListeners() {
+		listenerReportsByName[listener.GetName()] = proxyReport.GetListenerReports()[i]
+	}
+	reported := false
+	for _, listener := range listeners {
+		listenerReport, ok := listenerReportsByName[listener.GetName()]
+		if !ok {
+			continue
+		}
+		bs, listenerErr := envoyvalidation.BuildStaticBootstrap(clusters, []*envoy_config_listener_v3.Listener{listener}, routeConfigs, secrets)
+		if listenerErr == nil {
+			listenerErr = v.validate(bs)
+		}
+		if envoyvalidation.IsRejected(listenerErr) {
+			validation.AppendListenerError(listenerReport, validationapi.ListenerReport_Error_ProcessingError,
+				fmt.Sprintf("invalid envoy configuration: %v", listenerErr))
+			reported = true
+		}
+	}
+	if reported {
+		return
+	}
+	for _, listenerReport := range proxyReport.GetListenerReports() {
+		validation.AppendListenerError(listenerReport, validationapi.ListenerReport_Error_ProcessingError,
+			fmt.Sprintf("invalid envoy configuration: %v", err))
+	}
+}
+
+// reportVirtualHosts validates each virtual host of the route configuration on its own, and reports the errors on
+// the routes or virtual hosts envoy rejects.
+// The virtual hosts of the route configuration are translated in order from the virtual hosts of the reports.
+// It returns whether an error was reported.
+func (v *envoyValidator) reportVirtualHosts(
+	routeConfig *envoy_config_route_v3.RouteConfiguration,
+	vhostReports []*validationapi.VirtualHostReport,
+) bool {
+	reported := false
+	for i, vhost := range routeConfig.GetVirtualHosts() {
+		if i >= len(vhostReports) {
+			break
+		}
+		err := v.validateVirtualHost(vhost)
+		if !envoyvalidation.IsRejected(err) {
+			continue
+		}
+		reported = true
+		if !v.reportRoutes(vhost, vhostReports[i]) {
+			validation.AppendVirtualHostError(vhostReports[i], validationapi.VirtualHostReport_Error_ProcessingError,
+				fmt.Sprintf("invalid envoy configuration: %v", err))
+		}
+	}
+	return reported
+}
+
+// reportRoutes validates each route of the virtual host on its own, and reports the errors on the routes envoy
+// rejects. Envoy routes are mapped back to the route they are translated from through their name.
+// It returns whether an error was reported.
+func (v *envoyValidator) reportRoutes(vhost *envoy_config_route_v3.VirtualHost, vhostReport *validationapi.VirtualHostReport) bool {
+	reported := false
+	for i, routeReport := range vhostReport.GetRouteReports() {
+		generatedName := fmt.Sprintf("%s-route-%d", vhost.GetName(), i)
+		for _, route := range vhost.GetRoutes() {
+			if !strings.HasPrefix(route.GetName(), generatedName+"-") {
+				continue
+			}
+			singleRouteVhost := proto.Clone(vhost).(*envoy_config_route_v3.VirtualHost)
+			singleRouteVhost.Routes = []*envoy_config_route_v3.Route{route}
+			if err := v.validateVirtualHost(singleRouteVhost); envoyvalidation.IsRejected(err) {
+				validation.AppendRouteError(routeReport, validationapi.RouteReport_Error_ProcessingError,
+					fmt.Sprintf("invalid envoy configuration: %v", err), generatedName)
+				reported = true
+				break
+			}
+		}
+	}
+	return reported
+}
+
+func (v *envoyValidator) validateVirtualHost(vhost *envoy_config_route_v3.VirtualHost) error {
+	bs, err := envoyvalidation.BuildVirtualHostBootstrap([]*envoy_config_route_v3.VirtualHost{vhost})
+	if err != nil {
+		return err
+	}
+	return v.validate(bs)
+}
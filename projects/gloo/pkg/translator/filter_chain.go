@@ -6,6 +6,7 @@ import (
 	"github.com/rotisserie/eris"
 
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes/duration"
@@ -65,6 +66,9 @@ type sslDuplicatedFilterChainTranslator struct {
 	networkFilterTranslator NetworkFilterTranslator
 	sslConfigurations       []*v1.SslConfig
 	sslConfigTranslator     utils.SslConfigTranslator
+	// The names of the RouteConfigurations of the filter chains of each SslConfig,
+	// if they don't share the one of the NetworkFilters
+	routeConfigNames []string
 }
 
 func (s *sslDuplicatedFilterChainTranslator) ComputeFilterChains(params plugins.Params) []*envoy_config_listener_v3.FilterChain {
@@ -93,7 +97,7 @@ func (s *sslDuplicatedFilterChainTranslator) computeFilterChainsFromSslConfig(
 
 	var secureFilterChains []*envoy_config_listener_v3.FilterChain
 
-	for _, sslConfig := range s.sslConfigurations {
+	for i, sslConfig := range s.sslConfigurations {
 		// get secrets
 		downstreamTlsContext, err := s.sslConfigTranslator.ResolveDownstreamSslConfig(snap.Secrets, sslConfig)
 		if err != nil {
@@ -107,6 +111,13 @@ func (s *sslDuplicatedFilterChainTranslator) computeFilterChainsFromSslConfig(
 			sslConfig.GetSniDomains(),
 			listenerFilters,
 			sslConfig.GetTransportSocketConnectTimeout())
+		if len(s.routeConfigNames) > 0 {
+			if err := setRouteConfigName(filterChain.GetFilters(), s.routeConfigNames[i]); err != nil {
+				validation.AppendListenerError(s.parentReport,
+					validationapi.ListenerReport_Error_ProcessingError, err.Error())
+				continue
+			}
+		}
 		secureFilterChains = append(secureFilterChains, filterChain)
 	}
 	return secureFilterChains
@@ -144,6 +155,29 @@ func cloneListenerFilters(originalListenerFilters []*envoy_config_listener_v3.Fi
 	return clonedListenerFilters
 }
 
+// points the HttpConnectionManager NetworkFilter to the RouteConfiguration with the given name
+func setRouteConfigName(listenerFilters []*envoy_config_listener_v3.Filter, routeConfigName string) error {
+	for _, listenerFilter := range listenerFilters {
+		if listenerFilter.GetName() != wellknown.HTTPConnectionManager {
+			continue
+		}
+		var hcm envoyhttp.HttpConnectionManager
+		if err := ParseTypedConfig(listenerFilter, &hcm); err != nil {
+			return err
+		}
+		if hcm.GetRds() == nil {
+			continue
+		}
+		hcm.GetRds().RouteConfigName = routeConfigName
+		typedConfig, err := utils.MessageToAny(&hcm)
+		if err != nil {
+			return err
+		}
+		listenerFilter.ConfigType = &envoy_config_listener_v3.Filter_TypedConfig{TypedConfig: typedConfig}
+	}
+	return nil
+}
+
 func mergeSslConfigs(sslConfigs []*v1.SslConfig) []*v1.SslConfig {
 	// we can merge ssl config if they look the same except for SNI domains.
 	// combine SNI information.
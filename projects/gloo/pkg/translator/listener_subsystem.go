@@ -77,6 +77,23 @@ func (l *ListenerSubsystemTranslatorFactory) GetHttpListenerTranslators(ctx cont
 		l.pluginRegistry.GetHttpConnectionManagerPlugins(),
 		routeConfigurationName)
 
+	sslConfigurations := mergeSslConfigs(listener.GetSslConfigurations())
+
+	// Envoy cannot match routes on the SNI, so if some routes match on it,
+	// the FilterChain of each SslConfiguration gets its own RouteConfiguration,
+	// with only the routes that match the SNI domains of the FilterChain
+	var sniRouteConfigs []sniRouteConfig
+	var sniRouteConfigNames []string
+	if len(sslConfigurations) > 0 && hasSniMatchers(listener.GetHttpListener()) {
+		for i, sslConfig := range sslConfigurations {
+			sniRouteConfigs = append(sniRouteConfigs, sniRouteConfig{
+				name:       utils.SniRouteConfigName(listener, i),
+				sniDomains: sslConfig.GetSniDomains(),
+			})
+			sniRouteConfigNames = append(sniRouteConfigNames, utils.SniRouteConfigName(listener, i))
+		}
+	}
+
 	// This translator produces FilterChains
 	// For an HttpGateway we first build a set of NetworkFilters.
 	// Then, for each SslConfiguration that was found on that HttpGateway,
@@ -85,7 +102,8 @@ func (l *ListenerSubsystemTranslatorFactory) GetHttpListenerTranslators(ctx cont
 		parentReport:            listenerReport,
 		networkFilterTranslator: networkFilterTranslator,
 		sslConfigTranslator:     l.sslConfigTranslator,
-		sslConfigurations:       mergeSslConfigs(listener.GetSslConfigurations()),
+		sslConfigurations:       sslConfigurations,
+		routeConfigNames:        sniRouteConfigNames,
 	}
 
 	// This translator produces a single Listener
@@ -96,12 +114,12 @@ func (l *ListenerSubsystemTranslatorFactory) GetHttpListenerTranslators(ctx cont
 		filterChainTranslator: filterChainTranslator,
 	}
 
-	// This translator produces a single RouteConfiguration
+	// This translator produces a single RouteConfiguration, or one per FilterChain if routes match on SNI
 	// We produce the same number of RouteConfigurations as we do
 	// unique instances of the HttpConnectionManager NetworkFilter
 	// Since an HttpGateway can only be configured with a single set
 	// of configuration for the HttpConnectionManager, we only produce
-	// a single RouteConfiguration
+	// a single RouteConfiguration otherwise
 	routeConfigurationTranslator := &httpRouteConfigurationTranslator{
 		pluginRegistry:           l.pluginRegistry,
 		proxy:                    proxy,
@@ -111,11 +129,26 @@ func (l *ListenerSubsystemTranslatorFactory) GetHttpListenerTranslators(ctx cont
 		report:                   httpListenerReport,
 		routeConfigName:          routeConfigurationName,
 		requireTlsOnVirtualHosts: len(listener.GetSslConfigurations()) > 0,
+		sniRouteConfigs:          sniRouteConfigs,
 	}
 
 	return listenerTranslator, routeConfigurationTranslator
 }
 
+// returns true if a route of the listener matches on SNI
+func hasSniMatchers(listener *v1.HttpListener) bool {
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		for _, route := range virtualHost.GetRoutes() {
+			for _, matcher := range route.GetMatchers() {
+				if len(matcher.GetTls().GetSniDomains()) > 0 {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 func (l *ListenerSubsystemTranslatorFactory) GetTcpListenerTranslators(ctx context.Context, listener *v1.Listener, listenerReport *validationapi.ListenerReport) (
 	ListenerTranslator,
 	RouteConfigurationTranslator,
@@ -195,6 +228,10 @@ func (l *ListenerSubsystemTranslatorFactory) GetHybridListenerTranslators(ctx co
 			report:                   listenerReport.GetHybridListenerReport().GetMatchedListenerReports()[utils.MatchedRouteConfigName(listener, matcher)].GetHttpListenerReport(),
 			routeConfigName:          utils.MatchedRouteConfigName(listener, matcher),
 			requireTlsOnVirtualHosts: matcher.GetSslConfig() != nil,
+			sniRouteConfigs: []sniRouteConfig{{
+				name:       utils.MatchedRouteConfigName(listener, matcher),
+				sniDomains: matcher.GetSslConfig().GetSniDomains(),
+			}},
 		}
 		httpTranslators = append(httpTranslators, httpTranslator)
 	}
@@ -0,0 +1,97 @@
+package translator
+
+import (
+	"sync"
+
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/proto"
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+)
+
+// The route configurations and listeners translated from a proxy only depend on the proxy and on
+// the snapshot resources its routes may reference (notably not on the endpoints), so they are reused
+// by the following translations of the proxy until either changes.
+type listenerSubsystemCache struct {
+	lock sync.Mutex
+	// the latest listener subsystem translated for each proxy, keyed by proxy ref
+	proxies map[string]*listenerSubsystem
+}
+
+type listenerSubsystem struct {
+	// hash of the proxy and the snapshot inputs the listener subsystem was translated from
+	hash         uint64
+	routeConfigs []*envoy_config_route_v3.RouteConfiguration
+	listeners    []*envoy_config_listener_v3.Listener
+	proxyReport  *validationapi.ProxyReport
+}
+
+func newListenerSubsystemCache() *listenerSubsystemCache {
+	return &listenerSubsystemCache{
+		proxies: make(map[string]*listenerSubsystem),
+	}
+}
+
+// get returns the latest listener subsystem translated for the proxy, if it was translated from the same inputs
+func (c *listenerSubsystemCache) get(proxy *v1.Proxy, hash uint64) *listenerSubsystem {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	latest, ok := c.proxies[proxy.GetMetadata().Ref().Key()]
+	if !ok || latest.hash != hash {
+		return nil
+	}
+	return latest
+}
+
+// set stores the listener subsystem translated for the proxy, and drops those of the proxies no longer in the snapshot
+func (c *listenerSubsystemCache) set(snap *v1snap.ApiSnapshot, proxy *v1.Proxy, listenerSubsystem *listenerSubsystem) {
+	key := proxy.GetMetadata().Ref().Key()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.proxies[key] = listenerSubsystem
+	if len(c.proxies) <= len(snap.Proxies) {
+		return
+	}
+	current := map[string]bool{key: true}
+	for _, p := range snap.Proxies {
+		current[p.GetMetadata().Ref().Key()] = true
+	}
+	for k := range c.proxies {
+		if !current[k] {
+			delete(c.proxies, k)
+		}
+	}
+}
+
+// copy returns a copy of the listener subsystem that can be safely modified by a single proxy translation
+func (l *listenerSubsystem) copy() (
+	[]*envoy_config_route_v3.RouteConfiguration,
+	[]*envoy_config_listener_v3.Listener,
+	*validationapi.ProxyReport,
+) {
+	routeConfigs := make([]*envoy_config_route_v3.RouteConfiguration, 0, len(l.routeConfigs))
+	for _, routeConfig := range l.routeConfigs {
+		routeConfigs = append(routeConfigs, proto.Clone(routeConfig).(*envoy_config_route_v3.RouteConfiguration))
+	}
+	listeners := make([]*envoy_config_listener_v3.Listener, 0, len(l.listeners))
+	for _, listener := range l.listeners {
+		listeners = append(listeners, proto.Clone(listener).(*envoy_config_listener_v3.Listener))
+	}
+	return routeConfigs, listeners, proto.Clone(l.proxyReport).(*validationapi.ProxyReport)
+}
+
+func hashListenerSubsystemInputs(settings *v1.Settings, proxy *v1.Proxy, snap *v1snap.ApiSnapshot) (uint64, error) {
+	return hashResources(
+		[]interface{}{settings, proxy},
+		snap.Upstreams.AsInterfaces(),
+		snap.UpstreamGroups.AsInterfaces(),
+		snap.Secrets.AsInterfaces(),
+		snap.Artifacts.AsInterfaces(),
+		snap.AuthConfigs.AsInterfaces(),
+		snap.Ratelimitconfigs.AsInterfaces(),
+		snap.GraphqlSchemas.AsInterfaces(),
+	)
+}
@@ -20,18 +20,22 @@ import (
 	v1plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/headers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/iptagging"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	usconversion "github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 var (
 	NoDestinationSpecifiedError = errors.New("must specify at least one weighted destination for multi destination routes")
 
 	SubsetsMisconfiguredErr = errors.New("route has a subset config, but the upstream does not")
+
+	MissingGrpcServiceErr = errors.New("grpc matcher must specify a service")
 )
 
 type RouteConfigurationTranslator interface {
@@ -60,16 +64,71 @@ type httpRouteConfigurationTranslator struct {
 	report                   *validationapi.HttpListenerReport
 	routeConfigName          string
 	requireTlsOnVirtualHosts bool
+	// The RouteConfigurations to produce for the filter chains of the listener, if they don't all share a single
+	// one named routeConfigName that serves any SNI
+	sniRouteConfigs []sniRouteConfig
+	// The SNI domains that the envoy routes translated from a matcher on SNI require
+	routeSniDomains map[*envoy_config_route_v3.Route][]string
+}
+
+// As Envoy cannot match routes on the SNI, the routes that match on it are only added to the RouteConfigurations
+// of the filter chains whose SNI domains they all list
+type sniRouteConfig struct {
+	name       string
+	sniDomains []string
 }
 
 func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(params plugins.Params) []*envoy_config_route_v3.RouteConfiguration {
 	params.Ctx = contextutils.WithLogger(params.Ctx, "compute_route_config."+h.routeConfigName)
 
-	return []*envoy_config_route_v3.RouteConfiguration{{
-		Name:                           h.routeConfigName,
-		VirtualHosts:                   h.computeVirtualHosts(params),
-		MaxDirectResponseBodySizeBytes: h.parentListener.GetRouteOptions().GetMaxDirectResponseBodySizeBytes(),
-	}}
+	h.routeSniDomains = map[*envoy_config_route_v3.Route][]string{}
+	virtualHosts := h.computeVirtualHosts(params)
+
+	sniRouteConfigs := h.sniRouteConfigs
+	if len(sniRouteConfigs) == 0 {
+		sniRouteConfigs = []sniRouteConfig{{name: h.routeConfigName}}
+	}
+	routeConfigs := make([]*envoy_config_route_v3.RouteConfiguration, 0, len(sniRouteConfigs))
+	for _, routeConfig := range sniRouteConfigs {
+		routeConfigs = append(routeConfigs, &envoy_config_route_v3.RouteConfiguration{
+			Name:                           routeConfig.name,
+			VirtualHosts:                   h.scopeVirtualHostsToSni(virtualHosts, routeConfig.sniDomains),
+			MaxDirectResponseBodySizeBytes: h.parentListener.GetRouteOptions().GetMaxDirectResponseBodySizeBytes(),
+		})
+	}
+	return routeConfigs
+}
+
+// returns copies of the virtual hosts without the routes that match on SNI domains that don't include all of the
+// given ones, which are those of the filter chains of the RouteConfiguration
+func (h *httpRouteConfigurationTranslator) scopeVirtualHostsToSni(
+	virtualHosts []*envoy_config_route_v3.VirtualHost,
+	sniDomains []string,
+) []*envoy_config_route_v3.VirtualHost {
+	if len(h.routeSniDomains) == 0 {
+		return virtualHosts
+	}
+
+	out := make([]*envoy_config_route_v3.VirtualHost, 0, len(virtualHosts))
+	for _, virtualHost := range virtualHosts {
+		scoped := proto.Clone(virtualHost).(*envoy_config_route_v3.VirtualHost)
+		clonedRoutes := scoped.GetRoutes()
+		scoped.Routes = nil
+		for i, route := range virtualHost.GetRoutes() {
+			if routeSniDomains, ok := h.routeSniDomains[route]; ok && !sniDomainsWithin(sniDomains, routeSniDomains) {
+				continue
+			}
+			scoped.Routes = append(scoped.GetRoutes(), clonedRoutes[i])
+		}
+		out = append(out, scoped)
+	}
+	return out
+}
+
+// returns true if a filter chain matching the given SNI domains only accepts connections whose SNI is one of the
+// allowed domains. A filter chain without SNI domains accepts any SNI.
+func sniDomainsWithin(sniDomains, allowed []string) bool {
+	return len(sniDomains) > 0 && sets.NewString(allowed...).HasAll(sniDomains...)
 }
 
 func (h *httpRouteConfigurationTranslator) computeVirtualHosts(params plugins.Params) []*envoy_config_route_v3.VirtualHost {
@@ -131,6 +190,11 @@ func (h *httpRouteConfigurationTranslator) computeVirtualHost(
 	// run the plugins
 	for _, plugin := range h.pluginRegistry.GetVirtualHostPlugins() {
 		if err := plugin.ProcessVirtualHost(params, virtualHost, out); err != nil {
+			// virtual host reports have no warnings, so configuration warnings are only logged
+			if plugins.IsConfigurationWarning(err) {
+				contextutils.LoggerFrom(params.Ctx).Warnf("virtual host [%s]: %v", virtualHost.GetName(), err.Error())
+				continue
+			}
 			validation.AppendVirtualHostError(
 				vhostReport,
 				validationapi.VirtualHostReport_Error_ProcessingError,
@@ -148,7 +212,7 @@ func (h *httpRouteConfigurationTranslator) envoyRoutes(
 	generatedName string,
 ) []*envoy_config_route_v3.Route {
 
-	out := initRoutes(params, in, routeReport, generatedName)
+	out := initRoutes(params, in, routeReport, generatedName, h.requireTlsOnVirtualHosts, h.routeSniDomains)
 
 	for i := range out {
 		h.setAction(params, routeReport, in, out[i])
@@ -158,11 +222,15 @@ func (h *httpRouteConfigurationTranslator) envoyRoutes(
 }
 
 // creates Envoy routes for each matcher provided on our Gateway route
+// secure tells whether the listener terminates TLS, which is the case for either all of its requests or none of them
+// the SNI domains of the routes created for matchers on SNI are added to routeSniDomains
 func initRoutes(
 	params plugins.RouteParams,
 	in *v1.Route,
 	routeReport *validationapi.RouteReport,
 	generatedName string,
+	secure bool,
+	routeSniDomains map[*envoy_config_route_v3.Route][]string,
 ) []*envoy_config_route_v3.Route {
 
 	if len(in.GetMatchers()) == 0 {
@@ -175,7 +243,7 @@ func initRoutes(
 		}
 	}
 
-	out := make([]*envoy_config_route_v3.Route, len(in.GetMatchers()))
+	out := make([]*envoy_config_route_v3.Route, 0, len(in.GetMatchers()))
 	for i, matcher := range in.GetMatchers() {
 		if matcher.GetPathSpecifier() == nil {
 			validation.AppendRouteError(routeReport,
@@ -184,15 +252,30 @@ func initRoutes(
 				generatedName,
 			)
 		}
+		if err := validateMatcher(matcher); err != nil {
+			validation.AppendRouteError(routeReport,
+				validationapi.RouteReport_Error_InvalidMatcherError,
+				err.Error(),
+				generatedName,
+			)
+		}
+		// a matcher requiring the opposite of the listener can never match, so it doesn't need an envoy route
+		if secureMatcher := matcher.GetTls().GetSecure(); secureMatcher != nil && secureMatcher.GetValue() != secure {
+			continue
+		}
 		match := GlooMatcherToEnvoyMatcher(params.Params.Ctx, matcher)
-		out[i] = &envoy_config_route_v3.Route{
+		route := &envoy_config_route_v3.Route{
 			Match: &match,
 		}
 		if in.GetName() != "" {
-			out[i].Name = fmt.Sprintf("%s-%s-matcher-%d", generatedName, in.GetName(), i)
+			route.Name = fmt.Sprintf("%s-%s-matcher-%d", generatedName, in.GetName(), i)
 		} else {
-			out[i].Name = fmt.Sprintf("%s-matcher-%d", generatedName, i)
+			route.Name = fmt.Sprintf("%s-matcher-%d", generatedName, i)
 		}
+		if sniDomains := matcher.GetTls().GetSniDomains(); len(sniDomains) > 0 {
+			routeSniDomains[route] = sniDomains
+		}
+		out = append(out, route)
 	}
 
 	return out
@@ -212,6 +295,18 @@ func GlooMatcherToEnvoyMatcher(ctx context.Context, matcher *matchers.Matcher) e
 			},
 		})
 	}
+	if len(matcher.GetSourcePrefixRanges()) > 0 {
+		// invalid ranges are reported when the routes are created
+		if sourceIpMatcher, err := iptagging.SourceIpHeaderMatcher(ctx, matcher.GetSourcePrefixRanges()); err == nil {
+			match.Headers = append(match.GetHeaders(), sourceIpMatcher)
+		}
+	}
+	if tls := matcher.GetTls(); tls.GetClientCertificatePresented() != nil || tls.GetClientCertificateValidated() != nil {
+		match.TlsContext = &envoy_config_route_v3.RouteMatch_TlsContextMatchOptions{
+			Presented: tls.GetClientCertificatePresented(),
+			Validated: tls.GetClientCertificateValidated(),
+		}
+	}
 	// need to do this because Go's proto implementation makes oneofs private
 	// which genius thought of that?
 	setEnvoyPathMatcher(ctx, matcher, &match)
@@ -219,6 +314,18 @@ func GlooMatcherToEnvoyMatcher(ctx context.Context, matcher *matchers.Matcher) e
 	return match
 }
 
+func validateMatcher(matcher *matchers.Matcher) error {
+	if grpc, ok := matcher.GetPathSpecifier().(*matchers.Matcher_Grpc); ok && grpc.Grpc.GetService() == "" {
+		return MissingGrpcServiceErr
+	}
+	for _, cidr := range matcher.GetSourcePrefixRanges() {
+		if _, err := iptagging.TagName(cidr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h *httpRouteConfigurationTranslator) setAction(
 	params plugins.RouteParams,
 	routeReport *validationapi.RouteReport,
@@ -329,6 +436,13 @@ func (h *httpRouteConfigurationTranslator) runRoutePlugins(
 			if isWarningErr(err) {
 				continue
 			}
+			if plugins.IsConfigurationWarning(err) {
+				validation.AppendRouteWarning(routeReport,
+					validationapi.RouteReport_Warning_ConfigurationWarning,
+					fmt.Sprintf("%T: %v", plugin, err.Error()),
+				)
+				continue
+			}
 			validation.AppendRouteError(routeReport,
 				validationapi.RouteReport_Error_ProcessingError,
 				fmt.Sprintf("%T: %v", plugin, err.Error()),
@@ -553,6 +667,18 @@ func setEnvoyPathMatcher(ctx context.Context, in *matchers.Matcher, out *envoy_c
 		out.PathSpecifier = &envoy_config_route_v3.RouteMatch_Prefix{
 			Prefix: path.Prefix,
 		}
+	case *matchers.Matcher_Grpc:
+		if path.Grpc.GetMethod() != "" {
+			out.PathSpecifier = &envoy_config_route_v3.RouteMatch_Path{
+				Path: utils.GrpcPath(path.Grpc),
+			}
+		} else {
+			out.PathSpecifier = &envoy_config_route_v3.RouteMatch_Prefix{
+				Prefix: utils.GrpcPath(path.Grpc),
+			}
+		}
+		// envoy only matches requests whose content-type starts with application/grpc
+		out.Grpc = &envoy_config_route_v3.RouteMatch_GrpcRouteMatchOptions{}
 	}
 }
 
@@ -0,0 +1,135 @@
+package translator
+
+import (
+	"fmt"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+)
+
+// GlooSdsSecretResources returns the secrets gloo serves over SDS to the clusters and listeners of an xDS snapshot,
+// versioned as the translator versions them.
+// It rebuilds the secrets of the snapshots restored from disk, which are persisted without their secrets.
+func GlooSdsSecretResources(secrets v1.SecretList, snap envoycache.Snapshot) envoycache.Resources {
+	var clusters []*envoy_config_cluster_v3.Cluster
+	for _, res := range snap.GetResources(resource.ClusterTypeV3).Items {
+		if cluster, ok := res.ResourceProto().(*envoy_config_cluster_v3.Cluster); ok {
+			clusters = append(clusters, cluster)
+		}
+	}
+	var listeners []*envoy_config_listener_v3.Listener
+	for _, res := range snap.GetResources(resource.ListenerTypeV3).Items {
+		if listener, ok := res.ResourceProto().(*envoy_config_listener_v3.Listener); ok {
+			listeners = append(listeners, listener)
+		}
+	}
+	var secretsProto []envoycache.Resource
+	for _, secret := range computeGlooSdsSecrets(secrets, clusters, listeners) {
+		secretsProto = append(secretsProto, xds.NewSecretResource(secret))
+	}
+	return envoycache.NewResources(fmt.Sprintf("%v", EnvoyCacheResourcesListToFnvHash(secretsProto)), secretsProto)
+}
+
+// computeGlooSdsSecrets returns the secrets gloo serves over SDS that the clusters and listeners reference.
+// Only the referenced parts of the TLS secrets are served, so that Envoy is not sent the keys it does not use.
+func computeGlooSdsSecrets(
+	secrets v1.SecretList,
+	clusters []*envoy_config_cluster_v3.Cluster,
+	listeners []*envoy_config_listener_v3.Listener,
+) []*envoyauth.Secret {
+	referenced := map[string]bool{}
+	for _, cluster := range clusters {
+		addGlooSdsReferences(referenced, cluster.GetTransportSocket())
+		for _, match := range cluster.GetTransportSocketMatches() {
+			addGlooSdsReferences(referenced, match.GetTransportSocket())
+		}
+	}
+	for _, listener := range listeners {
+		for _, filterChain := range listener.GetFilterChains() {
+			addGlooSdsReferences(referenced, filterChain.GetTransportSocket())
+		}
+		addGlooSdsReferences(referenced, listener.GetDefaultFilterChain().GetTransportSocket())
+	}
+	if len(referenced) == 0 {
+		return nil
+	}
+
+	var out []*envoyauth.Secret
+	for _, secret := range secrets {
+		tlsSecret, ok := secret.GetKind().(*v1.Secret_Tls)
+		if !ok {
+			continue
+		}
+		ref := secret.GetMetadata().Ref()
+		if name := utils.GlooSdsCertificateName(ref); referenced[name] {
+			out = append(out, &envoyauth.Secret{
+				Name: name,
+				Type: &envoyauth.Secret_TlsCertificate{
+					TlsCertificate: &envoyauth.TlsCertificate{
+						CertificateChain: inlineDataSource(tlsSecret.Tls.GetCertChain()),
+						PrivateKey:       inlineDataSource(tlsSecret.Tls.GetPrivateKey()),
+					},
+				},
+			})
+		}
+		if name := utils.GlooSdsRootCaName(ref); referenced[name] {
+			out = append(out, &envoyauth.Secret{
+				Name: name,
+				Type: &envoyauth.Secret_ValidationContext{
+					ValidationContext: &envoyauth.CertificateValidationContext{
+						TrustedCa: inlineDataSource(tlsSecret.Tls.GetRootCa()),
+					},
+				},
+			})
+		}
+	}
+	return out
+}
+
+// addGlooSdsReferences adds the names of the secrets served by gloo that a TLS transport socket references
+func addGlooSdsReferences(referenced map[string]bool, transportSocket *envoy_config_core_v3.TransportSocket) {
+	if transportSocket.GetTypedConfig() == nil {
+		return
+	}
+	msg, err := utils.AnyToMessage(transportSocket.GetTypedConfig())
+	if err != nil {
+		return
+	}
+	var common *envoyauth.CommonTlsContext
+	switch tlsContext := msg.(type) {
+	case *envoyauth.UpstreamTlsContext:
+		common = tlsContext.GetCommonTlsContext()
+	case *envoyauth.DownstreamTlsContext:
+		common = tlsContext.GetCommonTlsContext()
+	default:
+		return
+	}
+
+	sdsConfigs := append([]*envoyauth.SdsSecretConfig{
+		common.GetValidationContextSdsSecretConfig(),
+		common.GetCombinedValidationContext().GetValidationContextSdsSecretConfig(),
+	}, common.GetTlsCertificateSdsSecretConfigs()...)
+	for _, sdsConfig := range sdsConfigs {
+		if utils.IsGlooSds(sdsConfig) {
+			referenced[sdsConfig.GetName()] = true
+		}
+	}
+}
+
+func inlineDataSource(s string) *envoy_config_core_v3.DataSource {
+	if s == "" {
+		return nil
+	}
+	return &envoy_config_core_v3.DataSource{
+		Specifier: &envoy_config_core_v3.DataSource_InlineString{
+			InlineString: s,
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package translator
 
 import (
+	"context"
 	"fmt"
 	"hash/fnv"
 
@@ -8,6 +9,7 @@ import (
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/golang/protobuf/proto"
 	"github.com/mitchellh/hashstructure"
 	errors "github.com/rotisserie/eris"
@@ -20,7 +22,6 @@ import (
 	"github.com/solo-io/go-utils/contextutils"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
-	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"go.opencensus.io/trace"
 	proto2 "google.golang.org/protobuf/proto"
@@ -48,18 +49,24 @@ func NewTranslatorWithHasher(
 	hasher func(resources []envoycache.Resource) uint64,
 ) Translator {
 	return &translatorFactory{
-		pluginRegistryFactory: pluginRegistryFactory,
-		settings:              settings,
-		sslConfigTranslator:   sslConfigTranslator,
-		hasher:                hasher,
+		pluginRegistryFactory:      pluginRegistryFactory,
+		settings:                   settings,
+		sslConfigTranslator:        sslConfigTranslator,
+		hasher:                     hasher,
+		clusterSubsystemTranslator: newClusterSubsystemTranslator(settings, pluginRegistryFactory),
+		listenerSubsystemCache:     newListenerSubsystemCache(),
+		envoyValidationCache:       newEnvoyValidationCache(),
 	}
 }
 
 type translatorFactory struct {
-	pluginRegistryFactory plugins.PluginRegistryFactory
-	settings              *v1.Settings
-	sslConfigTranslator   utils.SslConfigTranslator
-	hasher                func(resources []envoycache.Resource) uint64
+	pluginRegistryFactory      plugins.PluginRegistryFactory
+	settings                   *v1.Settings
+	sslConfigTranslator        utils.SslConfigTranslator
+	hasher                     func(resources []envoycache.Resource) uint64
+	clusterSubsystemTranslator *clusterSubsystemTranslator
+	listenerSubsystemCache     *listenerSubsystemCache
+	envoyValidationCache       *envoyValidationCache
 }
 
 func (t *translatorFactory) Translate(
@@ -70,10 +77,13 @@ func (t *translatorFactory) Translate(
 	listenerTranslatorFactory := NewListenerSubsystemTranslatorFactory(pluginRegistry, t.sslConfigTranslator)
 
 	instance := &translatorInstance{
-		pluginRegistry:            pluginRegistry,
-		settings:                  t.settings,
-		hasher:                    t.hasher,
-		listenerTranslatorFactory: listenerTranslatorFactory,
+		pluginRegistry:             pluginRegistry,
+		settings:                   t.settings,
+		hasher:                     t.hasher,
+		listenerTranslatorFactory:  listenerTranslatorFactory,
+		clusterSubsystemTranslator: t.clusterSubsystemTranslator,
+		listenerSubsystemCache:     t.listenerSubsystemCache,
+		envoyValidationCache:       t.envoyValidationCache,
 	}
 
 	return instance.Translate(params, proxy)
@@ -81,11 +91,16 @@ func (t *translatorFactory) Translate(
 
 // a translator instance performs one
 type translatorInstance struct {
-	pluginRegistry            plugins.PluginRegistry
-	settings                  *v1.Settings
-	sslConfigTranslator       utils.SslConfigTranslator
-	hasher                    func(resources []envoycache.Resource) uint64
-	listenerTranslatorFactory *ListenerSubsystemTranslatorFactory
+	pluginRegistry             plugins.PluginRegistry
+	settings                   *v1.Settings
+	sslConfigTranslator        utils.SslConfigTranslator
+	hasher                     func(resources []envoycache.Resource) uint64
+	listenerTranslatorFactory  *ListenerSubsystemTranslatorFactory
+	clusterSubsystemTranslator *clusterSubsystemTranslator
+	listenerSubsystemCache     *listenerSubsystemCache
+	envoyValidationCache       *envoyValidationCache
+	// whether all the plugins in the registry have been initialized
+	pluginsInitialized bool
 }
 
 func (t *translatorInstance) Translate(
@@ -97,29 +112,31 @@ func (t *translatorInstance) Translate(
 	defer span.End()
 	params.Ctx = contextutils.WithLogger(ctx, "translator")
 
-	// re-initialize plugins on each loop, this is done for 2 reasons:
-	//  1. Each translation run relies on its own context. If a plugin spawns a go-routine
-	//		we need to be able to cancel that go-routine on the next translation
-	//	2. Plugins are built with the assumption that they will be short lived, only for the
-	//		duration of a single translation loop
-	for _, p := range t.pluginRegistry.GetPlugins() {
-		if err := p.Init(plugins.InitParams{
-			Ctx:      params.Ctx,
-			Settings: t.settings,
-		}); err != nil {
-			return nil, nil, nil, errors.Wrapf(err, "plugin init failed")
-		}
-	}
-
 	// prepare reports used to aggregate Warnings/Errors encountered during translation
 	reports := make(reporter.ResourceReports)
-	proxyReport := validation.MakeReport(proxy)
 
 	// execute translation of listener and cluster subsystems
-	clusters, endpoints := t.translateClusterSubsystemComponents(params, proxy, reports)
-	routeConfigs, listeners := t.translateListenerSubsystemComponents(params, proxy, proxyReport)
+	clusters, endpoints, err := t.translateClusterSubsystemComponents(params, proxy, reports)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	routeConfigs, listeners, proxyReport, err := t.translateListenerSubsystem(params, proxy)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if t.settings.GetGloo().GetPruneUnreferencedClusters().GetValue() {
+		clusters, endpoints = pruneUnreferencedClusters(params.Ctx, clusters, endpoints, routeConfigs, listeners)
+	}
+
+	// the cluster subsystem is shared by every proxy translated from this snapshot,
+	// so copy it before handing it to plugins that may modify it
+	clusters, endpoints = cloneClusters(clusters), cloneEndpoints(endpoints)
 
 	// run Resource Generator Plugins
+	if err := t.initResourceGeneratorPlugins(params.Ctx); err != nil {
+		return nil, nil, nil, err
+	}
 	for _, plugin := range t.pluginRegistry.GetResourceGeneratorPlugins() {
 		generatedClusters, generatedEndpoints, generatedRouteConfigs, generatedListeners, err := plugin.GeneratedResources(params, clusters, endpoints, routeConfigs, listeners)
 		if err != nil {
@@ -131,7 +148,16 @@ func (t *translatorInstance) Translate(
 		listeners = append(listeners, generatedListeners...)
 	}
 
-	xdsSnapshot := t.generateXDSSnapshot(clusters, endpoints, routeConfigs, listeners)
+	var secrets []*envoyauth.Secret
+	if t.settings.GetGloo().GetTlsSecretDelivery() == v1.GlooOptions_SDS {
+		secrets = computeGlooSdsSecrets(params.Snapshot.Secrets, clusters, listeners)
+	}
+
+	xdsSnapshot := t.generateXDSSnapshot(clusters, endpoints, routeConfigs, listeners, secrets)
+
+	t.validateWithEnvoy(params, proxy, proxyReport, reports, clusters, listeners, routeConfigs, secrets)
+
+	t.reportCertificateExpiry(params, proxy, proxyReport, reports)
 
 	if err := validation.GetProxyError(proxyReport); err != nil {
 		reports.AddError(proxy, err)
@@ -147,69 +173,149 @@ func (t *translatorInstance) Translate(
 	return xdsSnapshot, reports, proxyReport, nil
 }
 
+// re-initialize plugins on each loop, this is done for 2 reasons:
+//  1. Each translation run relies on its own context. If a plugin spawns a go-routine
+//     we need to be able to cancel that go-routine on the next translation
+//  2. Plugins are built with the assumption that they will be short lived, only for the
+//     duration of a single translation loop
+func (t *translatorInstance) initPlugins(ctx context.Context) error {
+	if t.pluginsInitialized {
+		return nil
+	}
+	if err := initPlugins(ctx, t.pluginRegistry, t.settings); err != nil {
+		return err
+	}
+	t.pluginsInitialized = true
+	return nil
+}
+
+// initResourceGeneratorPlugins initializes only the resource generator plugins, if the other plugins
+// were not needed by this translation
+func (t *translatorInstance) initResourceGeneratorPlugins(ctx context.Context) error {
+	if t.pluginsInitialized {
+		return nil
+	}
+	for _, p := range t.pluginRegistry.GetResourceGeneratorPlugins() {
+		if err := p.Init(plugins.InitParams{
+			Ctx:      ctx,
+			Settings: t.settings,
+		}); err != nil {
+			return errors.Wrapf(err, "plugin init failed")
+		}
+	}
+	return nil
+}
+
+func initPlugins(ctx context.Context, pluginRegistry plugins.PluginRegistry, settings *v1.Settings) error {
+	for _, p := range pluginRegistry.GetPlugins() {
+		if err := p.Init(plugins.InitParams{
+			Ctx:      ctx,
+			Settings: settings,
+		}); err != nil {
+			return errors.Wrapf(err, "plugin init failed")
+		}
+	}
+	return nil
+}
+
+// the returned clusters and endpoints are shared with other proxies, and must not be modified
 func (t *translatorInstance) translateClusterSubsystemComponents(params plugins.Params, proxy *v1.Proxy, reports reporter.ResourceReports) (
 	[]*envoy_config_cluster_v3.Cluster,
 	[]*envoy_config_endpoint_v3.ClusterLoadAssignment,
+	error,
 ) {
-	logger := contextutils.LoggerFrom(params.Ctx)
+	clusterSubsystem, err := t.clusterSubsystemTranslator.Translate(params)
+	if err != nil {
+		return nil, nil, err
+	}
+	reports.Merge(clusterSubsystem.reportsFor(params.Snapshot))
 
-	logger.Debugf("verifying upstream groups: %v", proxy.GetMetadata().GetName())
-	t.verifyUpstreamGroups(params, reports)
+	validateUpstreamLambdaFunctions(proxy, params.Snapshot.Upstreams, params.Snapshot.UpstreamGroups, reports)
 
-	upstreamRefKeyToEndpoints := createUpstreamToEndpointsMap(params.Snapshot.Upstreams, params.Snapshot.Endpoints)
+	return clusterSubsystem.clusters, clusterSubsystem.endpoints, nil
+}
 
-	// endpoints and listeners are shared between listeners
-	logger.Debugf("computing envoy clusters for proxy: %v", proxy.GetMetadata().GetName())
-	clusters, clusterToUpstreamMap := t.computeClusters(params, reports, upstreamRefKeyToEndpoints, proxy)
-	logger.Debugf("computing envoy endpoints for proxy: %v", proxy.GetMetadata().GetName())
+// computeUpstreamClusterSubsystem translates an upstream into its cluster and, if it has endpoints or uses EDS, its load assignment.
+// It does not depend on the proxy being translated, nor on the other upstreams in the snapshot.
+func (t *translatorInstance) computeUpstreamClusterSubsystem(
+	params plugins.Params,
+	upstream *v1.Upstream,
+	upstreamRefKeyToEndpoints map[string][]*v1.Endpoint,
+	reports reporter.ResourceReports,
+) (*envoy_config_cluster_v3.Cluster, *envoy_config_endpoint_v3.ClusterLoadAssignment) {
+	cluster := t.computeCluster(params, upstream, upstreamRefKeyToEndpoints, reports)
+
+	var loadAssignment *envoy_config_endpoint_v3.ClusterLoadAssignment
+	// if there are any endpoints for this upstream, it's using eds and we need to create a load assignment for it
+	if clusterEndpoints := upstreamRefKeyToEndpoints[upstream.GetMetadata().Ref().Key()]; len(clusterEndpoints) > 0 {
+		loadAssignment = t.computeClusterEndpoints(params, upstream, clusterEndpoints, reports)
+	}
 
-	endpoints := t.computeClusterEndpoints(params, upstreamRefKeyToEndpoints, reports)
+	if cluster.GetType() != envoy_config_cluster_v3.Cluster_EDS {
+		return cluster, loadAssignment
+	}
+	endpointClusterName, err := getEndpointClusterName(cluster.GetName(), upstream)
+	if err != nil {
+		reports.AddError(upstream, errors.Wrapf(err, "could not marshal upstream to JSON"))
+	}
+	// Workaround for envoy bug: https://github.com/envoyproxy/envoy/issues/13009
+	// Change the cluster eds config, forcing envoy to re-request latest EDS config
+	cluster.GetEdsClusterConfig().ServiceName = endpointClusterName
+	if loadAssignment != nil {
+		// the endpoint ClusterName needs to match the cluster's EdsClusterConfig ServiceName
+		loadAssignment.ClusterName = endpointClusterName
+		return cluster, loadAssignment
+	}
 
-	// Find all the EDS clusters without endpoints (can happen with kube service that have no endpoints), and create a zero sized load assignment
+	// EDS clusters without endpoints (can happen with kube service that have no endpoints) need a zero sized load assignment
 	// this is important as otherwise envoy will wait for them forever wondering their fate and not doing much else.
-ClusterLoop:
-	for _, c := range clusters {
-		if c.GetType() != envoy_config_cluster_v3.Cluster_EDS {
-			continue
-		}
-		// get upstream that generated this cluster
-		upstream := clusterToUpstreamMap[c]
-		endpointClusterName, err := getEndpointClusterName(c.GetName(), upstream)
-		if err != nil {
-			reports.AddError(upstream, errors.Wrapf(err, "could not marshal upstream to JSON"))
-		}
-		// Workaround for envoy bug: https://github.com/envoyproxy/envoy/issues/13009
-		// Change the cluster eds config, forcing envoy to re-request latest EDS config
-		c.GetEdsClusterConfig().ServiceName = endpointClusterName
-		for _, ep := range endpoints {
-			if ep.GetClusterName() == c.GetName() {
-
-				// the endpoint ClusterName needs to match the cluster's EdsClusterConfig ServiceName
-				ep.ClusterName = endpointClusterName
-				continue ClusterLoop
-			}
-		}
-		emptyendpointlist := &envoy_config_endpoint_v3.ClusterLoadAssignment{
-			ClusterName: endpointClusterName,
-		}
-		// make sure to call EndpointPlugin with empty endpoint
-		for _, upstream := range params.Snapshot.Upstreams {
-			if UpstreamToClusterName(&core.ResourceRef{
-				Name:      upstream.GetMetadata().GetName(),
-				Namespace: upstream.GetMetadata().GetNamespace(),
-			}) == c.GetName() {
-				for _, plugin := range t.pluginRegistry.GetEndpointPlugins() {
-					if err := plugin.ProcessEndpoints(params, upstream, emptyendpointlist); err != nil {
-						reports.AddError(upstream, err)
-					}
-				}
-			}
+	emptyendpointlist := &envoy_config_endpoint_v3.ClusterLoadAssignment{
+		ClusterName: endpointClusterName,
+	}
+	// make sure to call EndpointPlugin with empty endpoint
+	for _, plugin := range t.pluginRegistry.GetEndpointPlugins() {
+		if err := plugin.ProcessEndpoints(params, upstream, emptyendpointlist); err != nil {
+			reportUpstreamPluginError(reports, upstream, err)
 		}
+	}
+	return cluster, emptyendpointlist
+}
 
-		endpoints = append(endpoints, emptyendpointlist)
+// translateListenerSubsystem returns the route configurations, listeners and report for the proxy, reusing those of the
+// previous translation of the proxy if neither it nor the snapshot resources it may depend on have changed.
+// The returned resources are owned by the caller.
+func (t *translatorInstance) translateListenerSubsystem(params plugins.Params, proxy *v1.Proxy) (
+	[]*envoy_config_route_v3.RouteConfiguration,
+	[]*envoy_config_listener_v3.Listener,
+	*validationapi.ProxyReport,
+	error,
+) {
+	// if the inputs cannot be hashed, fall back to translating the proxy without caching it
+	hash, hashErr := hashListenerSubsystemInputs(t.settings, proxy, params.Snapshot)
+	if hashErr == nil {
+		if latest := t.listenerSubsystemCache.get(proxy, hash); latest != nil {
+			contextutils.LoggerFrom(params.Ctx).Debugf("proxy %v and its dependencies have not changed, reusing its listeners", proxy.GetMetadata().Ref())
+			routeConfigs, listeners, proxyReport := latest.copy()
+			return routeConfigs, listeners, proxyReport, nil
+		}
 	}
 
-	return clusters, endpoints
+	if err := t.initPlugins(params.Ctx); err != nil {
+		return nil, nil, nil, err
+	}
+	proxyReport := validation.MakeReport(proxy)
+	routeConfigs, listeners := t.translateListenerSubsystemComponents(params, proxy, proxyReport)
+	listenerSubsystem := &listenerSubsystem{
+		hash:         hash,
+		routeConfigs: routeConfigs,
+		listeners:    listeners,
+		proxyReport:  proxyReport,
+	}
+	if hashErr == nil {
+		t.listenerSubsystemCache.set(params.Snapshot, proxy, listenerSubsystem)
+	}
+	routeConfigs, listeners, proxyReport = listenerSubsystem.copy()
+	return routeConfigs, listeners, proxyReport, nil
 }
 
 func (t *translatorInstance) translateListenerSubsystemComponents(params plugins.Params, proxy *v1.Proxy, proxyReport *validationapi.ProxyReport) (
@@ -259,28 +365,34 @@ func (t *translatorInstance) generateXDSSnapshot(
 	endpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment,
 	routeConfigs []*envoy_config_route_v3.RouteConfiguration,
 	listeners []*envoy_config_listener_v3.Listener,
+	secrets []*envoyauth.Secret,
 ) envoycache.Snapshot {
 
-	var endpointsProto, clustersProto, listenersProto []envoycache.Resource
+	var endpointsProto, clustersProto, listenersProto, secretsProto []envoycache.Resource
 
+	// all the resources are already copies owned by this translation
 	for _, ep := range endpoints {
-		endpointsProto = append(endpointsProto, resource.NewEnvoyResource(proto.Clone(ep)))
+		endpointsProto = append(endpointsProto, resource.NewEnvoyResource(ep))
 	}
 	for _, cluster := range clusters {
-		clustersProto = append(clustersProto, resource.NewEnvoyResource(proto.Clone(cluster)))
+		clustersProto = append(clustersProto, resource.NewEnvoyResource(cluster))
 	}
 	for _, listener := range listeners {
 		// don't add empty listeners, envoy will complain
 		if len(listener.GetFilterChains()) < 1 {
 			continue
 		}
-		listenersProto = append(listenersProto, resource.NewEnvoyResource(proto.Clone(listener)))
+		listenersProto = append(listenersProto, resource.NewEnvoyResource(listener))
+	}
+	for _, secret := range secrets {
+		secretsProto = append(secretsProto, xds.NewSecretResource(secret))
 	}
 	// construct version
 	// TODO: investigate whether we need a more sophisticated versioning algorithm
 	endpointsVersion := t.hasher(endpointsProto)
 	clustersVersion := t.hasher(clustersProto)
 	listenersVersion := t.hasher(listenersProto)
+	secretsVersion := t.hasher(secretsProto)
 
 	// if clusters are updated, provider a new version of the endpoints,
 	// so the clusters are warm
@@ -288,7 +400,8 @@ func (t *translatorInstance) generateXDSSnapshot(
 		envoycache.NewResources(fmt.Sprintf("%v-%v", clustersVersion, endpointsVersion), endpointsProto),
 		envoycache.NewResources(fmt.Sprintf("%v", clustersVersion), clustersProto),
 		MakeRdsResources(routeConfigs),
-		envoycache.NewResources(fmt.Sprintf("%v", listenersVersion), listenersProto))
+		envoycache.NewResources(fmt.Sprintf("%v", listenersVersion), listenersProto),
+		envoycache.NewResources(fmt.Sprintf("%v", secretsVersion), secretsProto))
 }
 
 func EnvoyCacheResourcesListToFnvHash(resources []envoycache.Resource) uint64 {
@@ -3,6 +3,10 @@ package translator_test
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 
@@ -27,8 +31,10 @@ import (
 	"github.com/golang/protobuf/ptypes/duration"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/go-multierror"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils/api_conversion"
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	"github.com/solo-io/gloo/projects/gloo/constants"
@@ -43,10 +49,12 @@ import (
 	v1grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
 	v1kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/iptagging"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
@@ -54,6 +62,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/kubernetes"
 	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	gloohelpers "github.com/solo-io/gloo/test/helpers"
 	envoycore_sk "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
@@ -62,6 +71,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
 	. "github.com/solo-io/solo-kit/test/matchers"
 	k8scorev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -174,7 +184,7 @@ var _ = Describe("Translator", func() {
 			return registry.NewPluginRegistry(registeredPlugins)
 		}
 
-		translator = NewTranslator(glooutils.NewSslConfigTranslator(), settings, pluginRegistryFactory)
+		translator = NewTranslator(glooutils.NewSslConfigTranslatorForSettings(settings), settings, pluginRegistryFactory)
 		httpListener := &v1.Listener{
 			Name:        "http-listener",
 			BindAddress: "127.0.0.1",
@@ -732,6 +742,122 @@ var _ = Describe("Translator", func() {
 		})
 	})
 
+	Context("route grpc match", func() {
+		It("should translate a grpc method matcher to an exact path match on grpc requests", func() {
+			matcher.PathSpecifier = &matchers.Matcher_Grpc{
+				Grpc: &matchers.GrpcMatcher{Service: "foo.Bar", Method: "Baz"},
+			}
+			translate()
+
+			match := routeConfiguration.VirtualHosts[0].Routes[0].Match
+			Expect(match.GetPath()).To(Equal("/foo.Bar/Baz"))
+			Expect(match.GetGrpc()).NotTo(BeNil())
+		})
+
+		It("should translate a grpc service matcher to a prefix match on grpc requests", func() {
+			matcher.PathSpecifier = &matchers.Matcher_Grpc{
+				Grpc: &matchers.GrpcMatcher{Service: "foo.Bar"},
+			}
+			translate()
+
+			match := routeConfiguration.VirtualHosts[0].Routes[0].Match
+			Expect(match.GetPrefix()).To(Equal("/foo.Bar/"))
+			Expect(match.GetGrpc()).NotTo(BeNil())
+		})
+
+		It("should error when the grpc matcher has no service", func() {
+			matcher.PathSpecifier = &matchers.Matcher_Grpc{
+				Grpc: &matchers.GrpcMatcher{Method: "Baz"},
+			}
+			report := translateWithError()
+
+			routeReport := report.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetRouteReports()[0]
+			Expect(routeReport.GetErrors()).To(HaveLen(1))
+			Expect(routeReport.GetErrors()[0].GetType()).To(Equal(validation.RouteReport_Error_InvalidMatcherError))
+			Expect(routeReport.GetErrors()[0].GetReason()).To(ContainSubstring(MissingGrpcServiceErr.Error()))
+		})
+	})
+
+	Context("route source ip match", func() {
+		It("should tag the requests and match the tags of the source prefix ranges", func() {
+			matcher.SourcePrefixRanges = []*v3.CidrRange{
+				{AddressPrefix: "10.1.0.0", PrefixLen: &wrappers.UInt32Value{Value: 16}},
+				{AddressPrefix: "2001:db8::", PrefixLen: &wrappers.UInt32Value{Value: 32}},
+			}
+			translate()
+
+			headerMatch := routeConfiguration.VirtualHosts[0].Routes[0].Match.Headers[0]
+			Expect(headerMatch.GetName()).To(Equal(iptagging.IpTagsHeader))
+			Expect(headerMatch.GetSafeRegexMatch().GetRegex()).To(Equal(`(.*,)?(10\.1\.0\.0/16|2001:db8::/32)(,.*)?`))
+
+			var filterNames []string
+			for _, filter := range hcmCfg.GetHttpFilters() {
+				filterNames = append(filterNames, filter.GetName())
+			}
+			Expect(filterNames).To(ContainElement(wellknown.IPTagging))
+		})
+
+		It("should error on an invalid source prefix range", func() {
+			matcher.SourcePrefixRanges = []*v3.CidrRange{
+				{AddressPrefix: "not-an-ip", PrefixLen: &wrappers.UInt32Value{Value: 16}},
+			}
+			report := translateWithError()
+
+			routeReport := report.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetRouteReports()[0]
+			Expect(routeReport.GetErrors()).To(HaveLen(1))
+			Expect(routeReport.GetErrors()[0].GetType()).To(Equal(validation.RouteReport_Error_InvalidMatcherError))
+			Expect(routeReport.GetErrors()[0].GetReason()).To(ContainSubstring("invalid source prefix range not-an-ip/16"))
+		})
+	})
+
+	Context("route tls match", func() {
+		It("should not create routes for the matchers requiring tls on a plaintext listener", func() {
+			routes[0].Matchers = []*matchers.Matcher{
+				{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/secure"},
+					Tls:           &matchers.TlsMatcher{Secure: &wrappers.BoolValue{Value: true}},
+				},
+				{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/plaintext"},
+					Tls:           &matchers.TlsMatcher{Secure: &wrappers.BoolValue{Value: false}},
+				},
+			}
+			translate()
+
+			Expect(routeConfiguration.VirtualHosts[0].Routes).To(HaveLen(1))
+			Expect(routeConfiguration.VirtualHosts[0].Routes[0].Match.GetPrefix()).To(Equal("/plaintext"))
+		})
+
+		It("should translate client certificate matchers to a tls context match", func() {
+			matcher.Tls = &matchers.TlsMatcher{
+				ClientCertificatePresented: &wrappers.BoolValue{Value: true},
+				ClientCertificateValidated: &wrappers.BoolValue{Value: false},
+			}
+			translate()
+
+			Expect(routeConfiguration.VirtualHosts[0].Routes[0].Match.GetTlsContext()).To(MatchProto(&envoy_config_route_v3.RouteMatch_TlsContextMatchOptions{
+				Presented: &wrappers.BoolValue{Value: true},
+				Validated: &wrappers.BoolValue{Value: false},
+			}))
+		})
+
+		It("should not create routes for the matchers on sni on a plaintext listener", func() {
+			routes[0].Matchers = []*matchers.Matcher{
+				{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/sni"},
+					Tls:           &matchers.TlsMatcher{SniDomains: []string{"sni1"}},
+				},
+				{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+				},
+			}
+			translate()
+
+			Expect(routeConfiguration.VirtualHosts[0].Routes).To(HaveLen(1))
+			Expect(routeConfiguration.VirtualHosts[0].Routes[0].Match.GetPrefix()).To(Equal("/"))
+		})
+	})
+
 	Context("non route_routeaction routes", func() {
 		BeforeEach(func() {
 			redirectRoute := &v1.Route{
@@ -1050,6 +1176,60 @@ var _ = Describe("Translator", func() {
 
 			Expect(cluster.CircuitBreakers).To(MatchProto(expectedCircuitBreakers))
 		})
+
+		It("should translate retry budgets", func() {
+
+			upstream.CircuitBreakers = &v1.CircuitBreakerConfig{
+				MaxRequests: &wrappers.UInt32Value{Value: 3},
+				RetryBudget: &v1.CircuitBreakerConfig_RetryBudget{
+					BudgetPercent:       &wrappers.DoubleValue{Value: 25},
+					MinRetryConcurrency: &wrappers.UInt32Value{Value: 5},
+				},
+			}
+
+			expectedCircuitBreakers := &envoy_config_cluster_v3.CircuitBreakers{
+				Thresholds: []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{
+					{
+						MaxRequests: &wrappers.UInt32Value{Value: 3},
+						RetryBudget: &envoy_config_cluster_v3.CircuitBreakers_Thresholds_RetryBudget{
+							BudgetPercent:       &envoy_type_v3.Percent{Value: 25},
+							MinRetryConcurrency: &wrappers.UInt32Value{Value: 5},
+						},
+					},
+				},
+			}
+			translate()
+
+			Expect(cluster.CircuitBreakers).To(MatchProto(expectedCircuitBreakers))
+		})
+
+		It("should warn when max retries are set along with a retry budget", func() {
+
+			upstream.CircuitBreakers = &v1.CircuitBreakerConfig{
+				MaxRetries:  &wrappers.UInt32Value{Value: 4},
+				RetryBudget: &v1.CircuitBreakerConfig_RetryBudget{},
+			}
+
+			_, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			_, upstreamReport := errs.Find("*v1.Upstream", upstream.Metadata.Ref())
+			Expect(upstreamReport.Errors).NotTo(HaveOccurred())
+			Expect(upstreamReport.Warnings).To(ConsistOf(ContainSubstring("maxRetries is ignored")))
+		})
+
+		It("should error on an invalid retry budget percent", func() {
+
+			upstream.CircuitBreakers = &v1.CircuitBreakerConfig{
+				RetryBudget: &v1.CircuitBreakerConfig_RetryBudget{
+					BudgetPercent: &wrappers.DoubleValue{Value: 120},
+				},
+			}
+
+			_, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			_, upstreamReport := errs.Find("*v1.Upstream", upstream.Metadata.Ref())
+			Expect(upstreamReport.Errors).To(MatchError(ContainSubstring(InvalidRetryBudgetPercentError(120).Error())))
+		})
 	})
 
 	Context("eds", func() {
@@ -1334,6 +1514,32 @@ var _ = Describe("Translator", func() {
 		})
 	})
 
+	Context("when handling retry policies", func() {
+
+		It("reports the configuration warnings of route plugins as route warnings", func() {
+			routes[0].Options = &v1.RouteOptions{
+				Retries: &retries.RetryPolicy{
+					RetryOn:              "5xx",
+					RetriableStatusCodes: []uint32{409},
+				},
+			}
+
+			snap, errs, report, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errs.Validate()).NotTo(HaveOccurred())
+
+			routeReport := report.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetRouteReports()[0]
+			Expect(routeReport.GetErrors()).To(BeEmpty())
+			Expect(routeReport.GetWarnings()).To(HaveLen(1))
+			Expect(routeReport.GetWarnings()[0].GetType()).To(Equal(validation.RouteReport_Warning_ConfigurationWarning))
+			Expect(routeReport.GetWarnings()[0].GetReason()).To(ContainSubstring("retriableStatusCodes are ignored"))
+
+			// the policy is still applied
+			routeConfig := snap.GetResources(resource.RouteTypeV3).Items["http-listener-routes"].ResourceProto().(*envoy_config_route_v3.RouteConfiguration)
+			Expect(routeConfig.GetVirtualHosts()[0].GetRoutes()[0].GetRoute().GetRetryPolicy().GetRetriableStatusCodes()).To(Equal([]uint32{409}))
+		})
+	})
+
 	Context("when handling missing upstream groups", func() {
 		BeforeEach(func() {
 			metadata := core.Metadata{
@@ -1417,6 +1623,77 @@ var _ = Describe("Translator", func() {
 			Expect(filterMetadata[SoloAnnotations].Fields).To(HaveKey("testkey"))
 			Expect(filterMetadata[SoloAnnotations].Fields["testkey"].GetStringValue()).To(Equal("testvalue"))
 		})
+
+		It("should group the endpoints by locality", func() {
+			ref := upstream.Metadata.Ref()
+			newEndpoint := func(name, address string, locality *v1.Locality) *v1.Endpoint {
+				return &v1.Endpoint{
+					Metadata:  &core.Metadata{Name: name, Namespace: "gloo-system"},
+					Upstreams: []*core.ResourceRef{ref},
+					Address:   address,
+					Port:      1234,
+					Locality:  locality,
+				}
+			}
+			params.Snapshot.Endpoints = v1.EndpointList{
+				newEndpoint("zone-c", "1.2.3.4", &v1.Locality{Region: "us-east1", Zone: "us-east1-c"}),
+				newEndpoint("zone-b-1", "1.2.3.5", &v1.Locality{Region: "us-east1", Zone: "us-east1-b"}),
+				newEndpoint("no-locality", "1.2.3.6", nil),
+				newEndpoint("zone-b-2", "1.2.3.7", &v1.Locality{Region: "us-east1", Zone: "us-east1-b"}),
+			}
+
+			translate()
+
+			clusterName := getEndpointClusterName(upstream)
+			claConfiguration = snapshot.GetResources(resource.EndpointTypeV3).Items[clusterName].ResourceProto().(*envoy_config_endpoint_v3.ClusterLoadAssignment)
+			Expect(claConfiguration.Endpoints).To(HaveLen(3))
+
+			addresses := func(localityEndpoints *envoy_config_endpoint_v3.LocalityLbEndpoints) []string {
+				var out []string
+				for _, lbEndpoint := range localityEndpoints.GetLbEndpoints() {
+					out = append(out, lbEndpoint.GetEndpoint().GetAddress().GetSocketAddress().GetAddress())
+				}
+				return out
+			}
+			Expect(claConfiguration.Endpoints[0].GetLocality()).To(BeNil())
+			Expect(addresses(claConfiguration.Endpoints[0])).To(Equal([]string{"1.2.3.6"}))
+			Expect(claConfiguration.Endpoints[1].GetLocality()).To(MatchProto(&envoy_config_core_v3.Locality{Region: "us-east1", Zone: "us-east1-b"}))
+			Expect(addresses(claConfiguration.Endpoints[1])).To(ConsistOf("1.2.3.5", "1.2.3.7"))
+			Expect(claConfiguration.Endpoints[2].GetLocality()).To(MatchProto(&envoy_config_core_v3.Locality{Region: "us-east1", Zone: "us-east1-c"}))
+			Expect(addresses(claConfiguration.Endpoints[2])).To(Equal([]string{"1.2.3.4"}))
+		})
+
+		It("should set the health status of the endpoints", func() {
+			ref := upstream.Metadata.Ref()
+			newEndpoint := func(name, address string, healthStatus v1.Endpoint_HealthStatus) *v1.Endpoint {
+				return &v1.Endpoint{
+					Metadata:     &core.Metadata{Name: name, Namespace: "gloo-system"},
+					Upstreams:    []*core.ResourceRef{ref},
+					Address:      address,
+					Port:         1234,
+					HealthStatus: healthStatus,
+				}
+			}
+			params.Snapshot.Endpoints = v1.EndpointList{
+				newEndpoint("unknown", "1.2.3.4", v1.Endpoint_UNKNOWN),
+				newEndpoint("healthy", "1.2.3.5", v1.Endpoint_HEALTHY),
+				newEndpoint("draining", "1.2.3.6", v1.Endpoint_DRAINING),
+			}
+
+			translate()
+
+			clusterName := getEndpointClusterName(upstream)
+			claConfiguration = snapshot.GetResources(resource.EndpointTypeV3).Items[clusterName].ResourceProto().(*envoy_config_endpoint_v3.ClusterLoadAssignment)
+			healthStatuses := map[string]envoy_config_core_v3.HealthStatus{}
+			for _, lbEndpoint := range claConfiguration.Endpoints[0].GetLbEndpoints() {
+				healthStatuses[lbEndpoint.GetEndpoint().GetAddress().GetSocketAddress().GetAddress()] = lbEndpoint.GetHealthStatus()
+			}
+			Expect(healthStatuses).To(Equal(map[string]envoy_config_core_v3.HealthStatus{
+				"1.2.3.4": envoy_config_core_v3.HealthStatus_UNKNOWN,
+				"1.2.3.5": envoy_config_core_v3.HealthStatus_HEALTHY,
+				"1.2.3.6": envoy_config_core_v3.HealthStatus_DRAINING,
+			}))
+		})
 	})
 
 	Context("when handling subsets", func() {
@@ -2558,6 +2835,64 @@ var _ = Describe("Translator", func() {
 				Expect(listener.GetListenerFilters()[0].GetName()).To(Equal(wellknown.TlsInspector))
 			})
 
+			It("should scope the routes matching on sni to the filter chains of their domains", func() {
+				routes[0].Matchers = []*matchers.Matcher{
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/sni1"},
+						Tls:           &matchers.TlsMatcher{SniDomains: []string{"sni1"}},
+					},
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+					},
+				}
+				prepSsl([]*v1.SslConfig{
+					{
+						SslSecrets: &v1.SslConfig_SslFiles{
+							SslFiles: &v1.SSLFiles{
+								TlsCert: "cert1",
+								TlsKey:  "key1",
+							},
+						},
+						SniDomains: []string{"sni1"},
+					},
+					{
+						SslSecrets: &v1.SslConfig_SslFiles{
+							SslFiles: &v1.SSLFiles{
+								TlsCert: "cert2",
+								TlsKey:  "key2",
+							},
+						},
+						SniDomains: []string{"sni1", "sni2"},
+					},
+				})
+
+				snap, errs, _, err := translator.Translate(params, proxy)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(errs.Validate()).NotTo(HaveOccurred())
+
+				listener = snap.GetResources(resource.ListenerTypeV3).Items["http-listener"].ResourceProto().(*envoy_config_listener_v3.Listener)
+				Expect(listener.GetFilterChains()).To(HaveLen(2))
+				routeConfigs := snap.GetResources(resource.RouteTypeV3).Items
+				Expect(routeConfigs).NotTo(HaveKey("http-listener-routes"))
+
+				var routePrefixes [][]string
+				for i, fc := range listener.GetFilterChains() {
+					hcm := &envoyhttp.HttpConnectionManager{}
+					Expect(ParseTypedConfig(fc.GetFilters()[0], hcm)).To(Succeed())
+					Expect(hcm.GetRds().GetRouteConfigName()).To(Equal(glooutils.SniRouteConfigName(proxy.GetListeners()[0], i)))
+
+					Expect(routeConfigs).To(HaveKey(hcm.GetRds().GetRouteConfigName()))
+					routeConfig := routeConfigs[hcm.GetRds().GetRouteConfigName()].ResourceProto().(*envoy_config_route_v3.RouteConfiguration)
+					var prefixes []string
+					for _, route := range routeConfig.GetVirtualHosts()[0].GetRoutes() {
+						prefixes = append(prefixes, route.GetMatch().GetPrefix())
+					}
+					routePrefixes = append(routePrefixes, prefixes)
+				}
+				// the second filter chain also accepts connections to sni2, so it only gets the routes matching any sni
+				Expect(routePrefixes).To(Equal([][]string{{"/sni1", "/"}, {"/"}}))
+			})
+
 			It("should reject configs if different FilterChains have identical FilterChainMatches", func() {
 				filterChains := []*envoy_config_listener_v3.FilterChain{
 					{
@@ -2962,6 +3297,201 @@ var _ = Describe("Translator", func() {
 				Expect(listener.GetListenerFilters()[0].GetName()).To(Equal(wellknown.TlsInspector))
 			})
 		})
+
+		Context("secrets served by gloo over sds", func() {
+
+			BeforeEach(func() {
+				settings.Gloo = &v1.GlooOptions{TlsSecretDelivery: v1.GlooOptions_SDS}
+				params.Snapshot.Secrets = append(params.Snapshot.Secrets, &v1.Secret{
+					Metadata: &core.Metadata{
+						Name:      "solo",
+						Namespace: "solo.io",
+					},
+					Kind: &v1.Secret_Tls{
+						Tls: &v1.TlsSecret{
+							CertChain:  "chain",
+							PrivateKey: "key",
+						},
+					},
+				}, &v1.Secret{
+					Metadata: &core.Metadata{
+						Name:      "upstream-ca",
+						Namespace: "solo.io",
+					},
+					Kind: &v1.Secret_Tls{
+						Tls: &v1.TlsSecret{
+							RootCa: "rootca",
+						},
+					},
+				}, &v1.Secret{
+					Metadata: &core.Metadata{
+						Name:      "unused",
+						Namespace: "solo.io",
+					},
+					Kind: &v1.Secret_Tls{
+						Tls: &v1.TlsSecret{
+							CertChain:  "chain2",
+							PrivateKey: "key2",
+						},
+					},
+				})
+				upstream.SslConfig = &v1.UpstreamSslConfig{
+					SslSecrets: &v1.UpstreamSslConfig_SecretRef{
+						SecretRef: &core.ResourceRef{
+							Name:      "upstream-ca",
+							Namespace: "solo.io",
+						},
+					},
+				}
+			})
+
+			It("should reference the secrets and serve the referenced ones", func() {
+				prep([]*v1.SslConfig{
+					{
+						SslSecrets: &v1.SslConfig_SecretRef{
+							SecretRef: &core.ResourceRef{
+								Name:      "solo",
+								Namespace: "solo.io",
+							},
+						},
+					},
+				})
+
+				Expect(listener.GetFilterChains()).To(HaveLen(1))
+				common := tlsContext(listener.GetFilterChains()[0]).GetCommonTlsContext()
+				Expect(common.GetTlsCertificates()).To(BeEmpty())
+				Expect(common.GetTlsCertificateSdsSecretConfigs()).To(HaveLen(1))
+				Expect(common.GetTlsCertificateSdsSecretConfigs()[0].GetName()).To(Equal("solo.io/solo"))
+				Expect(common.GetTlsCertificateSdsSecretConfigs()[0].GetSdsConfig().GetAds()).NotTo(BeNil())
+
+				upstreamTlsContext := glooutils.MustAnyToMessage(cluster.GetTransportSocket().GetTypedConfig()).(*envoyauth.UpstreamTlsContext)
+				Expect(upstreamTlsContext.GetCommonTlsContext().GetValidationContextSdsSecretConfig().GetName()).To(Equal("solo.io/upstream-ca/root-ca"))
+
+				secrets := snapshot.GetResources(resource.SecretTypeV3).Items
+				Expect(secrets).To(HaveLen(2))
+				Expect(secrets["solo.io/solo"].ResourceProto()).To(MatchProto(&envoyauth.Secret{
+					Name: "solo.io/solo",
+					Type: &envoyauth.Secret_TlsCertificate{
+						TlsCertificate: &envoyauth.TlsCertificate{
+							CertificateChain: &envoy_config_core_v3.DataSource{Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: "chain"}},
+							PrivateKey:       &envoy_config_core_v3.DataSource{Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: "key"}},
+						},
+					},
+				}))
+				Expect(secrets["solo.io/upstream-ca/root-ca"].ResourceProto()).To(MatchProto(&envoyauth.Secret{
+					Name: "solo.io/upstream-ca/root-ca",
+					Type: &envoyauth.Secret_ValidationContext{
+						ValidationContext: &envoyauth.CertificateValidationContext{
+							TrustedCa: &envoy_config_core_v3.DataSource{Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: "rootca"}},
+						},
+					},
+				}))
+			})
+
+			It("should rebuild the same secrets from the clusters and listeners of the snapshot", func() {
+				prep([]*v1.SslConfig{
+					{
+						SslSecrets: &v1.SslConfig_SecretRef{
+							SecretRef: &core.ResourceRef{
+								Name:      "solo",
+								Namespace: "solo.io",
+							},
+						},
+					},
+				})
+
+				translated := snapshot.GetResources(resource.SecretTypeV3)
+				rebuilt := GlooSdsSecretResources(params.Snapshot.Secrets, snapshot)
+				Expect(rebuilt.Version).To(Equal(translated.Version))
+				Expect(rebuilt.Items).To(HaveLen(2))
+				for name, secret := range translated.Items {
+					Expect(rebuilt.Items[name].ResourceProto()).To(MatchProto(secret.ResourceProto()))
+				}
+			})
+		})
+
+		Context("expiring certificates", func() {
+
+			BeforeEach(func() {
+				validFor := time.Hour
+				cert, key := gloohelpers.GetCerts(gloohelpers.Params{
+					Hosts:    "a.com",
+					ValidFor: &validFor,
+				})
+				params.Snapshot.Secrets = append(params.Snapshot.Secrets, &v1.Secret{
+					Metadata: &core.Metadata{
+						Name:      "expiring",
+						Namespace: "solo.io",
+					},
+					Kind: &v1.Secret_Tls{
+						Tls: &v1.TlsSecret{
+							CertChain:  cert,
+							PrivateKey: key,
+							RootCa:     cert,
+						},
+					},
+				})
+			})
+
+			It("should warn on the virtual hosts served with the certificate", func() {
+				prepSsl([]*v1.SslConfig{
+					{
+						SslSecrets: &v1.SslConfig_SecretRef{
+							SecretRef: &core.ResourceRef{
+								Name:      "expiring",
+								Namespace: "solo.io",
+							},
+						},
+						SniDomains: []string{"a.com"},
+					},
+				})
+
+				_, reports, proxyReport, err := translator.Translate(params, proxy)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reports.Validate()).NotTo(HaveOccurred())
+				vhostWarnings := proxyReport.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetWarnings()
+				Expect(vhostWarnings).To(HaveLen(1))
+				Expect(vhostWarnings[0].GetType()).To(Equal(validation.VirtualHostReport_Warning_CertificateExpiryWarning))
+				Expect(vhostWarnings[0].GetReason()).To(ContainSubstring("the certificate of TLS secret solo.io.expiring expires on"))
+
+				_, proxyResourceReport := reports.Find(resources.Kind(proxy), proxy.GetMetadata().Ref())
+				Expect(proxyResourceReport.Warnings).To(ConsistOf(ContainSubstring("VirtualHost Warning: CertificateExpiryWarning")))
+			})
+
+			It("should warn on the upstreams using the certificate", func() {
+				upstream.SslConfig = &v1.UpstreamSslConfig{
+					SslSecrets: &v1.UpstreamSslConfig_SecretRef{
+						SecretRef: &core.ResourceRef{
+							Name:      "expiring",
+							Namespace: "solo.io",
+						},
+					},
+				}
+
+				_, reports, _, err := translator.Translate(params, proxy)
+				Expect(err).NotTo(HaveOccurred())
+				_, upstreamReport := reports.Find(resources.Kind(upstream), upstream.GetMetadata().Ref())
+				Expect(upstreamReport.Warnings).To(ConsistOf(ContainSubstring("the certificate of TLS secret solo.io.expiring expires on")))
+			})
+
+			It("should not warn on certificates expiring after the warning window", func() {
+				settings.Gloo = &v1.GlooOptions{CertificateExpiryWarningWindow: prototime.DurationToProto(time.Minute)}
+				prepSsl([]*v1.SslConfig{
+					{
+						SslSecrets: &v1.SslConfig_SecretRef{
+							SecretRef: &core.ResourceRef{
+								Name:      "expiring",
+								Namespace: "solo.io",
+							},
+						},
+					},
+				})
+
+				_, _, proxyReport, err := translator.Translate(params, proxy)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(validationutils.GetProxyWarning(proxyReport)).To(BeEmpty())
+			})
+		})
 	})
 
 	It("Should report an error for virtual services with empty domains", func() {
@@ -3026,6 +3556,450 @@ var _ = Describe("Translator", func() {
 		Expect(clusterSpecifier).NotTo(BeNil())
 	})
 
+	Context("cluster subsystem", func() {
+		var (
+			upstreamPlugin *upstreamPluginMock
+			processed      int
+		)
+		BeforeEach(func() {
+			processed = 0
+			upstreamPlugin = &upstreamPluginMock{
+				ProcessUpstreamFunc: func(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+					processed++
+					return nil
+				},
+			}
+			registeredPlugins = append(registeredPlugins, upstreamPlugin)
+		})
+
+		It("translates the clusters once for proxies translated from the same snapshot", func() {
+			otherProxy := proto.Clone(proxy).(*v1.Proxy)
+			otherProxy.Metadata.Name = "other"
+
+			_, _, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, err = translator.Translate(params, otherProxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(processed).To(Equal(1))
+
+			// change the upstream
+			upstream.CircuitBreakers = &v1.CircuitBreakerConfig{
+				MaxRetries: &wrappers.UInt32Value{Value: 5},
+			}
+			translate()
+			Expect(processed).To(Equal(2))
+			Expect(cluster.GetCircuitBreakers().GetThresholds()[0].GetMaxRetries()).To(MatchProto(&wrappers.UInt32Value{Value: 5}))
+		})
+
+		It("reports upstream errors on every proxy", func() {
+			upstreamPlugin.ProcessUpstreamFunc = func(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+				return eris.New("upstream error")
+			}
+			otherProxy := proto.Clone(proxy).(*v1.Proxy)
+			otherProxy.Metadata.Name = "other"
+
+			for _, p := range []*v1.Proxy{proxy, otherProxy} {
+				_, errs, _, err := translator.Translate(params, p)
+				Expect(err).NotTo(HaveOccurred())
+				_, upstreamReport := errs.Find("*v1.Upstream", upstream.Metadata.Ref())
+				Expect(upstreamReport.Errors).To(MatchError(ContainSubstring("upstream error")))
+				Expect(upstreamReport.Errors.(*multierror.Error).Errors).To(HaveLen(1))
+			}
+		})
+
+		It("reports the configuration warnings of plugins as upstream warnings", func() {
+			upstreamPlugin.ProcessUpstreamFunc = func(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+				return plugins.NewConfigurationWarning("upstream warning")
+			}
+
+			_, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			_, upstreamReport := errs.Find("*v1.Upstream", upstream.Metadata.Ref())
+			Expect(upstreamReport.Errors).NotTo(HaveOccurred())
+			Expect(upstreamReport.Warnings).To(ConsistOf("upstream warning"))
+		})
+	})
+
+	Context("incremental translation", func() {
+		var (
+			otherUpstream  *v1.Upstream
+			secret         *v1.Secret
+			upstreamPlugin *upstreamPluginMock
+			routePlugin    *routePluginMock
+			processedUs    []string
+			processedRoute int
+		)
+		BeforeEach(func() {
+			processedUs = nil
+			processedRoute = 0
+			upstreamPlugin = &upstreamPluginMock{
+				ProcessUpstreamFunc: func(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+					processedUs = append(processedUs, in.GetMetadata().GetName())
+					return nil
+				},
+			}
+			routePlugin = &routePluginMock{
+				ProcessRouteFunc: func(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+					processedRoute++
+					return nil
+				},
+			}
+			registeredPlugins = append(registeredPlugins, upstreamPlugin, routePlugin)
+
+			secret = &v1.Secret{
+				Metadata: &core.Metadata{
+					Name:      "secret",
+					Namespace: "gloo-system",
+				},
+				Kind: &v1.Secret_Tls{
+					Tls: &v1.TlsSecret{},
+				},
+			}
+			otherUpstream = &v1.Upstream{
+				Metadata: &core.Metadata{
+					Name:      "other",
+					Namespace: "gloo-system",
+				},
+				UpstreamType: &v1.Upstream_Static{
+					Static: &v1static.UpstreamSpec{
+						Hosts: []*v1static.Host{{
+							Addr: "other",
+							Port: 8080,
+						}},
+					},
+				},
+				SslConfig: &v1.UpstreamSslConfig{
+					SslSecrets: &v1.UpstreamSslConfig_SecretRef{
+						SecretRef: secret.Metadata.Ref(),
+					},
+				},
+			}
+			params.Snapshot.Upstreams = append(params.Snapshot.Upstreams, otherUpstream)
+			params.Snapshot.Secrets = v1.SecretList{secret}
+		})
+
+		// translates the proxy with the translator under test, and with a new translator that has no prior state,
+		// and expects the results to be the same
+		expectConsistentTranslation := func() {
+			snap, errs, report, err := translator.Translate(params, proxy)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+			pluginRegistryFactory := func(ctx context.Context) plugins.PluginRegistry {
+				return registry.NewPluginRegistry(registeredPlugins)
+			}
+			fullTranslator := NewTranslator(glooutils.NewSslConfigTranslator(), settings, pluginRegistryFactory)
+			fullSnap, fullErrs, fullReport, err := fullTranslator.Translate(params, proxy)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+			for _, typ := range []string{resource.ClusterTypeV3, resource.EndpointTypeV3, resource.RouteTypeV3, resource.ListenerTypeV3} {
+				resources, fullResources := snap.GetResources(typ), fullSnap.GetResources(typ)
+				ExpectWithOffset(1, resources.Items).To(HaveLen(len(fullResources.Items)))
+				for name, res := range fullResources.Items {
+					ExpectWithOffset(1, resources.Items).To(HaveKey(name))
+					ExpectWithOffset(1, resources.Items[name].ResourceProto()).To(MatchProto(res.ResourceProto()))
+				}
+				ExpectWithOffset(1, resources.Version).To(Equal(fullResources.Version))
+			}
+
+			ExpectWithOffset(1, errs).To(HaveLen(len(fullErrs)))
+			for res, fullRpt := range fullErrs {
+				ExpectWithOffset(1, errs).To(HaveKey(res))
+				ExpectWithOffset(1, errs[res].Warnings).To(Equal(fullRpt.Warnings))
+				ExpectWithOffset(1, fmt.Sprint(errs[res].Errors)).To(Equal(fmt.Sprint(fullRpt.Errors)))
+			}
+			ExpectWithOffset(1, report).To(MatchProto(fullReport))
+		}
+
+		It("produces the same results as a full translation as the snapshot changes", func() {
+			expectConsistentTranslation()
+
+			By("adding endpoints")
+			params.Snapshot.Endpoints = append(params.Snapshot.Endpoints, &v1.Endpoint{
+				Upstreams: []*core.ResourceRef{otherUpstream.Metadata.Ref()},
+				Address:   "5.6.7.8",
+				Port:      8080,
+				Metadata: &core.Metadata{
+					Name:      "other-ep",
+					Namespace: "gloo-system",
+				},
+			})
+			expectConsistentTranslation()
+
+			By("removing endpoints")
+			params.Snapshot.Endpoints = params.Snapshot.Endpoints[:1]
+			expectConsistentTranslation()
+
+			By("changing an upstream")
+			upstream.CircuitBreakers = &v1.CircuitBreakerConfig{
+				MaxRetries: &wrappers.UInt32Value{Value: 5},
+			}
+			expectConsistentTranslation()
+
+			By("changing a secret")
+			secret.Kind = &v1.Secret_Tls{
+				Tls: &v1.TlsSecret{RootCa: "ca"},
+			}
+			expectConsistentTranslation()
+
+			By("changing the proxy")
+			proxy.Listeners[0].GetHttpListener().VirtualHosts[0].Domains = []string{"example.com"}
+			expectConsistentTranslation()
+
+			By("removing an upstream the proxy routes to")
+			params.Snapshot.Upstreams = v1.UpstreamList{otherUpstream}
+			expectConsistentTranslation()
+
+			By("replacing the snapshot with an equivalent one")
+			snapCopy := params.Snapshot.Clone()
+			params.Snapshot = &snapCopy
+			expectConsistentTranslation()
+		})
+
+		It("only re-translates the upstreams whose endpoints changed", func() {
+			translate()
+			Expect(processedUs).To(ConsistOf("test", "other"))
+			Expect(processedRoute).NotTo(BeZero())
+
+			processedUs, processedRoute = nil, 0
+			params.Snapshot.Endpoints = append(params.Snapshot.Endpoints, &v1.Endpoint{
+				Upstreams: []*core.ResourceRef{otherUpstream.Metadata.Ref()},
+				Address:   "5.6.7.8",
+				Port:      8080,
+				Metadata: &core.Metadata{
+					Name:      "other-ep",
+					Namespace: "gloo-system",
+				},
+			})
+			translate()
+			Expect(processedUs).To(ConsistOf("other"))
+			// the listeners do not depend on the endpoints
+			Expect(processedRoute).To(BeZero())
+			Expect(endpoints.Items).To(HaveLen(2))
+		})
+
+		It("re-translates the listeners when an upstream changes", func() {
+			translate()
+
+			processedUs, processedRoute = nil, 0
+			upstream.CircuitBreakers = &v1.CircuitBreakerConfig{
+				MaxRetries: &wrappers.UInt32Value{Value: 5},
+			}
+			translate()
+			Expect(processedUs).To(ConsistOf("test"))
+			Expect(processedRoute).NotTo(BeZero())
+		})
+
+		It("re-translates all the upstreams when a secret changes", func() {
+			translate()
+
+			processedUs = nil
+			secret.Kind = &v1.Secret_Tls{
+				Tls: &v1.TlsSecret{RootCa: "ca"},
+			}
+			translate()
+			Expect(processedUs).To(ConsistOf("test", "other"))
+		})
+
+		It("re-translates only the changed proxy", func() {
+			otherProxy := proto.Clone(proxy).(*v1.Proxy)
+			otherProxy.Metadata.Name = "other"
+			params.Snapshot.Proxies = v1.ProxyList{proxy, otherProxy}
+
+			for _, p := range params.Snapshot.Proxies {
+				_, _, _, err := translator.Translate(params, p)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			processedRoute = 0
+			otherProxy.Listeners[0].GetHttpListener().VirtualHosts[0].Domains = []string{"example.com"}
+			for _, p := range params.Snapshot.Proxies {
+				_, _, _, err := translator.Translate(params, p)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			// the routes of the http and hybrid listeners of the other proxy
+			Expect(processedRoute).To(Equal(2))
+		})
+	})
+
+	Context("pruning unreferenced clusters", func() {
+		var (
+			unusedUpstream *v1.Upstream
+		)
+		BeforeEach(func() {
+			settings.Gloo = &v1.GlooOptions{
+				PruneUnreferencedClusters: &wrappers.BoolValue{Value: true},
+			}
+			unusedUpstream = &v1.Upstream{
+				Metadata: &core.Metadata{
+					Name:      "unused",
+					Namespace: "gloo-system",
+				},
+				UpstreamType: &v1.Upstream_Static{
+					Static: &v1static.UpstreamSpec{
+						Hosts: []*v1static.Host{{
+							Addr: "unused",
+							Port: 124,
+						}},
+					},
+				},
+			}
+			params.Snapshot.Upstreams = append(params.Snapshot.Upstreams, unusedUpstream)
+		})
+
+		It("only sends the clusters referenced by the proxy", func() {
+			translate()
+			clusters := snapshot.GetResources(resource.ClusterTypeV3)
+			Expect(clusters.Items).To(HaveKey(UpstreamToClusterName(upstream.Metadata.Ref())))
+			Expect(clusters.Items).NotTo(HaveKey(UpstreamToClusterName(unusedUpstream.Metadata.Ref())))
+		})
+
+		Context("when a route selects its cluster from a header", func() {
+			BeforeEach(func() {
+				routes = append(routes, &v1.Route{
+					Name:     "testRouteClusterHeader",
+					Matchers: []*matchers.Matcher{matcher},
+					Action: &v1.Route_RouteAction{
+						RouteAction: &v1.RouteAction{
+							Destination: &v1.RouteAction_ClusterHeader{
+								ClusterHeader: "test-cluster",
+							},
+						},
+					},
+				})
+			})
+
+			It("sends all the clusters", func() {
+				translate()
+				clusters := snapshot.GetResources(resource.ClusterTypeV3)
+				Expect(clusters.Items).To(HaveKey(UpstreamToClusterName(unusedUpstream.Metadata.Ref())))
+			})
+		})
+	})
+
+	Context("full envoy validation", func() {
+
+		var (
+			envoyDir string
+		)
+
+		// a fake envoy rejecting the configurations that contain "invalid-envoy-config",
+		// and recording each run in a file next to it
+		fakeEnvoy := `#!/bin/sh
+echo run >> "$(dirname "$0")/runs"
+for arg; do
+	if [ "$prev" = "--config-path" ]; then config="$arg"; fi
+	prev="$arg"
+done
+if grep -q invalid-envoy-config "$config"; then
+	echo "rejected invalid-envoy-config"
+	exit 1
+fi
+`
+
+		envoyRuns := func() int {
+			runs, err := os.ReadFile(filepath.Join(envoyDir, "runs"))
+			if os.IsNotExist(err) {
+				return 0
+			}
+			Expect(err).NotTo(HaveOccurred())
+			return strings.Count(string(runs), "run")
+		}
+
+		BeforeEach(func() {
+			var err error
+			envoyDir, err = os.MkdirTemp("", "envoy")
+			Expect(err).NotTo(HaveOccurred())
+			envoyPath := filepath.Join(envoyDir, "envoy")
+			Expect(os.WriteFile(envoyPath, []byte(fakeEnvoy), 0755)).To(Succeed())
+			Expect(os.Setenv("ENVOY_BINARY_PATH", envoyPath)).To(Succeed())
+
+			settings.Gateway = &v1.GatewayOptions{
+				Validation: &v1.GatewayOptions_ValidationOptions{
+					FullEnvoyValidation: &wrappers.BoolValue{Value: true},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("ENVOY_BINARY_PATH")).To(Succeed())
+			Expect(os.RemoveAll(envoyDir)).To(Succeed())
+		})
+
+		It("validates the snapshot with a single envoy run, and caches the result", func() {
+			translate()
+			Expect(envoyRuns()).To(Equal(1))
+
+			translate()
+			Expect(envoyRuns()).To(Equal(1))
+		})
+
+		Context("with a route envoy rejects", func() {
+
+			BeforeEach(func() {
+				routes = append(routes, &v1.Route{
+					Name:     "invalidRoute",
+					Matchers: []*matchers.Matcher{matcher},
+					Action:   routes[0].GetAction(),
+					Options: &v1.RouteOptions{
+						PrefixRewrite: &wrappers.StringValue{Value: "/invalid-envoy-config"},
+					},
+				})
+			})
+
+			It("reports the error on the route, and caches the results", func() {
+				report := translateWithError()
+				// the snapshot, then the virtual host and each of its routes, on both the http and the hybrid listener
+				Expect(envoyRuns()).To(Equal(7))
+				routeReports := report.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetRouteReports()
+				Expect(routeReports[0].GetErrors()).To(BeEmpty())
+				Expect(routeReports[1].GetErrors()).To(HaveLen(1))
+				Expect(routeReports[1].GetErrors()[0].GetType()).To(Equal(validation.RouteReport_Error_ProcessingError))
+				Expect(routeReports[1].GetErrors()[0].GetReason()).To(ContainSubstring("rejected invalid-envoy-config"))
+				Expect(routeReports[1].GetErrors()[0].GetReason()).To(ContainSubstring("virt1-route-1"))
+
+				translateWithError()
+				Expect(envoyRuns()).To(Equal(7))
+			})
+		})
+
+		It("validates the snapshot again when envoy could not be run", func() {
+			envoyPath := filepath.Join(envoyDir, "envoy")
+			Expect(os.Chmod(envoyPath, 0644)).To(Succeed())
+
+			report := translateWithError()
+			for _, listenerReport := range report.GetListenerReports() {
+				Expect(listenerReport.GetErrors()).To(HaveLen(1))
+				Expect(listenerReport.GetErrors()[0].GetReason()).To(ContainSubstring("failed to validate the envoy configuration"))
+			}
+
+			Expect(os.Chmod(envoyPath, 0755)).To(Succeed())
+			translate()
+			Expect(envoyRuns()).To(Equal(1))
+		})
+
+		It("reports the errors caused by a cluster on its upstream", func() {
+			upstream.GetStatic().GetHosts()[0].Addr = "invalid-envoy-config"
+
+			_, errs, report, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report).To(Equal(validationutils.MakeReport(proxy)))
+			_, upstreamReport := errs.Find("*v1.Upstream", upstream.GetMetadata().Ref())
+			Expect(upstreamReport.Errors).To(MatchError(ContainSubstring("rejected invalid-envoy-config")))
+		})
+
+		It("reports the errors caused by a listener on the listener", func() {
+			proxy.GetListeners()[1].BindAddress = "invalid-envoy-config"
+
+			report := translateWithError()
+			Expect(report.GetListenerReports()[0].GetErrors()).To(BeEmpty())
+			Expect(report.GetListenerReports()[2].GetErrors()).To(BeEmpty())
+			listenerErrors := report.GetListenerReports()[1].GetErrors()
+			Expect(listenerErrors).To(HaveLen(1))
+			Expect(listenerErrors[0].GetType()).To(Equal(validation.ListenerReport_Error_ProcessingError))
+			Expect(listenerErrors[0].GetReason()).To(ContainSubstring("rejected invalid-envoy-config"))
+		})
+	})
+
 	Context("IgnoreHealthOnHostRemoval", func() {
 		table.DescribeTable("propagates IgnoreHealthOnHostRemoval to Cluster", func(upstreamValue *wrappers.BoolValue, expectedClusterValue bool) {
 			// Set the value
@@ -3081,6 +4055,22 @@ func (p *routePluginMock) ProcessRoute(params plugins.RouteParams, in *v1.Route,
 	return p.ProcessRouteFunc(params, in, out)
 }
 
+type upstreamPluginMock struct {
+	ProcessUpstreamFunc func(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error
+}
+
+func (u *upstreamPluginMock) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	return u.ProcessUpstreamFunc(params, in, out)
+}
+
+func (u *upstreamPluginMock) Name() string {
+	return "upstream_plugin_mock"
+}
+
+func (u *upstreamPluginMock) Init(params plugins.InitParams) error {
+	return nil
+}
+
 type endpointPluginMock struct {
 	ProcessEndpointFunc func(params plugins.Params, in *v1.Upstream, out *envoy_config_endpoint_v3.ClusterLoadAssignment) error
 }
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+)
+
+// DefaultCertificateExpiryWarningWindow is used when the settings do not set a certificate expiry warning window
+const DefaultCertificateExpiryWarningWindow = 30 * 24 * time.Hour
+
+var NoCertificateInChainError = eris.New("no certificate found in the certificate chain")
+
+// CertificateExpiryWarningWindow returns how long before their certificate expires Gloo warns on TLS secrets
+func CertificateExpiryWarningWindow(settings *v1.Settings) time.Duration {
+	if window := settings.GetGloo().GetCertificateExpiryWarningWindow(); window != nil {
+		return prototime.DurationFromProto(window)
+	}
+	return DefaultCertificateExpiryWarningWindow
+}
+
+// CertificateNotAfter returns the time after which the PEM encoded certificate chain is no longer valid,
+// which is the earliest expiry of the certificates in the chain.
+func CertificateNotAfter(certChain string) (time.Time, error) {
+	var notAfter time.Time
+	rest := []byte(certChain)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if notAfter.IsZero() || cert.NotAfter.Before(notAfter) {
+			notAfter = cert.NotAfter
+		}
+	}
+	if notAfter.IsZero() {
+		return time.Time{}, NoCertificateInChainError
+	}
+	return notAfter, nil
+}
+
+// CertificateExpiryWarning returns a warning if the certificate of the TLS secret has expired or expires within the
+// window, and an empty string otherwise.
+// Secrets that are not TLS secrets or whose certificate cannot be parsed are reported elsewhere, so are not warned on.
+func CertificateExpiryWarning(secret *v1.Secret, window time.Duration, now time.Time) string {
+	notAfter, err := CertificateNotAfter(secret.GetTls().GetCertChain())
+	if err != nil {
+		return ""
+	}
+	ref := secret.GetMetadata().Ref()
+	if !notAfter.After(now) {
+		return fmt.Sprintf("the certificate of TLS secret %v.%v expired on %v",
+			ref.GetNamespace(), ref.GetName(), notAfter.UTC().Format(time.RFC3339))
+	}
+	if notAfter.Before(now.Add(window)) {
+		return fmt.Sprintf("the certificate of TLS secret %v.%v expires on %v, in less than %v",
+			ref.GetNamespace(), ref.GetName(), notAfter.UTC().Format(time.RFC3339), window)
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	gloohelpers "github.com/solo-io/gloo/test/helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+)
+
+var _ = Describe("Certificate expiry", func() {
+
+	var (
+		now    time.Time
+		window = 24 * time.Hour
+	)
+
+	certValidFor := func(validFor time.Duration) string {
+		validFrom := now.Add(-time.Hour)
+		validFor += time.Hour
+		cert, _ := gloohelpers.GetCerts(gloohelpers.Params{
+			Hosts:     "gloo.solo.io",
+			ValidFrom: &validFrom,
+			ValidFor:  &validFor,
+		})
+		return cert
+	}
+
+	tlsSecret := func(certChain string) *v1.Secret {
+		return &v1.Secret{
+			Metadata: &core.Metadata{Name: "tls", Namespace: "gloo-system"},
+			Kind: &v1.Secret_Tls{
+				Tls: &v1.TlsSecret{CertChain: certChain},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		now = time.Now().Truncate(time.Second)
+	})
+
+	It("returns the earliest expiry of the certificate chain", func() {
+		notAfter, err := CertificateNotAfter(certValidFor(2*time.Hour) + certValidFor(time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(notAfter).To(BeTemporally("==", now.Add(time.Hour)))
+	})
+
+	It("errors if the chain has no certificate", func() {
+		_, err := CertificateNotAfter("")
+		Expect(err).To(MatchError(NoCertificateInChainError))
+	})
+
+	It("warns on expired certificates", func() {
+		warning := CertificateExpiryWarning(tlsSecret(certValidFor(-time.Minute)), window, now)
+		Expect(warning).To(ContainSubstring("the certificate of TLS secret gloo-system.tls expired on"))
+	})
+
+	It("warns on certificates expiring within the window", func() {
+		warning := CertificateExpiryWarning(tlsSecret(certValidFor(time.Hour)), window, now)
+		Expect(warning).To(ContainSubstring("the certificate of TLS secret gloo-system.tls expires on"))
+		Expect(warning).To(ContainSubstring("in less than 24h0m0s"))
+	})
+
+	It("does not warn on certificates expiring after the window", func() {
+		Expect(CertificateExpiryWarning(tlsSecret(certValidFor(2*window)), window, now)).To(BeEmpty())
+	})
+
+	It("does not warn on secrets without a certificate", func() {
+		Expect(CertificateExpiryWarning(&v1.Secret{Kind: &v1.Secret_Aws{}}, window, now)).To(BeEmpty())
+	})
+
+	It("uses the warning window of the settings", func() {
+		Expect(CertificateExpiryWarningWindow(&v1.Settings{})).To(Equal(DefaultCertificateExpiryWarningWindow))
+		settings := &v1.Settings{
+			Gloo: &v1.GlooOptions{CertificateExpiryWarningWindow: prototime.DurationToProto(time.Hour)},
+		}
+		Expect(CertificateExpiryWarningWindow(settings)).To(Equal(time.Hour))
+	})
+})
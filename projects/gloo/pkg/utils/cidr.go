@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"fmt"
+	"net"
+
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+)
+
+// ParseCidrRange parses the range into the network it covers.
+// Like envoy, treats an unset prefix length as 0.
+func ParseCidrRange(cidr *v3.CidrRange) (*net.IPNet, error) {
+	_, ipNet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", cidr.GetAddressPrefix(), cidr.GetPrefixLen().GetValue()))
+	return ipNet, err
+}
+
+// CidrRangesWithin returns true if every range of inner falls within one of the ranges of outer.
+// No ranges stand for every address, and ranges that cannot be parsed never fall within another one.
+func CidrRangesWithin(inner, outer []*v3.CidrRange) bool {
+	if len(outer) == 0 {
+		return true
+	}
+	if len(inner) == 0 {
+		return false
+	}
+	var outerNets []*net.IPNet
+	for _, cidr := range outer {
+		if ipNet, err := ParseCidrRange(cidr); err == nil {
+			outerNets = append(outerNets, ipNet)
+		}
+	}
+	for _, cidr := range inner {
+		innerNet, err := ParseCidrRange(cidr)
+		if err != nil || !ipNetWithin(innerNet, outerNets) {
+			return false
+		}
+	}
+	return true
+}
+
+func ipNetWithin(inner *net.IPNet, outer []*net.IPNet) bool {
+	innerOnes, innerBits := inner.Mask.Size()
+	for _, outerNet := range outer {
+		outerOnes, outerBits := outerNet.Mask.Size()
+		if innerBits == outerBits && outerOnes <= innerOnes && outerNet.Contains(inner.IP) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+)
+
+var _ = Describe("CidrRangesWithin", func() {
+
+	cidrs := func(ranges ...string) []*v3.CidrRange {
+		var out []*v3.CidrRange
+		for _, r := range ranges {
+			parts := strings.Split(r, "/")
+			prefixLen, _ := strconv.Atoi(parts[1])
+			out = append(out, &v3.CidrRange{AddressPrefix: parts[0], PrefixLen: &wrappers.UInt32Value{Value: uint32(prefixLen)}})
+		}
+		return out
+	}
+
+	DescribeTable("compares ranges", func(inner, outer []*v3.CidrRange, expected bool) {
+		Expect(CidrRangesWithin(inner, outer)).To(Equal(expected))
+	},
+		Entry("no outer ranges", cidrs("10.0.0.0/8"), nil, true),
+		Entry("no inner ranges", nil, cidrs("10.0.0.0/8"), false),
+		Entry("narrower range", cidrs("10.1.0.0/16"), cidrs("10.0.0.0/8"), true),
+		Entry("equal range", cidrs("10.0.0.0/8"), cidrs("10.0.0.0/8"), true),
+		Entry("broader range", cidrs("10.0.0.0/8"), cidrs("10.1.0.0/16"), false),
+		Entry("disjoint range", cidrs("192.168.0.0/16"), cidrs("10.0.0.0/8"), false),
+		Entry("every range within one of the outer ranges", cidrs("10.1.0.0/16", "192.168.1.0/24"), cidrs("10.0.0.0/8", "192.168.0.0/16"), true),
+		Entry("one range outside the outer ranges", cidrs("10.1.0.0/16", "172.16.0.0/12"), cidrs("10.0.0.0/8", "192.168.0.0/16"), false),
+		Entry("different address families", cidrs("::/0"), cidrs("0.0.0.0/0"), false),
+		Entry("invalid inner range", []*v3.CidrRange{{AddressPrefix: "invalid"}}, cidrs("0.0.0.0/0"), false),
+	)
+})
@@ -71,6 +71,22 @@ func ResourceListToUpstreamList(resourceList sk_resources.ResourceList) gloov1.U
 	return upstreamList
 }
 
+func UpstreamGroupsToResourceList(upstreamGroups []*gloov1.UpstreamGroup) sk_resources.ResourceList {
+	var upstreamGroupList gloov1.UpstreamGroupList
+	for _, upstreamGroup := range upstreamGroups {
+		upstreamGroupList = append(upstreamGroupList, upstreamGroup)
+	}
+	return upstreamGroupList.AsResources()
+}
+
+func ResourceListToUpstreamGroupList(resourceList sk_resources.ResourceList) gloov1.UpstreamGroupList {
+	var upstreamGroupList gloov1.UpstreamGroupList
+	for _, resource := range resourceList {
+		upstreamGroupList = append(upstreamGroupList, resource.(*gloov1.UpstreamGroup))
+	}
+	return upstreamGroupList
+}
+
 func ResourceListToSecretList(resourceList sk_resources.ResourceList) gloov1.SecretList {
 	var secretList gloov1.SecretList
 	for _, resource := range resourceList {
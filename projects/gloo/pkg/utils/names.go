@@ -10,6 +10,12 @@ func RouteConfigName(listener *v1.Listener) string {
 	return listener.GetName() + "-routes"
 }
 
+// SniRouteConfigName returns the name of the RouteConfiguration of the filter chain of the i-th (merged) ssl config
+// of an http listener, whose routes are split by filter chain when some of them match on SNI
+func SniRouteConfigName(listener *v1.Listener, i int) string {
+	return fmt.Sprintf("%s-sni-%d", RouteConfigName(listener), i)
+}
+
 func MatchedRouteConfigName(listener *v1.Listener, matcher *v1.Matcher) string {
 	hybridListener := listener.GetHybridListener()
 	if hybridListener == nil {
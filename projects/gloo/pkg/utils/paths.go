@@ -13,10 +13,21 @@ func PathAsString(matcher *matchers.Matcher) string {
 		return path.Exact
 	case *matchers.Matcher_Regex:
 		return path.Regex
+	case *matchers.Matcher_Grpc:
+		return GrpcPath(path.Grpc)
 	}
 	return ""
 }
 
+// GrpcPath returns the path of the gRPC requests matched by the matcher: the exact path of its method,
+// or the path prefix of its service if no method is set.
+func GrpcPath(matcher *matchers.GrpcMatcher) string {
+	if matcher.GetMethod() == "" {
+		return "/" + matcher.GetService() + "/"
+	}
+	return "/" + matcher.GetService() + "/" + matcher.GetMethod()
+}
+
 func EnvoyPathAsString(matcher *envoy_config_route_v3.RouteMatch) string {
 	switch path := matcher.GetPathSpecifier().(type) {
 	case *envoy_config_route_v3.RouteMatch_Prefix:
@@ -74,6 +74,12 @@ func pathTypePriority(m *matchers.Matcher) int {
 		return pathPriorityRegex
 	case *matchers.Matcher_Prefix:
 		return pathPriorityPrefix
+	case *matchers.Matcher_Grpc:
+		// a grpc matcher matches the exact path of its method, or the path prefix of its service
+		if m.GetGrpc().GetMethod() != "" {
+			return pathPriorityExact
+		}
+		return pathPriorityPrefix
 	default:
 		return pathPriorityEmpty
 	}
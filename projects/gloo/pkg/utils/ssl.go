@@ -47,12 +47,22 @@ type SslConfigTranslator interface {
 }
 
 type sslConfigTranslator struct {
+	// whether the TLS secrets referenced by secret_ref are served by gloo over SDS rather than inlined
+	glooSds bool
 }
 
 func NewSslConfigTranslator() *sslConfigTranslator {
 	return &sslConfigTranslator{}
 }
 
+// NewSslConfigTranslatorForSettings returns a translator delivering the TLS secrets referenced by secret_ref
+// the way the settings configure.
+func NewSslConfigTranslatorForSettings(settings *v1.Settings) *sslConfigTranslator {
+	return &sslConfigTranslator{
+		glooSds: settings.GetGloo().GetTlsSecretDelivery() == v1.GlooOptions_SDS,
+	}
+}
+
 func (s *sslConfigTranslator) ResolveUpstreamSslConfig(secrets v1.SecretList, uc *v1.UpstreamSslConfig) (*envoyauth.UpstreamTlsContext, error) {
 	common, err := s.ResolveCommonSslConfig(uc, secrets, false)
 	if err != nil {
@@ -205,6 +215,69 @@ func buildDeprecatedSDS(name string, sslSecrets *v1.SDSConfig) *envoyauth.SdsSec
 	}
 }
 
+// GlooSdsCertificateName is the name under which gloo serves the certificate chain and private key of a TLS secret over SDS.
+// The names of secrets cannot contain slashes, so the names gloo serves secrets under are unique.
+func GlooSdsCertificateName(ref *core.ResourceRef) string {
+	return ref.GetNamespace() + "/" + ref.GetName()
+}
+
+// GlooSdsRootCaName is the name under which gloo serves the root CA of a TLS secret over SDS.
+func GlooSdsRootCaName(ref *core.ResourceRef) string {
+	return GlooSdsCertificateName(ref) + "/root-ca"
+}
+
+// IsGlooSds returns whether the secret is served by gloo over SDS, on the ADS stream Envoy gets the rest of its
+// configuration from.
+func IsGlooSds(sdsConfig *envoyauth.SdsSecretConfig) bool {
+	return sdsConfig.GetSdsConfig().GetAds() != nil
+}
+
+func buildGlooSds(name string) *envoyauth.SdsSecretConfig {
+	return &envoyauth.SdsSecretConfig{
+		Name: name,
+		SdsConfig: &envoycore.ConfigSource{
+			ResourceApiVersion: envoycore.ApiVersion_V3,
+			ConfigSourceSpecifier: &envoycore.ConfigSource_Ads{
+				Ads: &envoycore.AggregatedConfigSource{},
+			},
+		},
+	}
+}
+
+// handleGlooSds references the certificate and root CA of a TLS secret served by gloo over SDS.
+// The contents of the secret are only used to validate it, and to know which of its parts to reference.
+func (s *sslConfigTranslator) handleGlooSds(ref *core.ResourceRef, certChain, privateKey, rootCa string, matchSan []*envoymatcher.StringMatcher) (*envoyauth.CommonTlsContext, error) {
+	tlsContext := &envoyauth.CommonTlsContext{
+		// default params
+		TlsParams: &envoyauth.TlsParameters{},
+	}
+
+	if certChain != "" && privateKey != "" {
+		tlsContext.TlsCertificateSdsSecretConfigs = []*envoyauth.SdsSecretConfig{buildGlooSds(GlooSdsCertificateName(ref))}
+	} else if certChain != "" || privateKey != "" {
+		return nil, eris.Errorf("both or none of cert chain and private key must be provided")
+	}
+
+	if rootCa != "" {
+		if len(matchSan) == 0 {
+			tlsContext.ValidationContextType = &envoyauth.CommonTlsContext_ValidationContextSdsSecretConfig{
+				ValidationContextSdsSecretConfig: buildGlooSds(GlooSdsRootCaName(ref)),
+			}
+		} else {
+			tlsContext.ValidationContextType = &envoyauth.CommonTlsContext_CombinedValidationContext{
+				CombinedValidationContext: &envoyauth.CommonTlsContext_CombinedCertificateValidationContext{
+					DefaultValidationContext:         &envoyauth.CertificateValidationContext{MatchSubjectAltNames: matchSan},
+					ValidationContextSdsSecretConfig: buildGlooSds(GlooSdsRootCaName(ref)),
+				},
+			}
+		}
+	} else if len(matchSan) != 0 {
+		return nil, RootCaMustBeProvidedError
+	}
+
+	return tlsContext, nil
+}
+
 func (s *sslConfigTranslator) handleSds(sslSecrets *v1.SDSConfig, matchSan []*envoymatcher.StringMatcher) (*envoyauth.CommonTlsContext, error) {
 	if sslSecrets.GetCertificatesSecretName() == "" && sslSecrets.GetValidationContextName() == "" {
 		return nil, eris.Errorf("at least one of certificates_secret_name or validation_context_name must be provided")
@@ -242,8 +315,9 @@ func (s *sslConfigTranslator) handleSds(sslSecrets *v1.SDSConfig, matchSan []*en
 func (s *sslConfigTranslator) ResolveCommonSslConfig(cs CertSource, secrets v1.SecretList, mustHaveCert bool) (*envoyauth.CommonTlsContext, error) {
 	var (
 		certChain, privateKey, rootCa string
-		// if using a Secret ref, we will inline the certs in the tls config
+		// if using a Secret ref, we will inline the certs in the tls config, unless gloo serves them over SDS
 		inlineDataSource bool
+		glooSdsRef       *core.ResourceRef
 	)
 
 	if sslSecrets := cs.GetSecretRef(); sslSecrets != nil {
@@ -254,6 +328,9 @@ func (s *sslConfigTranslator) ResolveCommonSslConfig(cs CertSource, secrets v1.S
 		if err != nil {
 			return nil, err
 		}
+		if s.glooSds {
+			glooSdsRef = ref
+		}
 	} else if sslSecrets := cs.GetSslFiles(); sslSecrets != nil {
 		certChain, privateKey, rootCa = sslSecrets.GetTlsCert(), sslSecrets.GetTlsKey(), sslSecrets.GetRootCa()
 	} else if sslSecrets := cs.GetSds(); sslSecrets != nil {
@@ -275,6 +352,16 @@ func (s *sslConfigTranslator) ResolveCommonSslConfig(cs CertSource, secrets v1.S
 		}
 	}
 
+	if glooSdsRef != nil {
+		tlsContext, err := s.handleGlooSds(glooSdsRef, certChain, privateKey, rootCa, verifySanListToMatchSanList(cs.GetVerifySubjectAltName()))
+		if err != nil {
+			return nil, err
+		}
+		tlsContext.TlsParams, err = s.ResolveSslParamsConfig(cs.GetParameters())
+		tlsContext.AlpnProtocols = cs.GetAlpnProtocols()
+		return tlsContext, err
+	}
+
 	dataSource := dataSourceGenerator(inlineDataSource)
 
 	var certChainData, privateKeyData, rootCaData *envoycore.DataSource
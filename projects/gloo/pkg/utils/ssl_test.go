@@ -291,6 +291,61 @@ var _ = Describe("Ssl", func() {
 			})
 		})
 
+		Context("served by gloo over sds", func() {
+
+			var glooSds = func(name string) *envoyauth.SdsSecretConfig {
+				return &envoyauth.SdsSecretConfig{
+					Name: name,
+					SdsConfig: &envoycore.ConfigSource{
+						ResourceApiVersion:    envoycore.ApiVersion_V3,
+						ConfigSourceSpecifier: &envoycore.ConfigSource_Ads{Ads: &envoycore.AggregatedConfigSource{}},
+					},
+				}
+			}
+
+			BeforeEach(func() {
+				configTranslator = NewSslConfigTranslatorForSettings(&v1.Settings{
+					Gloo: &v1.GlooOptions{TlsSecretDelivery: v1.GlooOptions_SDS},
+				})
+			})
+
+			It("should reference the certificate and root ca served by gloo", func() {
+				c, err := configTranslator.ResolveCommonSslConfig(downstreamCfg, secrets, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(c.TlsCertificates).To(BeEmpty())
+				Expect(c.TlsCertificateSdsSecretConfigs).To(HaveLen(1))
+				Expect(c.TlsCertificateSdsSecretConfigs[0]).To(test_matchers.MatchProto(glooSds("secret/secret")))
+				Expect(c.GetValidationContextSdsSecretConfig()).To(test_matchers.MatchProto(glooSds("secret/secret/root-ca")))
+				Expect(IsGlooSds(c.TlsCertificateSdsSecretConfigs[0])).To(BeTrue())
+			})
+
+			It("should not reference the root ca if the secret has none", func() {
+				tlsSecret.RootCa = ""
+				c, err := configTranslator.ResolveCommonSslConfig(upstreamCfg, secrets, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(c.TlsCertificateSdsSecretConfigs).To(HaveLen(1))
+				Expect(c.ValidationContextType).To(BeNil())
+			})
+
+			It("should add SAN verification when provided", func() {
+				upstreamCfg.VerifySubjectAltName = []string{"test"}
+				c, err := configTranslator.ResolveCommonSslConfig(upstreamCfg, secrets, false)
+				Expect(err).NotTo(HaveOccurred())
+				combined := c.GetCombinedValidationContext()
+				Expect(combined.GetDefaultValidationContext().GetMatchSubjectAltNames()).To(Equal(verifySanListToMatchSanList(upstreamCfg.VerifySubjectAltName)))
+				Expect(combined.GetValidationContextSdsSecretConfig()).To(test_matchers.MatchProto(glooSds("secret/secret/root-ca")))
+			})
+
+			It("should still validate the secret", func() {
+				tlsSecret.PrivateKey = ""
+				_, err := configTranslator.ResolveCommonSslConfig(downstreamCfg, secrets, true)
+				Expect(err).To(Equal(NoCertificateFoundError))
+
+				_, err = configTranslator.ResolveCommonSslConfig(downstreamCfg, nil, true)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
 	})
 
 	Context("sds", func() {
@@ -128,6 +128,14 @@ func GetVirtualHostErr(virtualHost *validation.VirtualHostReport) []error {
 	return errs
 }
 
+func GetVirtualHostWarning(virtualHost *validation.VirtualHostReport) []string {
+	var warnings []string
+	for _, warning := range virtualHost.GetWarnings() {
+		warnings = append(warnings, fmt.Sprintf("VirtualHost Warning: %v. Reason: %v", warning.GetType().String(), warning.GetReason()))
+	}
+	return warnings
+}
+
 func GetRouteErr(route *validation.RouteReport) []error {
 	var errs []error
 	for _, errReport := range route.GetErrors() {
@@ -240,6 +248,7 @@ func GetProxyWarning(proxyRpt *validation.ProxyReport) []string {
 	for _, listenerReport := range proxyRpt.GetListenerReports() {
 		vhostReports := utils.GetVhostReportsFromListenerReport(listenerReport)
 		for _, vhReport := range vhostReports {
+			warnings = append(warnings, GetVirtualHostWarning(vhReport)...)
 			for _, routeReport := range vhReport.GetRouteReports() {
 				if warns := GetRouteWarning(routeReport); len(warns) > 0 {
 					warnings = append(warnings, warns...)
@@ -265,6 +274,13 @@ func AppendVirtualHostError(virtualHostReport *validation.VirtualHostReport, err
 	})
 }
 
+func AppendVirtualHostWarning(virtualHostReport *validation.VirtualHostReport, warningType validation.VirtualHostReport_Warning_Type, reason string) {
+	virtualHostReport.Warnings = append(virtualHostReport.GetWarnings(), &validation.VirtualHostReport_Warning{
+		Type:   warningType,
+		Reason: reason,
+	})
+}
+
 func AppendHTTPListenerError(httpListenerReport *validation.HttpListenerReport, errType validation.HttpListenerReport_Error_Type, reason string) {
 	httpListenerReport.Errors = append(httpListenerReport.GetErrors(), &validation.HttpListenerReport_Error{
 		Type:   errType,
@@ -211,10 +211,16 @@ func (s *validator) Validate(ctx context.Context, req *validation.GlooValidation
 // updates the given snapshot with the resources from the request
 func applyRequestToSnapshot(snap *v1snap.ApiSnapshot, req *validation.GlooValidationServiceRequest) {
 	if req.GetModifiedResources() != nil {
+		// Upstreams
 		existingUpstreams := snap.Upstreams.AsResources()
 		modifiedUpstreams := utils.UpstreamsToResourceList(req.GetModifiedResources().GetUpstreams())
 		mergedUpstreams := utils.MergeResourceLists(existingUpstreams, modifiedUpstreams)
 		snap.Upstreams = utils.ResourceListToUpstreamList(mergedUpstreams)
+		// Upstream Groups
+		existingUpstreamGroups := snap.UpstreamGroups.AsResources()
+		modifiedUpstreamGroups := utils.UpstreamGroupsToResourceList(req.GetModifiedResources().GetUpstreamGroups())
+		mergedUpstreamGroups := utils.MergeResourceLists(existingUpstreamGroups, modifiedUpstreamGroups)
+		snap.UpstreamGroups = utils.ResourceListToUpstreamGroupList(mergedUpstreamGroups)
 	} else if req.GetDeletedResources() != nil {
 		// Upstreams
 		existingUpstreams := snap.Upstreams.AsResources()
@@ -226,11 +232,16 @@ func applyRequestToSnapshot(snap *v1snap.ApiSnapshot, req *validation.GlooValida
 		deletedSecretRefs := req.GetDeletedResources().GetSecretRefs()
 		finalSecrets := utils.DeleteResources(existingSecrets, deletedSecretRefs)
 		snap.Secrets = utils.ResourceListToSecretList(finalSecrets)
+		// Upstream Groups
+		existingUpstreamGroups := snap.UpstreamGroups.AsResources()
+		deletedUpstreamGroupRefs := req.GetDeletedResources().GetUpstreamGroupRefs()
+		finalUpstreamGroups := utils.DeleteResources(existingUpstreamGroups, deletedUpstreamGroupRefs)
+		snap.UpstreamGroups = utils.ResourceListToUpstreamGroupList(finalUpstreamGroups)
 	}
 }
 
 func convertToValidationReport(proxyReport *validation.ProxyReport, resourceReports reporter.ResourceReports, proxy *v1.Proxy) *validation.ValidationReport {
-	var upstreamReports []*validation.ResourceReport
+	var upstreamReports, upstreamGroupReports []*validation.ResourceReport
 
 	for resource, report := range resourceReports {
 		switch sk_resources.Kind(resource) {
@@ -240,14 +251,21 @@ func convertToValidationReport(proxyReport *validation.ProxyReport, resourceRepo
 				Warnings:    report.Warnings,
 				Errors:      getErrors(report.Errors),
 			})
+		case "*v1.UpstreamGroup":
+			upstreamGroupReports = append(upstreamGroupReports, &validation.ResourceReport{
+				ResourceRef: resource.GetMetadata().Ref(),
+				Warnings:    report.Warnings,
+				Errors:      getErrors(report.Errors),
+			})
 		}
 		// TODO add other resources types here
 	}
 
 	return &validation.ValidationReport{
-		ProxyReport:     proxyReport,
-		UpstreamReports: upstreamReports,
-		Proxy:           proxy,
+		ProxyReport:          proxyReport,
+		UpstreamReports:      upstreamReports,
+		Proxy:                proxy,
+		UpstreamGroupReports: upstreamGroupReports,
 	}
 }
 
@@ -233,6 +233,72 @@ var _ = Describe("Validation Server", func() {
 			Expect(warnings).To(HaveLen(2))
 			Expect(errors).To(HaveOccurred())
 		})
+		It("upstream group validation fails", func() {
+			// an upstream group with a destination that does not exist should be rejected
+			s := NewValidator(context.TODO(), translator, xdsSanitizer)
+			_ = s.Sync(context.TODO(), params.Snapshot)
+			resp, err := s.Validate(context.TODO(), &validationgrpc.GlooValidationServiceRequest{
+				Resources: &validationgrpc.GlooValidationServiceRequest_ModifiedResources{
+					ModifiedResources: &validationgrpc.ModifiedResources{
+						UpstreamGroups: []*v1.UpstreamGroup{
+							{
+								Metadata: &core.Metadata{Name: "ug", Namespace: "gloo-system"},
+								Destinations: []*v1.WeightedDestination{{
+									Destination: &v1.Destination{
+										DestinationType: &v1.Destination_Upstream{
+											Upstream: &core.ResourceRef{Name: "missing", Namespace: "gloo-system"},
+										},
+									},
+									Weight: 1,
+								}},
+							},
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.ValidationReports).To(HaveLen(1))
+
+			upstreamGroupReports := resp.ValidationReports[0].GetUpstreamGroupReports()
+			Expect(upstreamGroupReports).To(HaveLen(1))
+			Expect(upstreamGroupReports[0].GetResourceRef().GetName()).To(Equal("ug"))
+			Expect(upstreamGroupReports[0].GetErrors()).To(ConsistOf(ContainSubstring("upstream not found")))
+		})
+		It("upstream group deletion validation fails", func() {
+			// trying to delete an upstream group that is being referenced by a proxy should cause an error
+			ugRef := &core.ResourceRef{Name: "ug", Namespace: "gloo-system"}
+			params.Snapshot.UpstreamGroups = v1.UpstreamGroupList{{
+				Metadata: &core.Metadata{Name: ugRef.GetName(), Namespace: ugRef.GetNamespace()},
+				Destinations: []*v1.WeightedDestination{{
+					Destination: &v1.Destination{
+						DestinationType: &v1.Destination_Upstream{
+							Upstream: samples.SimpleUpstream().GetMetadata().Ref(),
+						},
+					},
+					Weight: 1,
+				}},
+			}}
+			proxy := params.Snapshot.Proxies[0]
+			proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes()[0].Action = &v1.Route_RouteAction{
+				RouteAction: &v1.RouteAction{
+					Destination: &v1.RouteAction_UpstreamGroup{UpstreamGroup: ugRef},
+				},
+			}
+
+			s := NewValidator(context.TODO(), translator, xdsSanitizer)
+			_ = s.Sync(context.TODO(), params.Snapshot)
+			resp, err := s.Validate(context.TODO(), &validationgrpc.GlooValidationServiceRequest{
+				Resources: &validationgrpc.GlooValidationServiceRequest_DeletedResources{
+					DeletedResources: &validationgrpc.DeletedResources{
+						UpstreamGroupRefs: []*core.ResourceRef{ugRef},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.ValidationReports).To(HaveLen(1))
+			proxyReport := resp.ValidationReports[0].GetProxyReport()
+			Expect(validation.GetProxyWarning(proxyReport)).To(ContainElement(ContainSubstring("*v1.UpstreamGroup { gloo-system.ug } not found")))
+		})
 		It("secret deletion validation succeeds", func() {
 			// deleting a secret that is not being used should succeed
 			s := NewValidator(context.TODO(), translator, xdsSanitizer)
@@ -0,0 +1,97 @@
+package xds
+
+import (
+	"context"
+
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
+)
+
+// DeltaCallbacks are the counterpart of the State of the World server callbacks for delta xDS streams.
+// The type URL of the requests is always set, and so is their node.
+type DeltaCallbacks interface {
+	// OnDeltaStreamOpen is called once a delta xDS stream is open with a stream ID and the type URL (or "" for ADS).
+	OnDeltaStreamOpen(ctx context.Context, streamID int64, typeURL string)
+	// OnDeltaStreamClosed is called immediately prior to closing a delta xDS stream with a stream ID.
+	OnDeltaStreamClosed(streamID int64)
+	// OnDeltaStreamRequest is called once a request is received on a stream.
+	OnDeltaStreamRequest(streamID int64, req *envoy_service_discovery_v3.DeltaDiscoveryRequest)
+	// OnDeltaStreamResponse is called immediately prior to sending a response on a stream.
+	OnDeltaStreamResponse(streamID int64, resp *envoy_service_discovery_v3.DeltaDiscoveryResponse)
+}
+
+// MultiCallbacks calls each of its (non-nil) callbacks in order.
+// The first error returned by a callback ends the processing of the stream or request.
+type MultiCallbacks []server.Callbacks
+
+var _ server.Callbacks = MultiCallbacks{}
+
+func (m MultiCallbacks) OnStreamOpen(ctx context.Context, streamID int64, typeURL string) error {
+	for _, callbacks := range m {
+		if callbacks == nil {
+			continue
+		}
+		if err := callbacks.OnStreamOpen(ctx, streamID, typeURL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m MultiCallbacks) OnStreamClosed(streamID int64) {
+	for _, callbacks := range m {
+		if callbacks == nil {
+			continue
+		}
+		callbacks.OnStreamClosed(streamID)
+	}
+}
+
+func (m MultiCallbacks) OnStreamRequest(streamID int64, req *envoy_service_discovery_v3.DiscoveryRequest) error {
+	for _, callbacks := range m {
+		if callbacks == nil {
+			continue
+		}
+		if err := callbacks.OnStreamRequest(streamID, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m MultiCallbacks) OnStreamResponse(
+	streamID int64,
+	req *envoy_service_discovery_v3.DiscoveryRequest,
+	resp *envoy_service_discovery_v3.DiscoveryResponse,
+) {
+	for _, callbacks := range m {
+		if callbacks == nil {
+			continue
+		}
+		callbacks.OnStreamResponse(streamID, req, resp)
+	}
+}
+
+func (m MultiCallbacks) OnFetchRequest(ctx context.Context, req *envoy_service_discovery_v3.DiscoveryRequest) error {
+	for _, callbacks := range m {
+		if callbacks == nil {
+			continue
+		}
+		if err := callbacks.OnFetchRequest(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m MultiCallbacks) OnFetchResponse(
+	req *envoy_service_discovery_v3.DiscoveryRequest,
+	resp *envoy_service_discovery_v3.DiscoveryResponse,
+) {
+	for _, callbacks := range m {
+		if callbacks == nil {
+			continue
+		}
+		callbacks.OnFetchResponse(req, resp)
+	}
+}
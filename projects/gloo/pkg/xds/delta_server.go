@@ -0,0 +1,333 @@
+package xds
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"sync/atomic"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/solo-io/go-utils/contextutils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	proto2 "google.golang.org/protobuf/proto"
+)
+
+// wildcardResourceName is used by clients to explicitly subscribe to all the resources of a type
+const wildcardResourceName = "*"
+
+type DeltaStream interface {
+	Send(*envoy_service_discovery_v3.DeltaDiscoveryResponse) error
+	Recv() (*envoy_service_discovery_v3.DeltaDiscoveryRequest, error)
+	grpc.ServerStream
+}
+
+// DeltaServer serves the incremental (delta) variant of the xDS protocol from the same snapshots as the
+// State of the World server. Envoy selects the variant to use per node, with the api_type of its bootstrap config.
+// Rather than the whole set of resources of a type, only the resources that changed since the last response
+// (according to their own version) and the names of the removed resources are sent.
+type DeltaServer interface {
+	StreamDelta(stream DeltaStream, defaultTypeURL string) error
+}
+
+type deltaServer struct {
+	cache     envoycache.Cache
+	callbacks DeltaCallbacks
+
+	// streamCount for counting bi-di streams
+	streamCount int64
+}
+
+// NewDeltaServer creates a delta xDS server from a config watcher and optional callbacks.
+func NewDeltaServer(config envoycache.Cache, callbacks DeltaCallbacks) DeltaServer {
+	return &deltaServer{cache: config, callbacks: callbacks}
+}
+
+// the state of a single resource type on a delta stream
+type deltaTypeState struct {
+	// whether the client subscribed to all the resources of the type
+	wildcard bool
+	// the names of the resources the client explicitly subscribed to
+	subscribed map[string]bool
+	// the versions of the resources the client has, by name
+	known map[string]string
+
+	// the latest resources of the type in the snapshot, and their version
+	latest        map[string]envoycache.Resource
+	latestVersion string
+	// whether a response has been sent for the type
+	responded bool
+
+	cancelWatch func()
+}
+
+type deltaWatchResponse struct {
+	typeURL  string
+	response *envoycache.Response
+}
+
+func (s *deltaServer) StreamDelta(stream DeltaStream, defaultTypeURL string) error {
+	// a channel for receiving incoming requests
+	reqCh := make(chan *envoy_service_discovery_v3.DeltaDiscoveryRequest)
+	reqStop := int32(0)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if atomic.LoadInt32(&reqStop) != 0 {
+				return
+			}
+			if err != nil {
+				close(reqCh)
+				return
+			}
+			reqCh <- req
+		}
+	}()
+
+	err := s.process(stream, reqCh, defaultTypeURL)
+
+	// prevents writing to a closed channel if send failed on blocked recv
+	atomic.StoreInt32(&reqStop, 1)
+
+	return err
+}
+
+func (s *deltaServer) process(
+	stream DeltaStream,
+	reqCh <-chan *envoy_service_discovery_v3.DeltaDiscoveryRequest,
+	defaultTypeURL string,
+) error {
+	ctx := stream.Context()
+	streamID := atomic.AddInt64(&s.streamCount, 1)
+	logger := contextutils.LoggerFrom(ctx).With("delta_stream", streamID)
+	if s.callbacks != nil {
+		s.callbacks.OnDeltaStreamOpen(ctx, streamID, defaultTypeURL)
+		defer s.callbacks.OnDeltaStreamClosed(streamID)
+	}
+
+	// unique nonce generator for responses on the stream
+	var streamNonce int64
+	states := make(map[string]*deltaTypeState)
+	responses := make(chan deltaWatchResponse)
+	done := make(chan struct{})
+	defer func() {
+		close(done)
+		for _, state := range states {
+			state.cancelWatch()
+		}
+	}()
+
+	// node may only be set on the first discovery request
+	node := &envoy_config_core_v3.Node{}
+
+	watch := func(typeURL, version string) func() {
+		value, cancel := s.cache.CreateWatch(envoycache.Request{
+			Node:        node,
+			TypeUrl:     typeURL,
+			VersionInfo: version,
+		})
+		go func() {
+			select {
+			case <-done:
+			case response, ok := <-value:
+				if !ok {
+					return
+				}
+				select {
+				case <-done:
+				case responses <- deltaWatchResponse{typeURL: typeURL, response: &response}:
+				}
+			}
+		}()
+		return func() {
+			if cancel != nil {
+				cancel()
+			}
+		}
+	}
+
+	send := func(typeURL string, state *deltaTypeState) error {
+		out, err := state.diff(typeURL)
+		if err != nil {
+			return err
+		}
+		if state.responded && len(out.GetResources()) == 0 && len(out.GetRemovedResources()) == 0 {
+			return nil
+		}
+		streamNonce++
+		out.Nonce = strconv.FormatInt(streamNonce, 10)
+		state.responded = true
+		logger.Debugf("sending %d resources and %d removed resources of type %s, version %s",
+			len(out.GetResources()), len(out.GetRemovedResources()), typeURL, out.GetSystemVersionInfo())
+		if s.callbacks != nil {
+			s.callbacks.OnDeltaStreamResponse(streamID, out)
+		}
+		return stream.Send(out)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case resp := <-responses:
+			state := states[resp.typeURL]
+			if state == nil {
+				continue
+			}
+			state.latest = make(map[string]envoycache.Resource, len(resp.response.Resources))
+			for _, res := range resp.response.Resources {
+				state.latest[res.Self().Name] = res
+			}
+			state.latestVersion = resp.response.Version
+			// watch for the next version of the resources
+			state.cancelWatch()
+			state.cancelWatch = watch(resp.typeURL, resp.response.Version)
+			if err := send(resp.typeURL, state); err != nil {
+				return err
+			}
+
+		case req, more := <-reqCh:
+			// input stream ended or errored out
+			if !more {
+				return nil
+			}
+			if req == nil {
+				return status.Errorf(codes.Unavailable, "empty request")
+			}
+
+			// node field in discovery request is delta-compressed
+			if req.GetNode() != nil {
+				node = req.GetNode()
+			} else {
+				req.Node = node
+			}
+
+			// type URL is required for ADS but is implicit for xDS
+			typeURL := req.GetTypeUrl()
+			if typeURL == "" {
+				if defaultTypeURL == resource.AnyType {
+					return status.Errorf(codes.InvalidArgument, "type URL is required for ADS")
+				}
+				typeURL = defaultTypeURL
+				req.TypeUrl = typeURL
+			}
+			if s.callbacks != nil {
+				s.callbacks.OnDeltaStreamRequest(streamID, req)
+			}
+
+			if errorDetail := req.GetErrorDetail(); errorDetail != nil {
+				logger.Warnf("envoy rejected resources of type %s sent with nonce %s: %s",
+					typeURL, req.GetResponseNonce(), errorDetail.GetMessage())
+			}
+
+			state, ok := states[typeURL]
+			if !ok {
+				state = newDeltaTypeState(req)
+				states[typeURL] = state
+				state.cancelWatch = watch(typeURL, "")
+				continue
+			}
+			if state.update(req) && state.latest != nil {
+				if err := send(typeURL, state); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func newDeltaTypeState(req *envoy_service_discovery_v3.DeltaDiscoveryRequest) *deltaTypeState {
+	state := &deltaTypeState{
+		// a first request without any resource names subscribes to all the resources
+		wildcard:   len(req.GetResourceNamesSubscribe()) == 0,
+		subscribed: make(map[string]bool),
+		known:      make(map[string]string),
+	}
+	for name, version := range req.GetInitialResourceVersions() {
+		state.known[name] = version
+	}
+	state.update(req)
+	return state
+}
+
+// update applies the subscription changes in the request, and returns whether there are new subscriptions
+func (s *deltaTypeState) update(req *envoy_service_discovery_v3.DeltaDiscoveryRequest) bool {
+	subscribed := false
+	for _, name := range req.GetResourceNamesSubscribe() {
+		if name == wildcardResourceName {
+			subscribed = subscribed || !s.wildcard
+			s.wildcard = true
+			continue
+		}
+		subscribed = subscribed || !s.subscribed[name]
+		s.subscribed[name] = true
+	}
+	for _, name := range req.GetResourceNamesUnsubscribe() {
+		if name == wildcardResourceName {
+			s.wildcard = false
+			continue
+		}
+		delete(s.subscribed, name)
+		// the client drops the resources it unsubscribes from, so they must be sent again if it re-subscribes
+		delete(s.known, name)
+	}
+	return subscribed
+}
+
+func (s *deltaTypeState) isSubscribed(name string) bool {
+	return s.wildcard || s.subscribed[name]
+}
+
+// diff returns a response with the subscribed resources that the client does not have the latest version of,
+// and the resources the client has that were removed, and records them as known by the client
+func (s *deltaTypeState) diff(typeURL string) (*envoy_service_discovery_v3.DeltaDiscoveryResponse, error) {
+	out := &envoy_service_discovery_v3.DeltaDiscoveryResponse{
+		SystemVersionInfo: s.latestVersion,
+		TypeUrl:           typeURL,
+	}
+	for name, res := range s.latest {
+		if !s.isSubscribed(name) {
+			continue
+		}
+		data, err := proto2.MarshalOptions{Deterministic: true}.Marshal(proto.MessageV2(res.ResourceProto()))
+		if err != nil {
+			return nil, err
+		}
+		version := resourceVersion(data)
+		if s.known[name] == version {
+			continue
+		}
+		s.known[name] = version
+		out.Resources = append(out.GetResources(), &envoy_service_discovery_v3.Resource{
+			Name:    name,
+			Version: version,
+			Resource: &any.Any{
+				TypeUrl: typeURL,
+				Value:   data,
+			},
+		})
+	}
+	for name := range s.known {
+		if _, ok := s.latest[name]; ok {
+			continue
+		}
+		delete(s.known, name)
+		if s.isSubscribed(name) {
+			out.RemovedResources = append(out.GetRemovedResources(), name)
+		}
+	}
+	return out, nil
+}
+
+func resourceVersion(data []byte) string {
+	hasher := fnv.New64()
+	_, _ = hasher.Write(data)
+	return fmt.Sprintf("%x", hasher.Sum64())
+}
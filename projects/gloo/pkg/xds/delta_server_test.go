@@ -0,0 +1,237 @@
+package xds_test
+
+import (
+	"context"
+	"io"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/struct"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"google.golang.org/grpc"
+)
+
+var _ = Describe("DeltaServer", func() {
+
+	const nodeKey = "gloo-system~gateway-proxy"
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		snapshotCache envoycache.SnapshotCache
+		deltaServer   xds.DeltaServer
+		node          *envoy_config_core_v3.Node
+
+		streamErrs []chan error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		snapshotCache = envoycache.NewSnapshotCache(true, xds.NewNodeHasher(), nil)
+		deltaServer = xds.NewDeltaServer(snapshotCache, nil)
+		streamErrs = nil
+		node = &envoy_config_core_v3.Node{
+			Id: "envoy",
+			Metadata: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"role": {Kind: &structpb.Value_StringValue{StringValue: nodeKey}},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+		// wait for the streams to end, so that they do not outlive the test
+		for _, errs := range streamErrs {
+			Eventually(errs, time.Second).Should(Receive(BeNil()))
+		}
+	})
+
+	setSnapshot := func(version string, clusters []*envoy_config_cluster_v3.Cluster, endpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment) {
+		var clusterResources, endpointResources []envoycache.Resource
+		for _, cluster := range clusters {
+			clusterResources = append(clusterResources, resource.NewEnvoyResource(cluster))
+		}
+		for _, cla := range endpoints {
+			endpointResources = append(endpointResources, resource.NewEnvoyResource(cla))
+		}
+		err := snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshotFromResources(
+			envoycache.NewResources(version, endpointResources),
+			envoycache.NewResources(version, clusterResources),
+			envoycache.NewResources(version, nil),
+			envoycache.NewResources(version, nil),
+			envoycache.NewResources(version, nil),
+		))
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	startStream := func(typeURL string) *fakeDeltaStream {
+		stream := newFakeDeltaStream(ctx)
+		errs := make(chan error, 1)
+		streamErrs = append(streamErrs, errs)
+		go func() {
+			errs <- deltaServer.StreamDelta(stream, typeURL)
+		}()
+		return stream
+	}
+
+	cluster := func(name string, connectTimeout int64) *envoy_config_cluster_v3.Cluster {
+		return &envoy_config_cluster_v3.Cluster{
+			Name:           name,
+			ConnectTimeout: &duration.Duration{Seconds: connectTimeout},
+		}
+	}
+
+	cla := func(name string) *envoy_config_endpoint_v3.ClusterLoadAssignment {
+		return &envoy_config_endpoint_v3.ClusterLoadAssignment{ClusterName: name}
+	}
+
+	resourceNames := func(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) []string {
+		var names []string
+		for _, res := range resp.GetResources() {
+			names = append(names, res.GetName())
+		}
+		return names
+	}
+
+	It("sends all the resources of a type to wildcard subscribers", func() {
+		setSnapshot("1", []*envoy_config_cluster_v3.Cluster{cluster("a", 1), cluster("b", 1)}, nil)
+
+		stream := startStream(resource.AnyType)
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:    node,
+			TypeUrl: resource.ClusterTypeV3,
+		}
+
+		resp := stream.receive()
+		Expect(resp.GetTypeUrl()).To(Equal(resource.ClusterTypeV3))
+		Expect(resp.GetSystemVersionInfo()).To(Equal("1"))
+		Expect(resourceNames(resp)).To(ConsistOf("a", "b"))
+		Expect(resp.GetRemovedResources()).To(BeEmpty())
+		for _, res := range resp.GetResources() {
+			Expect(res.GetVersion()).NotTo(BeEmpty())
+		}
+	})
+
+	It("only sends the resources that changed, and the names of the removed resources", func() {
+		setSnapshot("1", []*envoy_config_cluster_v3.Cluster{cluster("a", 1), cluster("b", 1), cluster("c", 1)}, nil)
+
+		stream := startStream(resource.AnyType)
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:    node,
+			TypeUrl: resource.ClusterTypeV3,
+		}
+		Expect(resourceNames(stream.receive())).To(ConsistOf("a", "b", "c"))
+
+		// a changed, b is unchanged and c was removed
+		setSnapshot("2", []*envoy_config_cluster_v3.Cluster{cluster("a", 2), cluster("b", 1)}, nil)
+
+		resp := stream.receive()
+		Expect(resp.GetSystemVersionInfo()).To(Equal("2"))
+		Expect(resourceNames(resp)).To(ConsistOf("a"))
+		Expect(resp.GetRemovedResources()).To(ConsistOf("c"))
+
+		// a new snapshot version with the same resources sends nothing
+		setSnapshot("3", []*envoy_config_cluster_v3.Cluster{cluster("a", 2), cluster("b", 1)}, nil)
+		Consistently(stream.responses, 100*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("only sends the resources explicitly subscribed to", func() {
+		setSnapshot("1", nil, []*envoy_config_endpoint_v3.ClusterLoadAssignment{cla("x"), cla("y")})
+
+		stream := startStream(resource.EndpointTypeV3)
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:                   node,
+			ResourceNamesSubscribe: []string{"x"},
+		}
+		resp := stream.receive()
+		Expect(resp.GetTypeUrl()).To(Equal(resource.EndpointTypeV3))
+		Expect(resourceNames(resp)).To(ConsistOf("x"))
+
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			ResponseNonce:          resp.GetNonce(),
+			ResourceNamesSubscribe: []string{"y"},
+		}
+		Expect(resourceNames(stream.receive())).To(ConsistOf("y"))
+
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			ResourceNamesUnsubscribe: []string{"x"},
+		}
+		// requests are processed in order, so the unsubscribe is applied once the next request is received
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{}
+		// removing a resource that is no longer subscribed to is not sent
+		setSnapshot("2", nil, []*envoy_config_endpoint_v3.ClusterLoadAssignment{cla("y")})
+		Consistently(stream.responses, 100*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("does not resend the resources the client already has", func() {
+		setSnapshot("1", []*envoy_config_cluster_v3.Cluster{cluster("a", 1), cluster("b", 1)}, nil)
+
+		stream := startStream(resource.ClusterTypeV3)
+		stream.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{Node: node}
+		initialVersions := map[string]string{}
+		for _, res := range stream.receive().GetResources() {
+			initialVersions[res.GetName()] = res.GetVersion()
+		}
+
+		// the client reconnects after b changed
+		setSnapshot("2", []*envoy_config_cluster_v3.Cluster{cluster("a", 1), cluster("b", 2)}, nil)
+		reconnected := startStream(resource.ClusterTypeV3)
+		reconnected.requests <- &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:                    node,
+			InitialResourceVersions: initialVersions,
+		}
+		resp := reconnected.receive()
+		Expect(resp.GetSystemVersionInfo()).To(Equal("2"))
+		Expect(resourceNames(resp)).To(ConsistOf("b"))
+	})
+})
+
+type fakeDeltaStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	requests  chan *envoy_service_discovery_v3.DeltaDiscoveryRequest
+	responses chan *envoy_service_discovery_v3.DeltaDiscoveryResponse
+}
+
+func newFakeDeltaStream(ctx context.Context) *fakeDeltaStream {
+	return &fakeDeltaStream{
+		ctx:       ctx,
+		requests:  make(chan *envoy_service_discovery_v3.DeltaDiscoveryRequest),
+		responses: make(chan *envoy_service_discovery_v3.DeltaDiscoveryResponse, 10),
+	}
+}
+
+func (s *fakeDeltaStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeDeltaStream) Send(resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) error {
+	s.responses <- resp
+	return nil
+}
+
+func (s *fakeDeltaStream) Recv() (*envoy_service_discovery_v3.DeltaDiscoveryRequest, error) {
+	select {
+	case <-s.ctx.Done():
+		return nil, io.EOF
+	case req := <-s.requests:
+		return req, nil
+	}
+}
+
+func (s *fakeDeltaStream) receive() *envoy_service_discovery_v3.DeltaDiscoveryResponse {
+	var resp *envoy_service_discovery_v3.DeltaDiscoveryResponse
+	EventuallyWithOffset(1, s.responses, time.Second).Should(Receive(&resp))
+	return resp
+}
@@ -72,7 +72,12 @@ func GetValidKeys(proxies v1.ProxyList, extensionKeys map[string]struct{}) []str
 }
 
 // register xDS methods with GRPC server
-func SetupEnvoyXds(grpcServer *grpc.Server, xdsServer envoyserver.Server, envoyCache envoycache.SnapshotCache) {
+func SetupEnvoyXds(
+	grpcServer *grpc.Server,
+	xdsServer envoyserver.Server,
+	envoyCache envoycache.SnapshotCache,
+	deltaCallbacks DeltaCallbacks,
+) {
 
 	// check if we need to register
 	if _, ok := grpcServer.GetServiceInfo()["solo.io.xds.SoloDiscoveryService"]; ok {
@@ -85,7 +90,8 @@ func SetupEnvoyXds(grpcServer *grpc.Server, xdsServer envoyserver.Server, envoyC
 	glooServer := NewGlooXdsServer(xdsServer)
 	solo_xds.RegisterSoloDiscoveryServiceServer(grpcServer, glooServer)
 
-	envoyServer := NewEnvoyServerV3(xdsServer)
+	// envoy nodes may use either the State of the World or the incremental variant of the xDS protocol
+	envoyServer := NewEnvoyServerV3(xdsServer, NewDeltaServer(envoyCache, deltaCallbacks))
 	envoy_service_endpoint_v3.RegisterEndpointDiscoveryServiceServer(grpcServer, envoyServer)
 	envoy_service_cluster_v3.RegisterClusterDiscoveryServiceServer(grpcServer, envoyServer)
 	envoy_service_route_v3.RegisterRouteDiscoveryServiceServer(grpcServer, envoyServer)
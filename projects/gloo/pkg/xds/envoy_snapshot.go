@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/golang/protobuf/proto"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
@@ -38,6 +39,9 @@ type EnvoySnapshot struct {
 
 	// Listeners are items in the LDS response payload.
 	Listeners cache.Resources
+
+	// Secrets are items in the SDS response payload, indexed by the names the listeners and clusters reference them by.
+	Secrets cache.Resources
 }
 
 var _ cache.Snapshot = &EnvoySnapshot{}
@@ -64,6 +68,7 @@ func NewSnapshotFromResources(
 	clusters cache.Resources,
 	routes cache.Resources,
 	listeners cache.Resources,
+	secrets cache.Resources,
 ) cache.Snapshot {
 	// TODO: Copy resources and downgrade, maybe maintain hash to not do it too many times (https://github.com/solo-io/gloo/issues/4421)
 	return &EnvoySnapshot{
@@ -71,6 +76,7 @@ func NewSnapshotFromResources(
 		Clusters:  clusters,
 		Routes:    routes,
 		Listeners: listeners,
+		Secrets:   secrets,
 	}
 }
 
@@ -125,6 +131,8 @@ func (s *EnvoySnapshot) GetResources(typ string) cache.Resources {
 		return s.Routes
 	case resource.ListenerTypeV3:
 		return s.Listeners
+	case resource.SecretTypeV3:
+		return s.Secrets
 	}
 	return cache.Resources{}
 }
@@ -152,6 +160,11 @@ func (s *EnvoySnapshot) Clone() cache.Snapshot {
 		Items:   cloneItems(s.Listeners.Items),
 	}
 
+	snapshotClone.Secrets = cache.Resources{
+		Version: s.Secrets.Version,
+		Items:   cloneItems(s.Secrets.Items),
+	}
+
 	return snapshotClone
 }
 
@@ -160,6 +173,10 @@ func cloneItems(items map[string]cache.Resource) map[string]cache.Resource {
 	for k, v := range items {
 		resProto := v.ResourceProto()
 		resClone := proto.Clone(resProto)
+		if secret, ok := resClone.(*envoy_extensions_transport_sockets_tls_v3.Secret); ok {
+			clonedItems[k] = NewSecretResource(secret)
+			continue
+		}
 		clonedItems[k] = resource.NewEnvoyResource(resClone)
 	}
 	return clonedItems
@@ -215,5 +232,17 @@ func (this *EnvoySnapshot) Equal(that *EnvoySnapshot) bool {
 			return false
 		}
 	}
+	if len(this.Secrets.Items) != len(that.Secrets.Items) || this.Secrets.Version != that.Secrets.Version {
+		return false
+	}
+	for key, thisVal := range this.Secrets.Items {
+		thatVal, ok := that.Secrets.Items[key]
+		if !ok {
+			return false
+		}
+		if !proto.Equal(thisVal.ResourceProto(), thatVal.ResourceProto()) {
+			return false
+		}
+	}
 	return true
 }
@@ -5,6 +5,7 @@ import (
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
@@ -46,4 +47,25 @@ var _ = Describe("EnvoySnapshot", func() {
 		Expect(toBeCloned.Equal(clone.(*xds.EnvoySnapshot))).NotTo(BeTrue())
 		Expect(toBeCloned.Equal(untouched)).To(BeTrue())
 	})
+
+	It("clones secrets by name", func() {
+		secret := &envoy_extensions_transport_sockets_tls_v3.Secret{Name: "gloo-system/secret"}
+		toBeCloned := xds.NewSnapshotFromResources(
+			cache.Resources{},
+			cache.Resources{},
+			cache.Resources{},
+			cache.Resources{},
+			cache.NewResources("1", []cache.Resource{xds.NewSecretResource(secret)}),
+		)
+
+		clone := toBeCloned.Clone()
+
+		secrets := clone.GetResources(resource.SecretTypeV3)
+		Expect(secrets.Version).To(Equal("1"))
+		Expect(secrets.Items).To(HaveKey("gloo-system/secret"))
+		clonedSecret := secrets.Items["gloo-system/secret"]
+		Expect(clonedSecret.Self()).To(Equal(cache.XdsResourceReference{Name: "gloo-system/secret", Type: resource.SecretTypeV3}))
+		Expect(clonedSecret.ResourceProto()).NotTo(BeIdenticalTo(secret))
+		Expect(toBeCloned.(*xds.EnvoySnapshot).Equal(clone.(*xds.EnvoySnapshot))).To(BeTrue())
+	})
 })
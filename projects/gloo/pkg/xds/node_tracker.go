@@ -0,0 +1,301 @@
+package xds
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
+	rpc_status "google.golang.org/genproto/googleapis/rpc/status"
+)
+
+// NodeStatus is the state of an Envoy node connected to the xDS server.
+type NodeStatus struct {
+	// The ID of the node, from the Envoy bootstrap config
+	ID string `json:"id"`
+	// The cluster of the node, from the Envoy bootstrap config
+	Cluster string `json:"cluster,omitempty"`
+	// The key of the xDS snapshot served to the node
+	SnapshotKey string `json:"snapshotKey"`
+	// Whether the node uses the incremental (delta) variant of the xDS protocol
+	Delta bool `json:"delta,omitempty"`
+	// When the node opened its first xDS stream
+	ConnectedAt time.Time `json:"connectedAt"`
+	// The status of each resource type requested by the node, by type URL
+	Resources map[string]*ResourceTypeStatus `json:"resources,omitempty"`
+}
+
+// ResourceTypeStatus tracks whether a node accepted the resources of a type sent to it.
+type ResourceTypeStatus struct {
+	// The version of the latest response sent to the node
+	SentVersion string `json:"sentVersion,omitempty"`
+	// The version of the latest response the node acknowledged, and when
+	AckedVersion string     `json:"ackedVersion,omitempty"`
+	AckedAt      *time.Time `json:"ackedAt,omitempty"`
+	// The version of the latest response the node rejected, when and why
+	NackedVersion string     `json:"nackedVersion,omitempty"`
+	NackedAt      *time.Time `json:"nackedAt,omitempty"`
+	NackError     string     `json:"nackError,omitempty"`
+
+	// the nonce of the latest response sent to the node, as only responses to it are (n)acks of SentVersion
+	sentNonce string
+}
+
+// NodeTracker records the Envoy nodes connected to the xDS server, and whether they accepted the resources
+// sent to them. It is used as the callbacks of both the State of the World and the delta xDS servers.
+type NodeTracker struct {
+	hasher *ProxyKeyHasher
+
+	lock     sync.RWMutex
+	streams  map[trackedStreamKey]*trackedStream
+	listener AckListener
+}
+
+// AckListener is notified when an Envoy node acknowledges or rejects the resources sent to it.
+// It is called from the goroutines of the xDS streams, so it must be safe for concurrent use.
+type AckListener interface {
+	// OnAck is called with the versions the node acknowledged on all its streams, by type URL.
+	// The versions are nil if the node has yet to acknowledge some responses.
+	OnAck(snapshotKey string, ackedVersions map[string]string)
+	// OnNack is called when the node rejects a version of the resources of a type
+	OnNack(snapshotKey, nodeID, typeURL, version, reason string)
+}
+
+var _ server.Callbacks = &NodeTracker{}
+var _ DeltaCallbacks = &NodeTracker{}
+
+// stream IDs are only unique per xDS server
+type trackedStreamKey struct {
+	delta bool
+	id    int64
+}
+
+type trackedStream struct {
+	node     *envoy_config_core_v3.Node
+	openedAt time.Time
+	types    map[string]*ResourceTypeStatus
+}
+
+func NewNodeTracker() *NodeTracker {
+	return &NodeTracker{
+		hasher:  NewNodeHasher(),
+		streams: make(map[trackedStreamKey]*trackedStream),
+	}
+}
+
+// SetAckListener sets the listener notified of the acks and nacks of the nodes, replacing the previous one.
+// A nil listener is not notified.
+func (t *NodeTracker) SetAckListener(listener AckListener) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.listener = listener
+}
+
+// Nodes returns the status of the connected nodes, sorted by snapshot key and ID.
+// The streams of a node that does not use ADS are merged into a single status.
+func (t *NodeTracker) Nodes() []*NodeStatus {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	nodes := make(map[string]*NodeStatus)
+	for key, stream := range t.streams {
+		// the node is only known once it sent its first request
+		if stream.node == nil {
+			continue
+		}
+		node, ok := nodes[stream.node.GetId()]
+		if !ok {
+			node = &NodeStatus{
+				ID:          stream.node.GetId(),
+				Cluster:     stream.node.GetCluster(),
+				SnapshotKey: t.hasher.ID(stream.node),
+				Delta:       key.delta,
+				ConnectedAt: stream.openedAt,
+				Resources:   make(map[string]*ResourceTypeStatus),
+			}
+			nodes[node.ID] = node
+		}
+		if stream.openedAt.Before(node.ConnectedAt) {
+			node.ConnectedAt = stream.openedAt
+		}
+		for typeURL, status := range stream.types {
+			statusCopy := *status
+			node.Resources[typeURL] = &statusCopy
+		}
+	}
+
+	result := make([]*NodeStatus, 0, len(nodes))
+	for _, node := range nodes {
+		result = append(result, node)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].SnapshotKey != result[j].SnapshotKey {
+			return result[i].SnapshotKey < result[j].SnapshotKey
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
+
+func (t *NodeTracker) openStream(key trackedStreamKey) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.streams[key] = &trackedStream{
+		openedAt: time.Now(),
+		types:    make(map[string]*ResourceTypeStatus),
+	}
+}
+
+func (t *NodeTracker) closeStream(key trackedStreamKey) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.streams, key)
+}
+
+// onRequest records the node of the stream, and whether the request (n)acks the latest response sent on it
+func (t *NodeTracker) onRequest(
+	key trackedStreamKey,
+	node *envoy_config_core_v3.Node,
+	typeURL, responseNonce string,
+	errorDetail *rpc_status.Status,
+) {
+	// the listener is notified once the lock is released, as it may take a while
+	if notify := t.recordRequest(key, node, typeURL, responseNonce, errorDetail); notify != nil {
+		notify()
+	}
+}
+
+func (t *NodeTracker) recordRequest(
+	key trackedStreamKey,
+	node *envoy_config_core_v3.Node,
+	typeURL, responseNonce string,
+	errorDetail *rpc_status.Status,
+) func() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	stream, ok := t.streams[key]
+	if !ok {
+		return nil
+	}
+	if node != nil {
+		stream.node = node
+	}
+	status := stream.status(typeURL)
+	if responseNonce == "" || responseNonce != status.sentNonce {
+		// an initial request, or a stale one
+		return nil
+	}
+	now := time.Now()
+	listener := t.listener
+	snapshotKey := t.hasher.ID(stream.node)
+	if errorDetail != nil {
+		status.NackedVersion = status.SentVersion
+		status.NackedAt = &now
+		status.NackError = errorDetail.GetMessage()
+		if listener == nil {
+			return nil
+		}
+		nodeID, version, reason := stream.node.GetId(), status.NackedVersion, status.NackError
+		return func() {
+			listener.OnNack(snapshotKey, nodeID, typeURL, version, reason)
+		}
+	}
+	status.AckedVersion = status.SentVersion
+	status.AckedAt = &now
+	if listener == nil {
+		return nil
+	}
+	ackedVersions := t.ackedVersions(stream.node.GetId())
+	return func() {
+		listener.OnAck(snapshotKey, ackedVersions)
+	}
+}
+
+// ackedVersions returns the versions acked by a node on all its streams, by type URL,
+// or nil if a response sent to the node is yet to be acked. The lock must be held.
+func (t *NodeTracker) ackedVersions(nodeID string) map[string]string {
+	versions := make(map[string]string)
+	for _, stream := range t.streams {
+		if stream.node.GetId() != nodeID {
+			continue
+		}
+		for typeURL, status := range stream.types {
+			if status.SentVersion != status.AckedVersion {
+				return nil
+			}
+			if status.AckedVersion != "" {
+				versions[typeURL] = status.AckedVersion
+			}
+		}
+	}
+	return versions
+}
+
+func (t *NodeTracker) onResponse(key trackedStreamKey, typeURL, version, nonce string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	stream, ok := t.streams[key]
+	if !ok {
+		return
+	}
+	status := stream.status(typeURL)
+	status.SentVersion = version
+	status.sentNonce = nonce
+}
+
+func (s *trackedStream) status(typeURL string) *ResourceTypeStatus {
+	status, ok := s.types[typeURL]
+	if !ok {
+		status = &ResourceTypeStatus{}
+		s.types[typeURL] = status
+	}
+	return status
+}
+
+func (t *NodeTracker) OnStreamOpen(_ context.Context, streamID int64, _ string) error {
+	t.openStream(trackedStreamKey{id: streamID})
+	return nil
+}
+
+func (t *NodeTracker) OnStreamClosed(streamID int64) {
+	t.closeStream(trackedStreamKey{id: streamID})
+}
+
+func (t *NodeTracker) OnStreamRequest(streamID int64, req *envoy_service_discovery_v3.DiscoveryRequest) error {
+	t.onRequest(trackedStreamKey{id: streamID}, req.GetNode(), req.GetTypeUrl(), req.GetResponseNonce(), req.GetErrorDetail())
+	return nil
+}
+
+func (t *NodeTracker) OnStreamResponse(
+	streamID int64,
+	_ *envoy_service_discovery_v3.DiscoveryRequest,
+	resp *envoy_service_discovery_v3.DiscoveryResponse,
+) {
+	t.onResponse(trackedStreamKey{id: streamID}, resp.GetTypeUrl(), resp.GetVersionInfo(), resp.GetNonce())
+}
+
+func (t *NodeTracker) OnFetchRequest(context.Context, *envoy_service_discovery_v3.DiscoveryRequest) error {
+	return nil
+}
+
+func (t *NodeTracker) OnFetchResponse(*envoy_service_discovery_v3.DiscoveryRequest, *envoy_service_discovery_v3.DiscoveryResponse) {
+}
+
+func (t *NodeTracker) OnDeltaStreamOpen(_ context.Context, streamID int64, _ string) {
+	t.openStream(trackedStreamKey{delta: true, id: streamID})
+}
+
+func (t *NodeTracker) OnDeltaStreamClosed(streamID int64) {
+	t.closeStream(trackedStreamKey{delta: true, id: streamID})
+}
+
+func (t *NodeTracker) OnDeltaStreamRequest(streamID int64, req *envoy_service_discovery_v3.DeltaDiscoveryRequest) {
+	t.onRequest(trackedStreamKey{delta: true, id: streamID}, req.GetNode(), req.GetTypeUrl(), req.GetResponseNonce(), req.GetErrorDetail())
+}
+
+func (t *NodeTracker) OnDeltaStreamResponse(streamID int64, resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) {
+	t.onResponse(trackedStreamKey{delta: true, id: streamID}, resp.GetTypeUrl(), resp.GetSystemVersionInfo(), resp.GetNonce())
+}
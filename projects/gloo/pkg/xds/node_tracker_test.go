@@ -0,0 +1,143 @@
+package xds_test
+
+import (
+	"context"
+	"fmt"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	rpc_status "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ = Describe("NodeTracker", func() {
+
+	var (
+		tracker *xds.NodeTracker
+		node    *envoy_config_core_v3.Node
+	)
+
+	BeforeEach(func() {
+		tracker = xds.NewNodeTracker()
+		node = &envoy_config_core_v3.Node{
+			Id:      "envoy",
+			Cluster: "gateway-proxy",
+			Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"role": structpb.NewStringValue("gloo-system~gateway-proxy"),
+			}},
+		}
+	})
+
+	request := func(nonce string, errorDetail *rpc_status.Status) {
+		err := tracker.OnStreamRequest(1, &envoy_service_discovery_v3.DiscoveryRequest{
+			Node:          node,
+			TypeUrl:       resource.ClusterTypeV3,
+			ResponseNonce: nonce,
+			ErrorDetail:   errorDetail,
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	respond := func(version, nonce string) {
+		tracker.OnStreamResponse(1, nil, &envoy_service_discovery_v3.DiscoveryResponse{
+			TypeUrl:     resource.ClusterTypeV3,
+			VersionInfo: version,
+			Nonce:       nonce,
+		})
+	}
+
+	clusterStatus := func() *xds.ResourceTypeStatus {
+		nodes := tracker.Nodes()
+		ExpectWithOffset(1, nodes).To(HaveLen(1))
+		return nodes[0].Resources[resource.ClusterTypeV3]
+	}
+
+	It("tracks connected nodes", func() {
+		Expect(tracker.OnStreamOpen(context.Background(), 1, "")).NotTo(HaveOccurred())
+		Expect(tracker.Nodes()).To(BeEmpty())
+
+		request("", nil)
+		nodes := tracker.Nodes()
+		Expect(nodes).To(HaveLen(1))
+		Expect(nodes[0].ID).To(Equal("envoy"))
+		Expect(nodes[0].Cluster).To(Equal("gateway-proxy"))
+		Expect(nodes[0].SnapshotKey).To(Equal("gloo-system~gateway-proxy"))
+		Expect(nodes[0].Delta).To(BeFalse())
+
+		tracker.OnStreamClosed(1)
+		Expect(tracker.Nodes()).To(BeEmpty())
+	})
+
+	It("tracks acks and nacks", func() {
+		Expect(tracker.OnStreamOpen(context.Background(), 1, "")).NotTo(HaveOccurred())
+		request("", nil)
+		respond("1", "a")
+		Expect(clusterStatus().SentVersion).To(Equal("1"))
+		Expect(clusterStatus().AckedVersion).To(BeEmpty())
+
+		request("a", nil)
+		Expect(clusterStatus().AckedVersion).To(Equal("1"))
+		Expect(clusterStatus().AckedAt).NotTo(BeNil())
+
+		respond("2", "b")
+		// a request for a previous response is not a nack of the latest one
+		request("a", &rpc_status.Status{Message: "stale"})
+		Expect(clusterStatus().NackedVersion).To(BeEmpty())
+
+		request("b", &rpc_status.Status{Message: "invalid cluster"})
+		status := clusterStatus()
+		Expect(status.SentVersion).To(Equal("2"))
+		Expect(status.AckedVersion).To(Equal("1"))
+		Expect(status.NackedVersion).To(Equal("2"))
+		Expect(status.NackError).To(Equal("invalid cluster"))
+	})
+
+	It("notifies the ack listener", func() {
+		listener := &recordingAckListener{}
+		tracker.SetAckListener(listener)
+		Expect(tracker.OnStreamOpen(context.Background(), 1, "")).NotTo(HaveOccurred())
+		request("", nil)
+		respond("1", "a")
+		request("a", nil)
+		Expect(listener.acked).To(Equal([]map[string]string{{resource.ClusterTypeV3: "1"}}))
+
+		respond("2", "b")
+		request("b", &rpc_status.Status{Message: "invalid cluster"})
+		Expect(listener.nacked).To(Equal([]string{"gloo-system~gateway-proxy envoy 2: invalid cluster"}))
+	})
+
+	It("tracks delta streams separately", func() {
+		Expect(tracker.OnStreamOpen(context.Background(), 1, "")).NotTo(HaveOccurred())
+		tracker.OnDeltaStreamOpen(context.Background(), 1, "")
+		tracker.OnDeltaStreamRequest(1, &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node:    &envoy_config_core_v3.Node{Id: "delta-envoy"},
+			TypeUrl: resource.ListenerTypeV3,
+		})
+		request("", nil)
+
+		nodes := tracker.Nodes()
+		Expect(nodes).To(HaveLen(2))
+		Expect(nodes[0].ID).To(Equal("delta-envoy"))
+		Expect(nodes[0].Delta).To(BeTrue())
+
+		tracker.OnStreamClosed(1)
+		Expect(tracker.Nodes()).To(HaveLen(1))
+	})
+})
+
+type recordingAckListener struct {
+	acked  []map[string]string
+	nacked []string
+}
+
+func (l *recordingAckListener) OnAck(_ string, ackedVersions map[string]string) {
+	l.acked = append(l.acked, ackedVersions)
+}
+
+func (l *recordingAckListener) OnNack(snapshotKey, nodeID, _, version, reason string) {
+	l.nacked = append(l.nacked, fmt.Sprintf("%v %v %v: %v", snapshotKey, nodeID, version, reason))
+}
@@ -0,0 +1,35 @@
+package xds
+
+import (
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+)
+
+// SecretResource is a secret served to Envoy over SDS.
+// The envoy resources of solo-kit only know the names and types of clusters, endpoints, routes and listeners.
+type SecretResource struct {
+	Secret *envoy_extensions_transport_sockets_tls_v3.Secret
+}
+
+var _ cache.Resource = &SecretResource{}
+
+func NewSecretResource(secret *envoy_extensions_transport_sockets_tls_v3.Secret) *SecretResource {
+	return &SecretResource{Secret: secret}
+}
+
+func (r *SecretResource) Self() cache.XdsResourceReference {
+	return cache.XdsResourceReference{
+		Name: r.Secret.GetName(),
+		Type: resource.SecretTypeV3,
+	}
+}
+
+func (r *SecretResource) ResourceProto() cache.ResourceProto {
+	return r.Secret
+}
+
+// secrets do not reference other resources
+func (r *SecretResource) References() []cache.XdsResourceReference {
+	return nil
+}
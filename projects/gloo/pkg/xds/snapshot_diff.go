@@ -0,0 +1,91 @@
+package xds
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+)
+
+// the resource types served to envoy, in the order they are reported.
+// Secrets are left out, so that they are neither reported nor persisted.
+var snapshotTypeURLs = []string{
+	resource.ClusterTypeV3,
+	resource.EndpointTypeV3,
+	resource.RouteTypeV3,
+	resource.ListenerTypeV3,
+}
+
+// the resource types whose versions are tracked to tell whether envoy accepted a snapshot
+var versionedTypeURLs = append([]string{resource.SecretTypeV3}, snapshotTypeURLs...)
+
+// ResourcesDiff lists the names of the resources of a type that differ between two xDS snapshots.
+type ResourcesDiff struct {
+	Added    []string `json:"added,omitempty"`
+	Removed  []string `json:"removed,omitempty"`
+	Modified []string `json:"modified,omitempty"`
+}
+
+// SnapshotDiff lists the differences between two xDS snapshots by type URL.
+// Types with no differences are omitted.
+type SnapshotDiff map[string]*ResourcesDiff
+
+// DiffSnapshots compares the resources of two xDS snapshots, either of which may be nil.
+func DiffSnapshots(from, to envoycache.Snapshot) SnapshotDiff {
+	diff := SnapshotDiff{}
+	for _, typeURL := range snapshotTypeURLs {
+		var fromItems, toItems map[string]envoycache.Resource
+		if from != nil {
+			fromItems = from.GetResources(typeURL).Items
+		}
+		if to != nil {
+			toItems = to.GetResources(typeURL).Items
+		}
+
+		resourcesDiff := &ResourcesDiff{}
+		for name, toResource := range toItems {
+			fromResource, ok := fromItems[name]
+			switch {
+			case !ok:
+				resourcesDiff.Added = append(resourcesDiff.Added, name)
+			case fromResource != toResource && !proto.Equal(fromResource.ResourceProto(), toResource.ResourceProto()):
+				resourcesDiff.Modified = append(resourcesDiff.Modified, name)
+			}
+		}
+		for name := range fromItems {
+			if _, ok := toItems[name]; !ok {
+				resourcesDiff.Removed = append(resourcesDiff.Removed, name)
+			}
+		}
+		if len(resourcesDiff.Added) == 0 && len(resourcesDiff.Removed) == 0 && len(resourcesDiff.Modified) == 0 {
+			continue
+		}
+		sort.Strings(resourcesDiff.Added)
+		sort.Strings(resourcesDiff.Removed)
+		sort.Strings(resourcesDiff.Modified)
+		diff[typeURL] = resourcesDiff
+	}
+	return diff
+}
+
+// CopySnapshotResources returns a snapshot with copies of the resource maps of the given snapshot,
+// so that resources can be added to or removed from either without affecting the other.
+// The resources themselves are shared.
+func CopySnapshotResources(snap envoycache.Snapshot) envoycache.Snapshot {
+	copyResources := func(typeURL string) envoycache.Resources {
+		resources := snap.GetResources(typeURL)
+		items := make(map[string]envoycache.Resource, len(resources.Items))
+		for name, res := range resources.Items {
+			items[name] = res
+		}
+		return envoycache.Resources{Version: resources.Version, Items: items}
+	}
+	return NewSnapshotFromResources(
+		copyResources(resource.EndpointTypeV3),
+		copyResources(resource.ClusterTypeV3),
+		copyResources(resource.RouteTypeV3),
+		copyResources(resource.ListenerTypeV3),
+		copyResources(resource.SecretTypeV3),
+	)
+}
@@ -0,0 +1,62 @@
+package xds_test
+
+import (
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+)
+
+var _ = Describe("DiffSnapshots", func() {
+
+	clusters := func(clusters ...*envoy_config_cluster_v3.Cluster) []cache.Resource {
+		var resources []cache.Resource
+		for _, cluster := range clusters {
+			resources = append(resources, resource.NewEnvoyResource(cluster))
+		}
+		return resources
+	}
+
+	It("lists added, removed and modified resources", func() {
+		from := xds.NewSnapshot("1", nil, clusters(
+			&envoy_config_cluster_v3.Cluster{Name: "removed"},
+			&envoy_config_cluster_v3.Cluster{Name: "modified"},
+			&envoy_config_cluster_v3.Cluster{Name: "unchanged"},
+		), nil, []cache.Resource{resource.NewEnvoyResource(&envoy_config_listener_v3.Listener{Name: "listener"})})
+		to := xds.NewSnapshot("2", nil, clusters(
+			&envoy_config_cluster_v3.Cluster{Name: "added"},
+			&envoy_config_cluster_v3.Cluster{Name: "modified", AltStatName: "changed"},
+			&envoy_config_cluster_v3.Cluster{Name: "unchanged"},
+		), nil, []cache.Resource{resource.NewEnvoyResource(&envoy_config_listener_v3.Listener{Name: "listener"})})
+
+		Expect(xds.DiffSnapshots(from, to)).To(Equal(xds.SnapshotDiff{
+			resource.ClusterTypeV3: {
+				Added:    []string{"added"},
+				Removed:  []string{"removed"},
+				Modified: []string{"modified"},
+			},
+		}))
+	})
+
+	It("handles nil snapshots", func() {
+		to := xds.NewSnapshot("1", nil, clusters(&envoy_config_cluster_v3.Cluster{Name: "cluster"}), nil, nil)
+		Expect(xds.DiffSnapshots(nil, to)).To(Equal(xds.SnapshotDiff{
+			resource.ClusterTypeV3: {Added: []string{"cluster"}},
+		}))
+		Expect(xds.DiffSnapshots(nil, nil)).To(BeEmpty())
+	})
+
+	It("copies the resources of snapshots", func() {
+		snap := xds.NewSnapshot("1", nil, clusters(&envoy_config_cluster_v3.Cluster{Name: "cluster"}), nil, nil)
+		snapCopy := xds.CopySnapshotResources(snap)
+		delete(snap.GetResources(resource.ClusterTypeV3).Items, "cluster")
+
+		Expect(snapCopy.GetResources(resource.ClusterTypeV3).Items).To(HaveKey("cluster"))
+		Expect(xds.DiffSnapshots(snapCopy, snap)).To(Equal(xds.SnapshotDiff{
+			resource.ClusterTypeV3: {Removed: []string{"cluster"}},
+		}))
+	})
+})
@@ -0,0 +1,406 @@
+package xds
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// the extension of the files the acked snapshots are persisted to
+const persistedSnapshotExtension = ".json"
+
+// Rejection describes an xDS snapshot rejected (NACKed) by Envoy.
+type Rejection struct {
+	// The node that rejected the snapshot
+	NodeID string
+	// The type and version of the resources rejected
+	TypeURL string
+	Version string
+	// The names of the resources of that type mentioned in the reason, if any
+	Resources []string
+	// The reason given by Envoy
+	Reason string
+}
+
+func (r *Rejection) Error() string {
+	rejected := r.TypeURL
+	if len(r.Resources) > 0 {
+		rejected = fmt.Sprintf("%v %v", r.TypeURL, strings.Join(r.Resources, ", "))
+	}
+	return fmt.Sprintf("envoy node %v rejected %v (version %v), "+
+		"it is served the last version of these resources it accepted until this proxy changes: %v", r.NodeID, rejected, r.Version, r.Reason)
+}
+
+// RebuildSecrets returns the secrets referenced by the clusters and listeners of a restored snapshot, as secrets are
+// not persisted with the snapshots.
+type RebuildSecrets func(snap envoycache.Snapshot) (envoycache.Resources, error)
+
+// SnapshotRollback keeps the last xDS snapshot acknowledged by Envoy for each proxy, and sets the resources of the
+// rejected type back in the xDS cache when Envoy rejects a newer snapshot. It is the ack listener of the node tracker.
+// The snapshots acknowledged can be persisted to a directory, so that they survive restarts.
+type SnapshotRollback struct {
+	ctx   context.Context
+	cache envoycache.SnapshotCache
+	// the directory the acked snapshots are persisted to, if any
+	persistDir string
+
+	lock       sync.Mutex
+	acked      map[string]envoycache.Snapshot
+	rejections map[string]*Rejection
+
+	// serializes the writes of the persisted snapshots, which happen outside of the lock
+	persistLock sync.Mutex
+}
+
+var _ AckListener = &SnapshotRollback{}
+
+// NewSnapshotRollback returns a rollback for the snapshots of the given cache, persisting them to `persistDir` if not empty.
+func NewSnapshotRollback(ctx context.Context, cache envoycache.SnapshotCache, persistDir string) *SnapshotRollback {
+	return &SnapshotRollback{
+		ctx:        ctx,
+		cache:      cache,
+		persistDir: persistDir,
+		acked:      make(map[string]envoycache.Snapshot),
+		rejections: make(map[string]*Rejection),
+	}
+}
+
+// Restore loads the persisted snapshots, which are set in the xDS cache for the proxies that do not have a snapshot yet,
+// so that the Envoys connecting before the first translation are served the last configuration they accepted.
+// The secrets of the restored snapshots are rebuilt with `rebuildSecrets`, if not nil.
+func (r *SnapshotRollback) Restore(rebuildSecrets RebuildSecrets) error {
+	if r.persistDir == "" {
+		return nil
+	}
+	files, err := ioutil.ReadDir(r.persistDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return eris.Wrapf(err, "reading acked xDS snapshots from %v", r.persistDir)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != persistedSnapshotExtension {
+			continue
+		}
+		key, err := url.PathUnescape(strings.TrimSuffix(file.Name(), persistedSnapshotExtension))
+		if err != nil {
+			continue
+		}
+		snap, err := readSnapshot(filepath.Join(r.persistDir, file.Name()))
+		if err != nil {
+			return err
+		}
+		if rebuildSecrets != nil {
+			secrets, err := rebuildSecrets(snap)
+			if err != nil {
+				return eris.Wrapf(err, "rebuilding the secrets of acked xDS snapshot %v", key)
+			}
+			snap = NewSnapshotFromResources(
+				snap.GetResources(resource.EndpointTypeV3),
+				snap.GetResources(resource.ClusterTypeV3),
+				snap.GetResources(resource.RouteTypeV3),
+				snap.GetResources(resource.ListenerTypeV3),
+				secrets,
+			)
+		}
+		r.acked[key] = snap
+		if _, err := r.cache.GetSnapshot(key); err == nil {
+			continue
+		}
+		if err := r.cache.SetSnapshot(key, snap); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rejected returns why Envoy rejected a snapshot of the proxy, if the given snapshot is the one it rejected.
+// If the snapshot differs from the rejected one, the rejection is forgotten.
+func (r *SnapshotRollback) Rejected(key string, snap envoycache.Snapshot) *Rejection {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	rejection, ok := r.rejections[key]
+	if !ok {
+		return nil
+	}
+	if snap.GetResources(rejection.TypeURL).Version == rejection.Version {
+		return rejection
+	}
+	delete(r.rejections, key)
+	return nil
+}
+
+// WithAckedResources returns the snapshot with the resources of the rejected type set back to those of the last
+// snapshot Envoy acked, so that the changes to the other types still reach Envoy while the rejection is pending.
+// It returns nil if no snapshot was acked.
+func (r *SnapshotRollback) WithAckedResources(key string, snap envoycache.Snapshot, rejection *Rejection) envoycache.Snapshot {
+	r.lock.Lock()
+	acked := r.acked[key]
+	r.lock.Unlock()
+	if acked == nil {
+		return nil
+	}
+	return withAckedResources(acked, snap, rejection.TypeURL)
+}
+
+// Acked returns the last snapshot Envoy acked for the key, or nil if none was acked
+func (r *SnapshotRollback) Acked(key string) envoycache.Snapshot {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.acked[key]
+}
+
+// withAckedResources keeps the clusters consistent with their endpoints, and the listeners with their routes:
+// the acked clusters and listeners are served the latest version of the endpoints and routes they reference, and
+// rejected endpoints and routes are served with the clusters and listeners acked along with them.
+func withAckedResources(acked, snap envoycache.Snapshot, rejectedTypeURL string) envoycache.Snapshot {
+	resources := map[string]envoycache.Resources{}
+	for _, typeURL := range versionedTypeURLs {
+		resources[typeURL] = snap.GetResources(typeURL)
+	}
+	for _, types := range [][2]string{
+		{resource.ClusterTypeV3, resource.EndpointTypeV3},
+		{resource.ListenerTypeV3, resource.RouteTypeV3},
+	} {
+		parent, child := types[0], types[1]
+		switch rejectedTypeURL {
+		case parent:
+			resources[parent] = acked.GetResources(parent)
+			resources[child] = referencedResources(acked.GetResources(parent), snap.GetResources(child), acked.GetResources(child))
+		case child:
+			resources[parent] = acked.GetResources(parent)
+			resources[child] = acked.GetResources(child)
+		}
+	}
+	if rejectedTypeURL == resource.SecretTypeV3 {
+		resources[resource.SecretTypeV3] = acked.GetResources(resource.SecretTypeV3)
+	}
+	return NewSnapshotFromResources(
+		resources[resource.EndpointTypeV3],
+		resources[resource.ClusterTypeV3],
+		resources[resource.RouteTypeV3],
+		resources[resource.ListenerTypeV3],
+		resources[resource.SecretTypeV3],
+	)
+}
+
+// referencedResources returns the latest version of the resources referenced by the parents, or their acked version
+// if they no longer exist
+func referencedResources(parents, latest, acked envoycache.Resources) envoycache.Resources {
+	items := map[string]envoycache.Resource{}
+	for name := range resource.GetResourceReferences(parents.Items) {
+		if res, ok := latest.Items[name]; ok {
+			items[name] = res
+		} else if res, ok := acked.Items[name]; ok {
+			items[name] = res
+		}
+	}
+	return envoycache.Resources{
+		Version: fmt.Sprintf("%v-%v", parents.Version, latest.Version),
+		Items:   items,
+	}
+}
+
+func (r *SnapshotRollback) OnAck(snapshotKey string, ackedVersions map[string]string) {
+	current, err := r.cache.GetSnapshot(snapshotKey)
+	if err != nil || len(ackedVersions) == 0 {
+		return
+	}
+	// the snapshot is accepted once every type the node requested was acked at its version
+	for typeURL, version := range ackedVersions {
+		if current.GetResources(typeURL).Version != version {
+			return
+		}
+	}
+
+	r.lock.Lock()
+	previous := r.acked[snapshotKey]
+	r.acked[snapshotKey] = current
+	r.lock.Unlock()
+
+	if r.persistDir == "" || (previous != nil && sameVersions(previous, current)) {
+		return
+	}
+	if err := r.persist(snapshotKey, current); err != nil {
+		contextutils.LoggerFrom(r.ctx).Warnw("failed to persist the acked xDS snapshot",
+			zap.String("key", snapshotKey), zap.Error(err))
+	}
+}
+
+func (r *SnapshotRollback) OnNack(snapshotKey, nodeID, typeURL, version, reason string) {
+	logger := contextutils.LoggerFrom(r.ctx)
+	current, err := r.cache.GetSnapshot(snapshotKey)
+	if err != nil || current.GetResources(typeURL).Version != version {
+		// the rejected snapshot was already replaced
+		return
+	}
+	rejection := &Rejection{
+		NodeID:    nodeID,
+		TypeURL:   typeURL,
+		Version:   version,
+		Resources: mentionedResources(current, typeURL, reason),
+		Reason:    reason,
+	}
+
+	r.lock.Lock()
+	r.rejections[snapshotKey] = rejection
+	acked := r.acked[snapshotKey]
+	r.lock.Unlock()
+
+	logger.Errorw("envoy rejected the xDS snapshot", zap.String("key", snapshotKey), zap.Error(rejection))
+	if acked == nil || acked.GetResources(typeURL).Version == version {
+		logger.Warnw("no snapshot acked by envoy to roll back to", zap.String("key", snapshotKey))
+		return
+	}
+	if err := r.cache.SetSnapshot(snapshotKey, withAckedResources(acked, current, typeURL)); err != nil {
+		logger.Errorw("failed to roll back to the last acked xDS snapshot", zap.String("key", snapshotKey), zap.Error(err))
+		return
+	}
+	logger.Warnw("rolled back to the last xDS snapshot acked by envoy", zap.String("key", snapshotKey))
+}
+
+// the cache returns copies of its snapshots, which are compared by their versions
+func sameVersions(a, b envoycache.Snapshot) bool {
+	for _, typeURL := range versionedTypeURLs {
+		if a.GetResources(typeURL).Version != b.GetResources(typeURL).Version {
+			return false
+		}
+	}
+	return true
+}
+
+// mentionedResources returns the names of the resources of the type that Envoy mentions in the reason of a rejection
+func mentionedResources(snap envoycache.Snapshot, typeURL, reason string) []string {
+	var names []string
+	for name := range snap.GetResources(typeURL).Items {
+		if name != "" && strings.Contains(reason, name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// the format of the persisted snapshots
+type persistedSnapshot struct {
+	// The resources of the snapshot by type URL
+	Resources map[string]*persistedResources `json:"resources"`
+	// The version of the secrets of the snapshot, which may contain TLS private keys so are not persisted
+	SecretsVersion string `json:"secretsVersion,omitempty"`
+}
+
+type persistedResources struct {
+	Version string `json:"version"`
+	// The resources, as serialized Any messages
+	Items [][]byte `json:"items"`
+}
+
+func (r *SnapshotRollback) persist(key string, snap envoycache.Snapshot) error {
+	out := &persistedSnapshot{
+		Resources:      map[string]*persistedResources{},
+		SecretsVersion: snap.GetResources(resource.SecretTypeV3).Version,
+	}
+	for _, typeURL := range snapshotTypeURLs {
+		resources := snap.GetResources(typeURL)
+		persisted := &persistedResources{Version: resources.Version}
+		for _, res := range resources.Items {
+			packed, err := anypb.New(proto.MessageV2(res.ResourceProto()))
+			if err != nil {
+				return err
+			}
+			data, err := proto.Marshal(proto.MessageV1(packed))
+			if err != nil {
+				return err
+			}
+			persisted.Items = append(persisted.Items, data)
+		}
+		out.Resources[typeURL] = persisted
+	}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+
+	r.persistLock.Lock()
+	defer r.persistLock.Unlock()
+	// the snapshots may contain TLS private keys, so are only readable by gloo
+	if err := os.MkdirAll(r.persistDir, 0700); err != nil {
+		return err
+	}
+	// write to a temporary file first, so that a snapshot is never partially written
+	path := filepath.Join(r.persistDir, url.PathEscape(key)+persistedSnapshotExtension)
+	if err := ioutil.WriteFile(path+".tmp", data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+func readSnapshot(path string) (envoycache.Snapshot, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var persisted persistedSnapshot
+	if err := json.Unmarshal(data, &persisted); err != nil {
+		return nil, eris.Wrapf(err, "parsing acked xDS snapshot %v", path)
+	}
+	resources := make(map[string]envoycache.Resources, len(snapshotTypeURLs))
+	for _, typeURL := range snapshotTypeURLs {
+		items := map[string]envoycache.Resource{}
+		persistedItems := persisted.Resources[typeURL]
+		for _, data := range persistedItems.GetItems() {
+			packed := &anypb.Any{}
+			if err := proto.Unmarshal(data, proto.MessageV1(packed)); err != nil {
+				return nil, eris.Wrapf(err, "parsing acked xDS snapshot %v", path)
+			}
+			msg, err := packed.UnmarshalNew()
+			if err != nil {
+				return nil, eris.Wrapf(err, "parsing acked xDS snapshot %v", path)
+			}
+			res := resource.NewEnvoyResource(proto.MessageV1(msg))
+			items[res.Self().Name] = res
+		}
+		resources[typeURL] = envoycache.Resources{Version: persistedItems.GetVersion(), Items: items}
+	}
+	return NewSnapshotFromResources(
+		resources[resource.EndpointTypeV3],
+		resources[resource.ClusterTypeV3],
+		resources[resource.RouteTypeV3],
+		resources[resource.ListenerTypeV3],
+		// secrets are not persisted, they are rebuilt on restore
+		envoycache.Resources{Version: persisted.SecretsVersion},
+	), nil
+}
+
+func (p *persistedResources) GetItems() [][]byte {
+	if p == nil {
+		return nil
+	}
+	return p.Items
+}
+
+func (p *persistedResources) GetVersion() string {
+	if p == nil {
+		return ""
+	}
+	return p.Version
+}
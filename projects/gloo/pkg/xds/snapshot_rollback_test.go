@@ -0,0 +1,275 @@
+package xds_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/resource"
+)
+
+var _ = Describe("SnapshotRollback", func() {
+
+	const key = "gloo-system~gateway-proxy"
+
+	var (
+		ctx      context.Context
+		xdsCache envoycache.SnapshotCache
+		rollback *xds.SnapshotRollback
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		xdsCache = envoycache.NewSnapshotCache(true, xds.NewNodeHasher(), nil)
+		rollback = xds.NewSnapshotRollback(ctx, xdsCache, "")
+	})
+
+	snapshot := func(version string, clusterNames ...string) envoycache.Snapshot {
+		var clusters []envoycache.Resource
+		for _, name := range clusterNames {
+			clusters = append(clusters, resource.NewEnvoyResource(&envoy_config_cluster_v3.Cluster{Name: name}))
+		}
+		return xds.NewSnapshot(version, nil, clusters, nil, nil)
+	}
+
+	setSnapshot := func(snap envoycache.Snapshot) {
+		ExpectWithOffset(1, xdsCache.SetSnapshot(key, snap)).NotTo(HaveOccurred())
+	}
+
+	// the version of the clusters of the snapshot in the cache
+	currentVersion := func() string {
+		snap, err := xdsCache.GetSnapshot(key)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return snap.GetResources(resource.ClusterTypeV3).Version
+	}
+
+	It("rolls back to the last acked snapshot when envoy rejects one", func() {
+		setSnapshot(snapshot("1", "good"))
+		rollback.OnAck(key, map[string]string{resource.ClusterTypeV3: "1"})
+
+		bad := snapshot("2", "good", "bad")
+		setSnapshot(bad)
+		rollback.OnNack(key, "envoy", resource.ClusterTypeV3, "2", "cluster bad: invalid lb policy")
+		Expect(currentVersion()).To(Equal("1"))
+
+		rejection := rollback.Rejected(key, bad)
+		Expect(rejection).NotTo(BeNil())
+		Expect(rejection.Resources).To(Equal([]string{"bad"}))
+		Expect(rejection.Error()).To(ContainSubstring("cluster bad: invalid lb policy"))
+
+		// a new snapshot clears the rejection
+		Expect(rollback.Rejected(key, snapshot("3", "good"))).To(BeNil())
+		Expect(rollback.Rejected(key, bad)).To(BeNil())
+	})
+
+	It("keeps serving the latest endpoints while the clusters are rejected", func() {
+		endpoints := func(version string, names ...string) envoycache.Resources {
+			items := map[string]envoycache.Resource{}
+			for _, name := range names {
+				items[name] = resource.NewEnvoyResource(&envoy_config_endpoint_v3.ClusterLoadAssignment{ClusterName: name})
+			}
+			return envoycache.Resources{Version: version, Items: items}
+		}
+		cluster := func(name string) envoycache.Resource {
+			return resource.NewEnvoyResource(&envoy_config_cluster_v3.Cluster{
+				Name:                 name,
+				ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_Type{Type: envoy_config_cluster_v3.Cluster_EDS},
+			})
+		}
+		clusters := func(version string, names ...string) envoycache.Resources {
+			items := map[string]envoycache.Resource{}
+			for _, name := range names {
+				items[name] = cluster(name)
+			}
+			return envoycache.Resources{Version: version, Items: items}
+		}
+		setSnapshot(xds.NewSnapshotFromResources(endpoints("1", "good"), clusters("1", "good"),
+			envoycache.Resources{}, envoycache.Resources{}, envoycache.Resources{}))
+		rollback.OnAck(key, map[string]string{resource.ClusterTypeV3: "1", resource.EndpointTypeV3: "1"})
+
+		bad := xds.NewSnapshotFromResources(endpoints("2", "good", "bad"), clusters("2", "good", "bad"),
+			envoycache.Resources{}, envoycache.Resources{}, envoycache.Resources{})
+		setSnapshot(bad)
+		rollback.OnNack(key, "envoy", resource.ClusterTypeV3, "2", "cluster bad: invalid lb policy")
+		rejection := rollback.Rejected(key, bad)
+		Expect(rejection).NotTo(BeNil())
+
+		rolledBack := rollback.WithAckedResources(key, bad, rejection)
+		Expect(rolledBack.Consistent()).NotTo(HaveOccurred())
+		Expect(rolledBack.GetResources(resource.ClusterTypeV3).Version).To(Equal("1"))
+		Expect(rolledBack.GetResources(resource.EndpointTypeV3).Items).To(HaveKey("good"))
+		Expect(rolledBack.GetResources(resource.EndpointTypeV3).Items).NotTo(HaveKey("bad"))
+		current, err := xdsCache.GetSnapshot(key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(current.GetResources(resource.ClusterTypeV3).Version).To(Equal("1"))
+		Expect(current.GetResources(resource.EndpointTypeV3).Version).To(Equal(rolledBack.GetResources(resource.EndpointTypeV3).Version))
+
+		// the endpoints of the acked clusters keep being updated
+		updated := rollback.WithAckedResources(key, xds.NewSnapshotFromResources(endpoints("3", "good", "bad"),
+			clusters("2", "good", "bad"), envoycache.Resources{}, envoycache.Resources{}, envoycache.Resources{}), rejection)
+		Expect(updated.GetResources(resource.ClusterTypeV3).Version).To(Equal("1"))
+		Expect(updated.GetResources(resource.EndpointTypeV3).Version).NotTo(Equal(rolledBack.GetResources(resource.EndpointTypeV3).Version))
+	})
+
+	It("keeps serving the clusters acked with the endpoints envoy rejects", func() {
+		setSnapshot(snapshot("1", "good"))
+		rollback.OnAck(key, map[string]string{resource.ClusterTypeV3: "1"})
+
+		bad := snapshot("2", "good", "other")
+		rejection := &xds.Rejection{TypeURL: resource.EndpointTypeV3, Version: "2"}
+		Expect(rollback.WithAckedResources(key, bad, rejection).GetResources(resource.ClusterTypeV3).Version).To(Equal("1"))
+	})
+
+	It("has nothing to roll back to if no snapshot was acked", func() {
+		rejection := &xds.Rejection{TypeURL: resource.ClusterTypeV3, Version: "1"}
+		Expect(rollback.WithAckedResources(key, snapshot("1", "bad"), rejection)).To(BeNil())
+	})
+
+	It("ignores nacks of snapshots that were already replaced", func() {
+		setSnapshot(snapshot("1", "good"))
+		rollback.OnAck(key, map[string]string{resource.ClusterTypeV3: "1"})
+		current := snapshot("3", "other")
+		setSnapshot(current)
+
+		rollback.OnNack(key, "envoy", resource.ClusterTypeV3, "2", "invalid")
+		Expect(currentVersion()).To(Equal("3"))
+		Expect(rollback.Rejected(key, current)).To(BeNil())
+	})
+
+	It("does not roll back if no snapshot was acked", func() {
+		bad := snapshot("1", "bad")
+		setSnapshot(bad)
+		rollback.OnNack(key, "envoy", resource.ClusterTypeV3, "1", "invalid")
+		Expect(currentVersion()).To(Equal("1"))
+		Expect(rollback.Rejected(key, bad)).NotTo(BeNil())
+	})
+
+	Context("persisting acked snapshots", func() {
+
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "acked-snapshots")
+			Expect(err).NotTo(HaveOccurred())
+			rollback = xds.NewSnapshotRollback(ctx, xdsCache, dir)
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("restores the acked snapshots after a restart", func() {
+			setSnapshot(snapshot("1", "good"))
+			rollback.OnAck(key, map[string]string{resource.ClusterTypeV3: "1"})
+
+			restartedCache := envoycache.NewSnapshotCache(true, xds.NewNodeHasher(), nil)
+			restarted := xds.NewSnapshotRollback(ctx, restartedCache, dir)
+			Expect(restarted.Restore(nil)).NotTo(HaveOccurred())
+
+			restored, err := restartedCache.GetSnapshot(key)
+			Expect(err).NotTo(HaveOccurred())
+			clusters := restored.GetResources(resource.ClusterTypeV3)
+			Expect(clusters.Version).To(Equal("1"))
+			Expect(clusters.Items).To(HaveKey("good"))
+
+			// the restored snapshot is the one rolled back to
+			Expect(restartedCache.SetSnapshot(key, snapshot("2", "bad"))).NotTo(HaveOccurred())
+			restarted.OnNack(key, "envoy", resource.ClusterTypeV3, "2", "invalid")
+			current, err := restartedCache.GetSnapshot(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(current.GetResources(resource.ClusterTypeV3).Items).To(HaveKey("good"))
+		})
+
+		It("rebuilds the secrets of the restored snapshots, which are not persisted", func() {
+			secret := func(version, key string) envoycache.Resources {
+				return envoycache.NewResources(version, []envoycache.Resource{xds.NewSecretResource(&envoyauth.Secret{
+					Name: "tls",
+					Type: &envoyauth.Secret_TlsCertificate{
+						TlsCertificate: &envoyauth.TlsCertificate{
+							PrivateKey: &envoy_config_core_v3.DataSource{
+								Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: key},
+							},
+						},
+					},
+				})})
+			}
+			withSecret := func(version, key string) envoycache.Snapshot {
+				good := snapshot("1", "good")
+				return xds.NewSnapshotFromResources(
+					good.GetResources(resource.EndpointTypeV3),
+					good.GetResources(resource.ClusterTypeV3),
+					good.GetResources(resource.RouteTypeV3),
+					good.GetResources(resource.ListenerTypeV3),
+					secret(version, key),
+				)
+			}
+			setSnapshot(withSecret("1", "private-key"))
+			rollback.OnAck(key, map[string]string{resource.ClusterTypeV3: "1", resource.SecretTypeV3: "1"})
+
+			files, err := ioutil.ReadDir(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+			persisted, err := ioutil.ReadFile(filepath.Join(dir, files[0].Name()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(persisted)).NotTo(ContainSubstring("private-key"))
+
+			// a rotation of the secrets only is persisted
+			setSnapshot(withSecret("2", "rotated-key"))
+			rollback.OnAck(key, map[string]string{resource.ClusterTypeV3: "1", resource.SecretTypeV3: "2"})
+
+			restartedCache := envoycache.NewSnapshotCache(true, xds.NewNodeHasher(), nil)
+			restarted := xds.NewSnapshotRollback(ctx, restartedCache, dir)
+			Expect(restarted.Restore(func(snap envoycache.Snapshot) (envoycache.Resources, error) {
+				Expect(snap.GetResources(resource.SecretTypeV3).Version).To(Equal("2"))
+				Expect(snap.GetResources(resource.ClusterTypeV3).Items).To(HaveKey("good"))
+				return secret("2", "rotated-key"), nil
+			})).NotTo(HaveOccurred())
+
+			restored, err := restartedCache.GetSnapshot(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored.GetResources(resource.SecretTypeV3).Version).To(Equal("2"))
+			Expect(restored.GetResources(resource.SecretTypeV3).Items).To(HaveKey("tls"))
+
+			// a rejected rotation of the secrets is rolled back
+			Expect(restartedCache.SetSnapshot(key, withSecret("3", "bad-key"))).NotTo(HaveOccurred())
+			restarted.OnNack(key, "envoy", resource.SecretTypeV3, "3", "secret tls: invalid private key")
+			current, err := restartedCache.GetSnapshot(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(current.GetResources(resource.SecretTypeV3).Version).To(Equal("2"))
+		})
+
+		It("only lets gloo read the persisted snapshots", func() {
+			persistDir := filepath.Join(dir, "snapshots")
+			rollback = xds.NewSnapshotRollback(ctx, xdsCache, persistDir)
+			setSnapshot(snapshot("1", "good"))
+			rollback.OnAck(key, map[string]string{resource.ClusterTypeV3: "1"})
+
+			dirInfo, err := os.Stat(persistDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dirInfo.Mode().Perm()).To(Equal(os.FileMode(0700)))
+			files, err := ioutil.ReadDir(persistDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+			Expect(files[0].Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+
+		It("does not override snapshots already set", func() {
+			setSnapshot(snapshot("1", "good"))
+			rollback.OnAck(key, map[string]string{resource.ClusterTypeV3: "1"})
+			setSnapshot(snapshot("2", "translated"))
+
+			Expect(xds.NewSnapshotRollback(ctx, xdsCache, dir).Restore(nil)).NotTo(HaveOccurred())
+			Expect(currentVersion()).To(Equal("2"))
+		})
+	})
+})
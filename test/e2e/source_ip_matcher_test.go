@@ -0,0 +1,120 @@
+package e2e_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gatewaydefaults "github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/iptagging"
+	gloohelpers "github.com/solo-io/gloo/test/helpers"
+	"github.com/solo-io/gloo/test/services"
+	"github.com/solo-io/gloo/test/v1helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+var _ = Describe("Source IP matcher", func() {
+
+	var (
+		err           error
+		ctx           context.Context
+		cancel        context.CancelFunc
+		testClients   services.TestClients
+		envoyInstance *services.EnvoyInstance
+		up            *gloov1.Upstream
+
+		writeNamespace = defaults.GlooSystem
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		defaults.HttpPort = services.NextBindPort()
+
+		ro := &services.RunOptions{
+			NsToWrite: writeNamespace,
+			NsToWatch: []string{"default", writeNamespace},
+			WhatToRun: services.What{
+				DisableFds: true,
+				DisableUds: true,
+			},
+		}
+		testClients = services.RunGlooGatewayUdsFds(ctx, ro)
+
+		err = gloohelpers.WriteDefaultGateways(writeNamespace, testClients.GatewayClient)
+		Expect(err).NotTo(HaveOccurred(), "Should be able to write default gateways")
+		Eventually(func() (gatewayv1.GatewayList, error) {
+			return testClients.GatewayClient.List(writeNamespace, clients.ListOpts{})
+		}, "10s", "0.1s").Should(HaveLen(2), "Gateways should be present")
+
+		envoyInstance, err = envoyFactory.NewEnvoyInstance()
+		Expect(err).NotTo(HaveOccurred())
+		err = envoyInstance.RunWithRoleAndRestXds(writeNamespace+"~"+gatewaydefaults.GatewayProxyName, testClients.GlooPort, testClients.RestXdsPort)
+		Expect(err).NotTo(HaveOccurred())
+
+		testUs := v1helpers.NewTestHttpUpstream(ctx, envoyInstance.LocalAddr())
+		up = testUs.Upstream
+		_, err = testClients.UpstreamClient.Write(up, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		if envoyInstance != nil {
+			_ = envoyInstance.Clean()
+		}
+		cancel()
+	})
+
+	writeVirtualServiceWithSourceRange := func(addressPrefix string, prefixLen uint32) {
+		vs := getTrivialVirtualServiceForUpstream(writeNamespace, up.Metadata.Ref())
+		vs.GetVirtualHost().GetRoutes()[0].Matchers = []*matchers.Matcher{{
+			PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+			SourcePrefixRanges: []*v3.CidrRange{{
+				AddressPrefix: addressPrefix,
+				PrefixLen:     &wrappers.UInt32Value{Value: prefixLen},
+			}},
+		}}
+		_, err = testClients.VirtualServiceClient.Write(vs, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	requestStatus := func(ipTags string) func() (int, error) {
+		return func() (int, error) {
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s:%d/", "localhost", defaults.HttpPort), nil)
+			if err != nil {
+				return 0, err
+			}
+			if ipTags != "" {
+				req.Header.Set(iptagging.IpTagsHeader, ipTags)
+			}
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return 0, err
+			}
+			defer res.Body.Close()
+			return res.StatusCode, nil
+		}
+	}
+
+	It("should match requests from the source prefix ranges", func() {
+		writeVirtualServiceWithSourceRange("127.0.0.1", 32)
+
+		Eventually(requestStatus(""), 10*time.Second, 500*time.Millisecond).Should(Equal(http.StatusOK))
+	})
+
+	It("should not match requests spoofing the ip tags header", func() {
+		writeVirtualServiceWithSourceRange("10.0.0.0", 8)
+
+		// the listener is only served once the virtual service is translated
+		Eventually(requestStatus(""), 10*time.Second, 500*time.Millisecond).Should(Equal(http.StatusNotFound))
+		Consistently(requestStatus("10.0.0.0/8"), 2*time.Second, 500*time.Millisecond).Should(Equal(http.StatusNotFound))
+	})
+})